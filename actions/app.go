@@ -3,6 +3,7 @@ package actions
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
@@ -21,15 +22,18 @@ const defaultRepo = "github.com"
 var (
 	wd        string
 	driver    string
+	migrator  string
 	framework string
 	host      string
+	module    string
 	repo      string
 	port      int
 
-	dep        bool
-	git        bool
-	mod        bool
-	migrations bool
+	dep          bool
+	git          bool
+	mod          bool
+	migrations   bool
+	fsMigrations bool
 )
 
 func init() {
@@ -62,12 +66,74 @@ func init() {
 				Destination: &migrations,
 				Usage:       "whether or not to include support for database migrations",
 			},
+			cli.BoolFlag{
+				Name:        "fs-migrations",
+				Destination: &fsMigrations,
+				Usage:       "whether or not to load migrations from the filesystem instead of embedding them",
+			},
+			cli.BoolFlag{
+				Name:        "seeds",
+				Destination: &seeds,
+				Usage:       "whether or not to include per-environment seed data (requires --migrations)",
+			},
 			cli.StringFlag{
 				Name:        "driver",
 				Value:       "postgres",
-				Usage:       "database driver",
+				Usage:       fmt.Sprintf("database driver [i.e. %v]", strings.Join(listDrivers(), ", ")),
 				Destination: &driver,
 			},
+			cli.StringFlag{
+				Name:        "migrator",
+				Value:       defaultMigrator,
+				Usage:       fmt.Sprintf("migration engine [i.e. %v]", strings.Join(listEngines(), ", ")),
+				Destination: &migrator,
+			},
+			cli.StringFlag{
+				Name:        "orm",
+				Value:       defaultORM,
+				Usage:       fmt.Sprintf("ORM or query layer of the sql package [i.e. %v]", strings.Join(listORMs(), ", ")),
+				Destination: &orm,
+			},
+			cli.BoolFlag{
+				Name:        "sqlc",
+				Destination: &sqlc,
+				Usage:       "whether or not to generate type-safe queries using sqlc (requires --migrations)",
+			},
+			cli.BoolFlag{
+				Name:        "replicas",
+				Destination: &replicas,
+				Usage:       "whether or not to route read-only queries to replicas configured through the environment (requires --migrations)",
+			},
+			cli.StringFlag{
+				Name:        "store",
+				Usage:       fmt.Sprintf("embedded key-value store of the store package [i.e. %v]", strings.Join(listStores(), ", ")),
+				Destination: &store,
+			},
+			cli.BoolFlag{
+				Name:        "config",
+				Destination: &appConfig,
+				Usage:       "whether or not to load the app settings from defaults, a YAML or TOML file, the environment, and flags",
+			},
+			cli.BoolFlag{
+				Name:        "logging",
+				Destination: &appLogging,
+				Usage:       "whether or not to log structured requests through log/slog",
+			},
+			cli.BoolFlag{
+				Name:        "metrics",
+				Destination: &appMetrics,
+				Usage:       "whether or not to record Prometheus metrics served on /metrics",
+			},
+			cli.IntFlag{
+				Name:        "metrics-port",
+				Usage:       fmt.Sprintf("admin port serving the metrics apart from the main port, or %d for grpc when unset", defaultMetricsPort),
+				Destination: &metricsPort,
+			},
+			cli.BoolFlag{
+				Name:        "tracing",
+				Destination: &appTracing,
+				Usage:       "whether or not to trace requests and queries through OpenTelemetry",
+			},
 			cli.StringFlag{
 				Name:        "repo",
 				Value:       defaultRepo,
@@ -101,7 +167,32 @@ type Context struct {
 	Driver     string
 	Conn       string
 	Import     string
+	Migrate    string
+	Dialect    string
+	Module     string
 	Migrations bool
+	Embed      bool
+	Schema     string
+	Throwaway  bool
+	Seeds      bool
+	Seed       seedSQL
+	Queries    bool
+	Replicas   bool
+	Store      string
+	StorePath  string
+	Config     bool
+	Logging    bool
+	Metrics    bool
+	AdminPort  int
+	Tracing    bool
+	System     string
+	Imports    []string
+	ORM        *ormContext
+	Models     []*tableModel
+	Model      *tableModel
+	Resource   *resourceSpec
+	Name       string
+	Version    string
 }
 
 func appAction(_ *cli.Context) error {
@@ -109,11 +200,65 @@ func appAction(_ *cli.Context) error {
 		wd = "."
 	}
 
+	if migrations {
+		if _, err := projectEngine(&Project{Driver: driver, Migrator: migrator}); err != nil {
+			return err
+		}
+
+		if _, err := projectORM(&Project{Driver: driver, ORM: orm}); err != nil {
+			return err
+		}
+
+		if sqlc {
+			if orm != "" && orm != defaultORM {
+				return errors.New("sqlc generates the query layer and cannot be combined with an ORM")
+			}
+
+			if _, err := lookupSQLCEngine(driver); err != nil {
+				return err
+			}
+		}
+
+		if replicas && orm != "" && orm != defaultORM {
+			return errors.New("replicas are only routed by the sql package and cannot be combined with an ORM")
+		}
+	} else if seeds {
+		return errors.New("seed data requires --migrations")
+	} else if orm != "" && orm != defaultORM {
+		return errors.New("an ORM requires --migrations")
+	} else if sqlc {
+		return errors.New("sqlc requires --migrations")
+	} else if replicas {
+		return errors.New("replicas require --migrations")
+	}
+
+	if store != "" {
+		if _, err := lookupStore(store); err != nil {
+			return err
+		}
+	}
+
+	if appMetrics {
+		if metricsPort == 0 && framework == "grpc" {
+			metricsPort = defaultMetricsPort
+		}
+	} else if metricsPort != 0 {
+		return errors.New("a metrics port requires --metrics")
+	}
+
+	if module == "" && (migrations || mod || store != "" || appConfig || appLogging || appMetrics || appTracing) {
+		module = modulePath()
+	}
+
 	templates := parseTemplates()
 	if err := createWebApp(templates); err != nil {
 		return err
 	}
 
+	if err := saveProject(); err != nil {
+		return err
+	}
+
 	if migrations {
 		if err := stageMigrations(templates); err != nil {
 			return err
@@ -124,6 +269,48 @@ func appAction(_ *cli.Context) error {
 		}
 	}
 
+	if seeds {
+		if err := stageSeeds(templates); err != nil {
+			return err
+		}
+	}
+
+	if sqlc {
+		if err := stageQueries(templates); err != nil {
+			return err
+		}
+	}
+
+	if store != "" {
+		if err := stageStore(templates); err != nil {
+			return err
+		}
+	}
+
+	if appConfig {
+		if err := stageConfig(templates); err != nil {
+			return err
+		}
+	}
+
+	if appLogging {
+		if err := stageLogging(templates); err != nil {
+			return err
+		}
+	}
+
+	if appMetrics {
+		if err := stageMetrics(templates); err != nil {
+			return err
+		}
+	}
+
+	if appTracing {
+		if err := stageTracing(templates); err != nil {
+			return err
+		}
+	}
+
 	if dep {
 		if out, err := depInit(); err != nil {
 			return err
@@ -165,12 +352,32 @@ func createWebApp(templates *template.Template) error {
 		Host:       host,
 		Port:       port,
 		Migrations: migrations,
+		Store:      store,
+		Config:     appConfig,
+		Logging:    appLogging,
+		Metrics:    appMetrics,
+		AdminPort:  metricsPort,
+		Tracing:    appTracing,
+		Module:     module,
+	}
+
+	if appLogging && !appConfig {
+		context.Name = envPrefix(getPath())
 	}
 
 	if err := t.Execute(app, context); err != nil {
 		return err
 	}
 
+	server := "templates/server/http.tpl"
+	if framework == "grpc" {
+		server = "templates/server/grpc.tpl"
+	}
+
+	if err := writeSource(templates, server, filepath.Join(wd, "server.go"), context); err != nil {
+		return err
+	}
+
 	if framework == "grpc" {
 		path := filepath.Join(wd, "proto")
 		if err := os.MkdirAll(path, 0755); err != nil {
@@ -183,23 +390,106 @@ func createWebApp(templates *template.Template) error {
 	return nil
 }
 
+func saveProject() error {
+	project := &Project{
+		Framework: framework,
+	}
+
+	if migrations {
+		project.Driver = driver
+		project.Migrator = migrator
+		project.Migrations = defaultMigrationDir
+		if orm != "" && orm != defaultORM {
+			project.ORM = orm
+		}
+
+		if sqlc {
+			project.Queries = defaultQueryDir
+		}
+		project.Replicas = replicas
+	}
+
+	if seeds {
+		project.Seeds = defaultSeedDir
+	}
+	project.Store = store
+	project.Logging = appLogging
+	project.Config = appConfig
+	project.Metrics = appMetrics
+	project.Tracing = appTracing
+	return project.save(wd)
+}
+
 func stageMigrations(templates *template.Template) error {
-	path := filepath.Join(wd, "sql/migrations")
+	e, err := lookupEngine(migrator)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(wd, defaultMigrationDir)
 	log.Println("staging migrations...")
 	if err := os.MkdirAll(path, 0755); err != nil {
 		return err
 	}
 
-	up, _ := os.Create(filepath.Join(path, "1.up.sql"))
-	if err := templates.Lookup("templates/sql/1.up.tpl").Execute(up, nil); err != nil {
+	var up, down bytes.Buffer
+	if err := starter(templates, "1.up").Execute(&up, nil); err != nil {
+		return err
+	}
+
+	if err := starter(templates, "1.down").Execute(&down, nil); err != nil {
+		return err
+	}
+
+	if e.Declarative {
+		if err := stageSchema(templates); err != nil {
+			return err
+		}
+	}
+
+	if migrator == "goose" {
+		pkg, err := os.Create(filepath.Join(path, "migrations.go"))
+		if err != nil {
+			return err
+		}
+		defer pkg.Close()
+
+		if err := templates.Lookup("templates/sql/goose/package.tpl").Execute(pkg, nil); err != nil {
+			return err
+		}
+	}
+	return writeMigration(e, path, fmt.Sprintf(e.Format, 1), "init", up.String(), down.String())
+}
+
+// writeMigration writes the up and down statements of a migration using the
+// file layout of the migration engine
+func writeMigration(e migrationEngine, path, version, name, up, down string) error {
+	base := filepath.Join(path, fmt.Sprintf("%s_%s", version, name))
+	if !e.paired() {
+		return ioutil.WriteFile(base+".sql", []byte(e.Join(up, down)), 0644)
+	}
+
+	if err := ioutil.WriteFile(base+".up.sql", []byte(up), 0644); err != nil {
 		return err
 	}
+	return ioutil.WriteFile(base+".down.sql", []byte(down), 0644)
+}
 
-	down, _ := os.Create(filepath.Join(path, "1.down.sql"))
-	return templates.Lookup("templates/sql/1.down.tpl").Execute(down, nil)
+// starter looks up the dialect specific starter migration template for the
+// selected driver, falling back to the generic template
+func starter(templates *template.Template, name string) *template.Template {
+	if d, err := lookupDriver(driver); err == nil && d.Dialect != "" {
+		return templates.Lookup(fmt.Sprintf("templates/sql/%s/%s.tpl", d.Dialect, name))
+	}
+	return templates.Lookup(fmt.Sprintf("templates/sql/%s.tpl", name))
 }
 
 func setupDb(templates *template.Template) error {
+	d, err := lookupDriver(driver)
+	if err != nil {
+		return err
+	}
+
 	dbConn, err := conn(driver)
 	if err != nil {
 		return err
@@ -210,17 +500,69 @@ func setupDb(templates *template.Template) error {
 		return err
 	}
 
+	e, err := projectEngine(&Project{Driver: driver, Migrator: migrator})
+	if err != nil {
+		return err
+	}
+
+	layer, err := newORMContext(orm, driver)
+	if err != nil {
+		return err
+	}
+
 	migrations, _ := os.Create(filepath.Join(path, "migrations.go"))
 	context := &Context{
-		Driver: driver,
-		Conn:   dbConn,
-		Import: imp(driver),
+		Driver:    d.Name,
+		Conn:      dbConn,
+		Import:    d.Import,
+		Migrate:   d.Migrate,
+		Dialect:   string(gooseDialects[driver]),
+		Module:    module,
+		Embed:     !fsMigrations,
+		Schema:    d.Schema,
+		Throwaway: d.throwaway(),
+		Seeds:     seeds,
+		Seed:      seedStatements(d),
+		Queries:   sqlc,
+		Replicas:  replicas,
+		Config:    appConfig,
+		Metrics:   appMetrics,
+		Tracing:   appTracing,
+		System:    d.System,
+		ORM:       layer,
 	}
 
-	if err := templates.Lookup("templates/sql/migrations.tpl").Execute(migrations, context); err != nil {
+	if err := templates.Lookup(e.Template).Execute(migrations, context); err != nil {
 		return err
 	}
 
+	// the generated round-trip test drives golang-migrate directly
+	if e.Template == engines[defaultMigrator].Template {
+		migrationsTest, _ := os.Create(filepath.Join(path, "migrations_test.go"))
+		if err := templates.Lookup("templates/sql/migrations_test.tpl").Execute(migrationsTest, context); err != nil {
+			return err
+		}
+	}
+
+	if seeds {
+		seedsFile, _ := os.Create(filepath.Join(path, "seeds.go"))
+		if err := templates.Lookup("templates/sql/seeds.tpl").Execute(seedsFile, context); err != nil {
+			return err
+		}
+	}
+
+	if orm == "ent" {
+		if err := stageEnt(templates, path); err != nil {
+			return err
+		}
+	}
+
+	if replicas {
+		if err := writeReplicas(templates, path, context); err != nil {
+			return err
+		}
+	}
+
 	sql, _ := os.Create(filepath.Join(path, "sql.go"))
 	return templates.Lookup("templates/sql/sql.tpl").Execute(sql, context)
 }
@@ -238,12 +580,7 @@ func depInit() (string, error) {
 }
 
 func modInit() (string, error) {
-	username, err := gitUsername()
-	if err != nil {
-		return "", err
-	}
-
-	cmd := exec.Command("go", "mod", "init", fmt.Sprintf("%s/%s/%s", repo, username, getPath()))
+	cmd := exec.Command("go", "mod", "init", module)
 	log.Println("initializing go module...")
 
 	output, err := cmd.CombinedOutput()
@@ -301,30 +638,19 @@ func gitUsername() (string, error) {
 	return username, nil
 }
 
-func getPath() string {
-	wd, _ := os.Getwd()
-	return filepath.Base(wd)
-}
-
-func conn(driver string) (string, error) {
-	wd, _ := os.Getwd()
-	switch driver {
-	case "postgres":
-		return fmt.Sprintf("postgres://localhost:5432/%s", filepath.Base(wd)), nil
-	case "sqlite3":
-		return fmt.Sprintf("file:%s.sqlite", filepath.Base(wd)), nil
+// modulePath builds the import path of the generated module from the git
+// user name, falling back to the project directory name
+func modulePath() string {
+	username, err := gitUsername()
+	if err != nil {
+		username = getPath()
 	}
-	return "", fmt.Errorf("%s is not a supported database driver", driver)
+	return fmt.Sprintf("%s/%s/%s", repo, username, getPath())
 }
 
-func imp(driver string) string {
-	switch driver {
-	case "postgres":
-		return "github.com/lib/pq"
-	case "sqlite3":
-		return "github.com/mattn/go-sqlite3"
-	}
-	return ""
+func getPath() string {
+	wd, _ := os.Getwd()
+	return filepath.Base(wd)
 }
 
 func listApps() []string {
@@ -94,6 +94,63 @@ func TestCreateWebApp(t *testing.T) {
 	})
 }
 
+func TestCreateWebAppMigrations(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(m string) { migrations, module = false, m }(module)
+		migrations, module = true, "github.com/example/app"
+		host, port = "localhost", 8080
+
+		for _, app := range listApps() {
+			framework = app
+			if err := createWebApp(templates); err != nil {
+				t.Fatalf("failed to create %s web application: %s", framework, err)
+			}
+
+			actual, _ := ioutil.ReadFile(filepath.Join(wd, "app.go"))
+			for _, expected := range []string{"if err := sql.Open(); err != nil {", "onClose(sql.Close)"} {
+				if !bytes.Contains(actual, []byte(expected)) {
+					t.Errorf("expected the %s application to open the database and close it on shutdown: %s", app, expected)
+				}
+			}
+
+			golden := filepath.Join("testdata", app+"_migrations.golden")
+			if update {
+				ioutil.WriteFile(golden, actual, 0644)
+			}
+
+			expected, _ := ioutil.ReadFile(golden)
+			if !bytes.Equal(actual, expected) {
+				t.Fatalf("generated %s application contents did not match: \n%s", app, actual)
+			}
+		}
+	})
+}
+
+func TestCreateWebAppServer(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		host, port = "localhost", 8080
+
+		for _, app := range listApps() {
+			framework = app
+			if err := createWebApp(templates); err != nil {
+				t.Fatalf("failed to create %s web application: %s", framework, err)
+			}
+
+			expected := "ReadHeaderTimeout"
+			if app == "grpc" {
+				expected = "GracefulStop"
+			}
+
+			src, _ := ioutil.ReadFile(filepath.Join(wd, "server.go"))
+			for _, s := range []string{expected, "signal.NotifyContext", "func onShutdown("} {
+				if !bytes.Contains(src, []byte(s)) {
+					t.Errorf("generated %s server did not contain %s: \n%s", app, s, src)
+				}
+			}
+		}
+	})
+}
+
 func TestStageMigrations(t *testing.T) {
 	stageTest(t, func(t *testing.T) {
 		if err := stageMigrations(templates); err != nil {
@@ -106,6 +163,88 @@ func TestStageMigrations(t *testing.T) {
 	})
 }
 
+func TestStageDialectMigrations(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d string) { driver = d }(driver)
+		driver = "mysql"
+
+		if err := stageMigrations(templates); err != nil {
+			t.Errorf("failed to stage migrations: %s", err)
+		}
+
+		actual, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "migrations", "0001_init.up.sql"))
+		if !bytes.Contains(actual, []byte("ENGINE=InnoDB")) {
+			t.Errorf("expected mysql starter migration: \n%s", actual)
+		}
+	})
+}
+
+func TestStageEngineMigrations(t *testing.T) {
+	defer func(m string) { migrator = m }(migrator)
+
+	tests := []struct {
+		Migrator string
+		Files    []string
+	}{
+		{"goose", []string{"00001_init.sql", "migrations.go"}},
+		{"tern", []string{"001_init.sql"}},
+		{"declarative", []string{"0001_init.up.sql", "0001_init.down.sql", filepath.Join("..", "schema", "schema.sql")}},
+	}
+
+	for _, test := range tests {
+		stageTest(t, func(t *testing.T) {
+			migrator = test.Migrator
+			if err := stageMigrations(templates); err != nil {
+				t.Fatalf("failed to stage %s migrations: %s", test.Migrator, err)
+			}
+
+			for _, f := range test.Files {
+				if !conseil.FileExists(filepath.Join(wd, defaultMigrationDir, f)) {
+					t.Errorf("expected %s to stage %s", test.Migrator, f)
+				}
+			}
+		})
+	}
+}
+
+func TestSetupEngineDb(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d, m string) { driver, migrator = d, m }(driver, migrator)
+
+		tests := []struct {
+			Migrator string
+			Driver   string
+			Expected string
+		}{
+			{"goose", "sqlite3", `goose.Dialect("sqlite3")`},
+			{"goose", "postgres", `goose.Dialect("postgres")`},
+			{"tern", "pgx", "github.com/jackc/tern/v2/migrate"},
+		}
+
+		for _, test := range tests {
+			os.RemoveAll(filepath.Join(wd, "sql"))
+			driver, migrator = test.Driver, test.Migrator
+			if err := setupDb(templates); err != nil {
+				t.Fatalf("failed to setup %s database file: %s", test.Migrator, err)
+			}
+
+			migrations, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "migrations.go"))
+			if !bytes.Contains(migrations, []byte(test.Expected)) {
+				t.Errorf("generated %s migrations did not contain %s: \n%s", test.Migrator, test.Expected, migrations)
+			}
+
+			if conseil.FileExists(filepath.Join(wd, "sql", "migrations_test.go")) {
+				t.Errorf("expected no golang-migrate round-trip test for %s", test.Migrator)
+			}
+		}
+
+		driver, migrator = "sqlite3", "tern"
+		if err := setupDb(templates); err == nil {
+			t.Error("expected an unsupported engine driver to generate an error")
+		}
+	})
+}
+
 func TestSetupDb(t *testing.T) {
 	stageTest(t, func(t *testing.T) {
 		tests := []struct {
@@ -114,7 +253,12 @@ func TestSetupDb(t *testing.T) {
 		}{
 			{"postgres", false},
 			{"sqlite3", false},
-			{"mysql", true},
+			{"mysql", false},
+			{"pgx", false},
+			{"sqlite", false},
+			{"sqlserver", false},
+			{"cockroachdb", false},
+			{"oracle", true},
 		}
 
 		for _, test := range tests {
@@ -142,6 +286,49 @@ func TestSetupDb(t *testing.T) {
 			if !bytes.Equal(actual, expected) {
 				t.Fatalf("generated %s application contents did not match: \n%s", test.Driver, actual)
 			}
+
+			migrations, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "migrations.go"))
+			if !bytes.Contains(migrations, []byte(drivers[test.Driver].Migrate)) {
+				t.Errorf("generated %s migrations did not import the migrate driver: \n%s", test.Driver, migrations)
+			}
+
+			migrationsTest, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "migrations_test.go"))
+			if skip := bytes.Contains(migrationsTest, []byte("t.Skip(")); skip == drivers[test.Driver].throwaway() {
+				t.Errorf("generated %s migrations test did not match the driver's throwaway support: \n%s", test.Driver, migrationsTest)
+			}
+		}
+	})
+}
+
+func TestSetupMigrations(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d string, fs bool) { driver, fsMigrations = d, fs }(driver, fsMigrations)
+
+		tests := []struct {
+			Golden       string
+			FsMigrations bool
+		}{
+			{"migrations", false},
+			{"migrations_fs", true},
+		}
+
+		for _, test := range tests {
+			driver = "postgres"
+			fsMigrations = test.FsMigrations
+			if err := setupDb(templates); err != nil {
+				t.Fatalf("failed to setup database file: %s", err)
+			}
+
+			actual, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "migrations.go"))
+			golden := filepath.Join("testdata", test.Golden+".golden")
+			if update {
+				ioutil.WriteFile(golden, actual, 0644)
+			}
+
+			expected, _ := ioutil.ReadFile(golden)
+			if !bytes.Equal(actual, expected) {
+				t.Fatalf("generated %s contents did not match: \n%s", test.Golden, actual)
+			}
 		}
 	})
 }
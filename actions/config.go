@@ -0,0 +1,64 @@
+package actions
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+var appConfig bool
+
+// unsafePrefix matches the characters replaced within the environment
+// variable prefix of a generated app
+var unsafePrefix = regexp.MustCompile(`[^A-Z0-9]+`)
+
+// stageConfig writes the config package loading the settings of the app,
+// seeded by the host, port, connection string, and store path of the project
+func stageConfig(templates *template.Template) error {
+	path := filepath.Join(wd, "config")
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+
+	context := &Context{
+		Name:       envPrefix(getPath()),
+		Host:       host,
+		Port:       port,
+		Migrations: migrations,
+		Store:      store,
+		Logging:    appLogging,
+		AdminPort:  metricsPort,
+	}
+
+	if migrations {
+		dbConn, err := conn(driver)
+		if err != nil {
+			return err
+		}
+		context.Conn = dbConn
+	}
+
+	if store != "" {
+		s, err := lookupStore(store)
+		if err != nil {
+			return err
+		}
+		context.StorePath = s.Path
+	}
+
+	log.Println("staging config...")
+	return writeSource(templates, "templates/config/config.tpl", filepath.Join(path, "config.go"), context)
+}
+
+// envPrefix derives the prefix of the environment variables of the app
+// named name, e.g. MY_APP for my-app
+func envPrefix(name string) string {
+	prefix := strings.Trim(unsafePrefix.ReplaceAllString(strings.ToUpper(name), "_"), "_")
+	if prefix == "" || (prefix[0] >= '0' && prefix[0] <= '9') {
+		prefix = "APP_" + prefix
+	}
+	return strings.TrimSuffix(prefix, "_")
+}
@@ -0,0 +1,128 @@
+package actions
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestEnvPrefix(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Expected string
+	}{
+		{"app", "APP"},
+		{"my-app", "MY_APP"},
+		{"my.api_v2", "MY_API_V2"},
+		{"2fa", "APP_2FA"},
+		{"---", "APP"},
+	}
+
+	for _, test := range tests {
+		if actual := envPrefix(test.Name); actual != test.Expected {
+			t.Errorf("expected the prefix of %s to be %s; actual %s", test.Name, test.Expected, actual)
+		}
+	}
+}
+
+func TestStageConfig(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d, s string, b, l bool) { driver, store, migrations, appLogging = d, s, b, l }(driver, store, migrations, appLogging)
+		driver, store, migrations, appLogging = "postgres", "badger", true, true
+		host, port = "localhost", 9000
+
+		if err := stageConfig(templates); err != nil {
+			t.Fatalf("failed to stage the config package: %s", err)
+		}
+
+		src, _ := ioutil.ReadFile(filepath.Join(wd, "config", "config.go"))
+		for _, expected := range []string{
+			`Host:            "localhost",`,
+			"Port:            9000,",
+			"ShutdownTimeout: 15 * time.Second,",
+			`DatabaseURL:     "postgres://`,
+			`StorePath:       "data",`,
+			"`yaml:\"database_url\" toml:\"database_url\" secret:\"true\"`",
+			`{"database-url", "connection string of the database"},`,
+			"`yaml:\"log_level\" toml:\"log_level\"`",
+			"if err := level.UnmarshalText([]byte(c.LogLevel)); err != nil {",
+			fmt.Sprintf("named by --config or the %s_CONFIG\n", envPrefix(getPath())),
+		} {
+			if !bytes.Contains(src, []byte(expected)) {
+				t.Errorf("generated config package did not contain %s: \n%s", expected, src)
+			}
+		}
+
+		store, migrations, appLogging = "", false, false
+		if err := stageConfig(templates); err != nil {
+			t.Fatalf("failed to stage the config package: %s", err)
+		}
+
+		if src, _ := ioutil.ReadFile(filepath.Join(wd, "config", "config.go")); bytes.Contains(src, []byte("DatabaseURL")) || bytes.Contains(src, []byte("StorePath")) || bytes.Contains(src, []byte("LogLevel")) {
+			t.Errorf("expected the config package to only include the server settings: \n%s", src)
+		}
+	})
+}
+
+func TestCreateWebAppConfig(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d, m string, c, b bool) {
+			driver, module, appConfig, migrations = d, m, c, b
+		}(driver, module, appConfig, migrations)
+		module, appConfig, migrations = "github.com/example/app", true, true
+		host, port = "localhost", 8080
+
+		for _, app := range listApps() {
+			framework = app
+			if err := createWebApp(templates); err != nil {
+				t.Fatalf("failed to create %s web application: %s", framework, err)
+			}
+
+			actual, _ := ioutil.ReadFile(filepath.Join(wd, "app.go"))
+			for _, expected := range []string{`"github.com/example/app/config"`, "cfg, err := config.Load(os.Args[1:])", "sql.URL = cfg.DatabaseURL", "sql.Migrate(cfg.Args[1:])", "shutdownTimeout = cfg.ShutdownTimeout", "cfg.Addr()"} {
+				if !bytes.Contains(actual, []byte(expected)) {
+					t.Errorf("generated %s application did not contain %s: \n%s", app, expected, actual)
+				}
+			}
+
+			if bytes.Contains(actual, []byte("var addr")) {
+				t.Errorf("expected the %s application to read the address from the config: \n%s", app, actual)
+			}
+		}
+
+		driver = "sqlite3"
+		if err := setupDb(templates); err != nil {
+			t.Fatalf("failed to setup database file: %s", err)
+		}
+
+		src, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "sql.go"))
+		if !bytes.Contains(src, []byte(`sql.Open("sqlite3", URL)`)) {
+			t.Errorf("expected the sql package to open the configured URL: \n%s", src)
+		}
+	})
+}
+
+func TestSaveProjectOptions(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(c, l, m, tr bool) {
+			appConfig, appLogging, appMetrics, appTracing = c, l, m, tr
+		}(appConfig, appLogging, appMetrics, appTracing)
+		appConfig, appLogging, appMetrics, appTracing = true, true, true, true
+		framework = "gin"
+
+		if err := saveProject(); err != nil {
+			t.Fatalf("failed to save project: %s", err)
+		}
+
+		project, err := loadProject(wd)
+		if err != nil {
+			t.Fatalf("failed to load project: %s", err)
+		}
+
+		if !project.Config || !project.Logging || !project.Metrics || !project.Tracing {
+			t.Errorf("expected the app options to be recorded; actual %+v", project)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package actions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"text/template"
+)
+
+// repositoryDecl matches the repositories generated for the tables of the
+// sql package
+var repositoryDecl = regexp.MustCompile(`(?m)^// (\w+)Repository reads and writes rows of the (.+) table$`)
+
+// writeStore writes sql/store.go grouping the generated repositories, along
+// with their in-memory fakes, into a Store
+func writeStore(templates *template.Template, path string) error {
+	files, err := filepath.Glob(filepath.Join(path, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	context := &Context{ORM: repositoryORM(), Replicas: replicas}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		for _, match := range repositoryDecl.FindAllSubmatch(data, -1) {
+			name, table := string(match[1]), string(match[2])
+
+			// repositories generated without a fake are left out
+			if !bytes.Contains(data, []byte("type fake"+name+"Repository struct")) {
+				continue
+			}
+			context.Models = append(context.Models, &tableModel{Name: name, Table: table, Plural: goName(table)})
+		}
+	}
+
+	if len(context.Models) == 0 {
+		return nil
+	}
+	context.Imports = groupImports(storeImports())
+	return writeSource(templates, "templates/sql/store.tpl", filepath.Join(path, "store.go"), context)
+}
+
+// storeImports lists the packages referenced by the Store using the query
+// layer of the project
+func storeImports() []string {
+	imports := []string{"context"}
+	switch orm {
+	case "sqlx":
+		return append(imports, "database/sql", "sync", "github.com/jmoiron/sqlx")
+	case "gorm":
+		return append(imports, "sync", "gorm.io/gorm")
+	case "bun":
+		return append(imports, "sync", "github.com/uptrace/bun")
+	}
+	return append(imports, "database/sql", "sync")
+}
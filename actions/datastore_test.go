@@ -0,0 +1,92 @@
+package actions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWriteStore(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d, m, e, o string, b bool) {
+			driver, module, migrator, orm, migrations = d, m, e, o, b
+		}(driver, module, migrator, orm, migrations)
+		driver, module, migrations = "postgres", "github.com/example/app", true
+		migrationDir, timestamp, migrator = defaultMigrationDir, false, ""
+
+		if err := stageMigrations(templates); err != nil {
+			t.Fatalf("failed to stage migrations: %s", err)
+		}
+
+		for _, name := range []string{"user", "order"} {
+			fields, _ := parseResourceFields([]string{"email:string"})
+			r, err := newResource(name, fields, "gin", drivers["postgres"])
+			if err != nil {
+				t.Fatalf("failed to describe the %s resource: %s", name, err)
+			}
+
+			if err := generateResource(templates, r, module, drivers["postgres"], time.Now()); err != nil {
+				t.Fatalf("failed to generate the %s resource: %s", name, err)
+			}
+		}
+
+		src, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "store.go"))
+		for _, expected := range []string{
+			"Orders OrderStore",
+			"Users  UserStore",
+			"func NewStore(conn *sql.DB) Store {",
+			"Users:  NewUserRepository(conn),",
+			"func (s Store) WithTx(ctx context.Context, fn func(tx Store) error) error {",
+			"func NewFakeStore() Store {",
+			"Users:  &fakeUserRepository{data},",
+		} {
+			if !bytes.Contains(src, []byte(expected)) {
+				t.Errorf("generated store did not contain %s: \n%s", expected, src)
+			}
+		}
+
+		repository, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "users_repository.go"))
+		for _, expected := range []string{"type UserStore interface {", "func (r *fakeUserRepository) Get(ctx context.Context, id int64) (*User, error) {"} {
+			if !bytes.Contains(repository, []byte(expected)) {
+				t.Errorf("generated repository did not contain %s: \n%s", expected, repository)
+			}
+		}
+
+		handlers, _ := ioutil.ReadFile(filepath.Join(wd, "users_handlers_test.go"))
+		if !bytes.Contains(handlers, []byte("store := sql.NewFakeStore().Users")) {
+			t.Errorf("expected the handler tests to use the fake store: \n%s", handlers)
+		}
+	})
+}
+
+func TestStoreTransactions(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(o string) { orm = o }(orm)
+
+		tests := []struct {
+			ORM      string
+			Expected []string
+		}{
+			{"sqlx", []string{"func NewStore(conn *sqlx.DB) Store {", "conn.BeginTxx(ctx, nil)", "SelectContext(ctx context.Context, dest interface{}"}},
+			{"gorm", []string{"func NewStore(conn *gorm.DB) Store {", "conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {", "func newStore(conn *gorm.DB) Store {"}},
+			{"bun", []string{"func NewStore(conn *bun.DB) Store {", "return fn(newStore(&tx))", "func newStore(conn bun.IDB) Store {"}},
+		}
+
+		for _, test := range tests {
+			orm = test.ORM
+			context := &Context{ORM: repositoryORM(), Models: []*tableModel{{Name: "User", Table: "users", Plural: "Users"}}, Imports: groupImports(storeImports())}
+			src, err := renderSource(templates, "templates/sql/store.tpl", "store.go", context)
+			if err != nil {
+				t.Fatalf("failed to render the %s store: %s", test.ORM, err)
+			}
+
+			for _, expected := range test.Expected {
+				if !bytes.Contains(src, []byte(expected)) {
+					t.Errorf("generated %s store did not contain %s: \n%s", test.ORM, expected, src)
+				}
+			}
+		}
+	})
+}
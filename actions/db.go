@@ -0,0 +1,245 @@
+package actions
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	migrate "github.com/golang-migrate/migrate/v4"
+	"github.com/pkg/errors"
+
+	// file source
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+const dsnEnvVar = "DATABASE_URL"
+
+var dsn string
+
+func init() {
+	register(cli.Command{
+		Name:  "db",
+		Usage: "run, roll back, and inspect database migrations",
+		Subcommands: []cli.Command{
+			{
+				Name:   "up",
+				Usage:  "apply all pending migrations",
+				Action: dbAction(dbUp),
+				Flags:  dbFlags(),
+			},
+			{
+				Name:      "down",
+				Usage:     "roll back the last n migrations (default: 1)",
+				ArgsUsage: "[n]",
+				Action:    dbAction(dbDown),
+				Flags:     dbFlags(),
+			},
+			{
+				Name:      "goto",
+				Usage:     "migrate up or down to a version",
+				ArgsUsage: "<version>",
+				Action:    dbAction(dbGoto),
+				Flags:     dbFlags(),
+			},
+			{
+				Name:      "force",
+				Usage:     "set the version without running migrations and clear the dirty state",
+				ArgsUsage: "<version>",
+				Action:    dbAction(dbForce),
+				Flags:     dbFlags(),
+			},
+			{
+				Name:   "status",
+				Usage:  "print the applied and pending migrations",
+				Action: dbAction(dbStatus),
+				Flags:  dbFlags(),
+			},
+			{
+				Name:   "seed",
+				Usage:  "apply the seed files of an environment that have not been applied",
+				Action: seedAction,
+				Flags: append(dbFlags(), cli.StringFlag{
+					Name:        "env",
+					Value:       defaultSeedEnv,
+					Usage:       "the seed environment",
+					Destination: &seedEnv,
+				}),
+			},
+		},
+	})
+}
+
+func dbFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:        "dsn",
+			EnvVar:      dsnEnvVar,
+			Usage:       "the database connection string (default: the generated project connection)",
+			Destination: &dsn,
+		},
+	}
+}
+
+// dbAction loads the project and connects to its database before invoking fn
+func dbAction(fn func(Migrator, *Project, cli.Args) error) func(*cli.Context) error {
+	return func(c *cli.Context) error {
+		if wd == "" {
+			wd = "."
+		}
+
+		project, err := loadProject(wd)
+		if err != nil {
+			return err
+		}
+
+		m, _, err := openMigrator(project, dsn)
+		if err != nil {
+			return err
+		}
+		defer m.Close()
+
+		return fn(m, project, c.Args())
+	}
+}
+
+// openMigrate connects golang-migrate to the project database using connStr
+func openMigrate(project *Project, connStr string) (*migrate.Migrate, *sql.DB, error) {
+	d, err := lookupDriver(project.Driver)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	withInstance, ok := migrators[project.Driver]
+	if !ok {
+		return nil, nil, errors.Errorf("the %s driver is not supported by the db commands", project.Driver)
+	}
+
+	db, err := sql.Open(d.sqlName(), connStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	instance, err := withInstance(db)
+	if err != nil {
+		db.Close()
+		return nil, nil, errors.Errorf("unable to connect to the database: %s", err)
+	}
+
+	path, err := filepath.Abs(filepath.Join(wd, project.Migrations))
+	if err != nil {
+		instance.Close()
+		return nil, nil, err
+	}
+
+	sourceURL := fmt.Sprintf("file://%s", filepath.ToSlash(path))
+	m, err := migrate.NewWithDatabaseInstance(sourceURL, project.Driver, instance)
+	if err != nil {
+		instance.Close()
+		return nil, nil, err
+	}
+	return m, db, nil
+}
+
+func dbUp(m Migrator, project *Project, _ cli.Args) error {
+	if err := checkBaseline(m, project); err != nil {
+		return err
+	}
+
+	log.Println("applying migrations...")
+	return ignoreNoChange(m.Up())
+}
+
+func dbDown(m Migrator, _ *Project, args cli.Args) error {
+	n := 1
+	if args.Present() {
+		var err error
+		if n, err = strconv.Atoi(args.First()); err != nil || n < 1 {
+			return errors.Errorf("invalid number of migrations: %s", args.First())
+		}
+	}
+
+	log.Printf("rolling back %d migration(s)...", n)
+	err := m.Steps(-n)
+	if short, ok := err.(migrate.ErrShortLimit); ok {
+		log.Printf("rolled back %d migration(s); no applied migrations remain", n-int(short.Short))
+		return nil
+	}
+	return ignoreNoChange(err)
+}
+
+func dbGoto(m Migrator, project *Project, args cli.Args) error {
+	version, err := strconv.ParseUint(args.First(), 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid migration version: %s", args.First())
+	}
+
+	if err := checkBaseline(m, project); err != nil {
+		return err
+	}
+
+	log.Printf("migrating to version %d...", version)
+	return ignoreNoChange(m.Migrate(version))
+}
+
+func dbForce(m Migrator, _ *Project, args cli.Args) error {
+	version, err := strconv.Atoi(args.First())
+	if err != nil || version < -1 {
+		return errors.Errorf("invalid migration version: %s", args.First())
+	}
+
+	log.Printf("forcing version %d...", version)
+	return m.Force(version)
+}
+
+func dbStatus(m Migrator, project *Project, _ cli.Args) error {
+	return printStatus(os.Stdout, m, project)
+}
+
+// printStatus writes the current version, dirty state, and the applied and
+// pending migrations to w
+func printStatus(w io.Writer, m Migrator, project *Project) error {
+	version, dirty, err := m.Version()
+	if err != nil && err != migrate.ErrNilVersion {
+		return err
+	}
+
+	applied := err == nil
+	if applied {
+		fmt.Fprintf(w, "version: %d\n", version)
+	} else {
+		fmt.Fprintln(w, "version: none")
+	}
+	fmt.Fprintf(w, "dirty:   %t\n", dirty)
+
+	migrationList, err := scanMigrations(filepath.Join(wd, project.Migrations))
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range migrationList {
+		if migration.Direction == "down" {
+			continue
+		}
+
+		state := "pending"
+		if applied && migration.Version <= version {
+			state = "applied"
+		}
+		fmt.Fprintf(w, "%-8s %s\n", state, migrationName(migration))
+	}
+	return nil
+}
+
+func ignoreNoChange(err error) error {
+	if err == migrate.ErrNoChange {
+		log.Println("no change")
+		return nil
+	}
+	return err
+}
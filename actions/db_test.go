@@ -0,0 +1,165 @@
+package actions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	migrate "github.com/golang-migrate/migrate/v4"
+)
+
+func TestDbCommands(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		project := stageSqliteProject(t)
+		m, _, err := openMigrator(project, dsn)
+		if err != nil {
+			t.Fatalf("failed to open migrations: %s", err)
+		}
+		defer m.Close()
+
+		steps := []struct {
+			Name   string
+			Run    func() error
+			Status []string
+		}{
+			{"status", func() error { return nil }, []string{"version: none", "pending  0001_users", "pending  0002_roles"}},
+			{"up", func() error { return dbUp(m, project, nil) }, []string{"version: 2", "applied  0001_users", "applied  0002_roles"}},
+			{"up again", func() error { return dbUp(m, project, nil) }, []string{"version: 2"}},
+			{"down", func() error { return dbDown(m, project, nil) }, []string{"version: 1", "applied  0001_users", "pending  0002_roles"}},
+			{"goto", func() error { return dbGoto(m, project, []string{"2"}) }, []string{"version: 2", "dirty:   false"}},
+			{"force", func() error { return dbForce(m, project, []string{"1"}) }, []string{"version: 1"}},
+		}
+
+		for _, step := range steps {
+			if err := step.Run(); err != nil {
+				t.Fatalf("failed to run %s: %s", step.Name, err)
+			}
+
+			var out bytes.Buffer
+			if err := printStatus(&out, m, project); err != nil {
+				t.Fatalf("failed to print status after %s: %s", step.Name, err)
+			}
+
+			for _, line := range step.Status {
+				if !strings.Contains(out.String(), line) {
+					t.Errorf("expected status after %s to contain %q: \n%s", step.Name, line, out.String())
+				}
+			}
+		}
+
+		if err := dbDown(m, project, []string{"5"}); err != nil {
+			t.Errorf("expected rolling back more migrations than applied to succeed: %s", err)
+		}
+
+		if version, _, err := m.Version(); err != migrate.ErrNilVersion {
+			t.Errorf("expected all migrations to be rolled back; actual version %d", version)
+		}
+
+		if err := dbDown(m, project, []string{"zero"}); err == nil {
+			t.Error("expected an invalid step count to generate an error")
+		}
+	})
+}
+
+func TestDbCommandsSqlite(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		// projects using the cgo-free driver are migrated through sqlite3
+		project := stageSqliteProject(t)
+		project.Driver = "sqlite"
+
+		m, _, err := openMigrator(project, dsn)
+		if err != nil {
+			t.Fatalf("failed to open migrations: %s", err)
+		}
+		defer m.Close()
+
+		if err := dbUp(m, project, nil); err != nil {
+			t.Fatalf("failed to apply migrations: %s", err)
+		}
+
+		if version, _, err := m.Version(); err != nil || version != 2 {
+			t.Errorf("expected version 2; actual %d (%v)", version, err)
+		}
+	})
+}
+
+func TestLoadProject(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d string, fs bool) { driver, fsMigrations = d, fs }(driver, fsMigrations)
+
+		driver = "mysql"
+		if err := setupDb(templates); err != nil {
+			t.Fatalf("failed to setup database file: %s", err)
+		}
+
+		project, err := loadProject(wd)
+		if err != nil {
+			t.Fatalf("failed to load project: %s", err)
+		}
+
+		if project.Driver != "mysql" || project.Migrations != defaultMigrationDir {
+			t.Errorf("expected the mysql driver to be detected; actual %+v", project)
+		}
+	})
+}
+
+func TestLoadProjectUnaliased(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		// the migrations generated before the driver descriptors
+		src := `package sql
+
+import (
+	"errors"
+	"fmt"
+
+	migrate "github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+
+	// file driver
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+)
+`
+		os.MkdirAll(filepath.Join(wd, "sql"), 0755)
+		ioutil.WriteFile(filepath.Join(wd, "sql", "migrations.go"), []byte(src), 0644)
+
+		project, err := loadProject(wd)
+		if err != nil {
+			t.Fatalf("failed to load project: %s", err)
+		}
+
+		if project.Driver != "postgres" {
+			t.Errorf("expected the postgres driver to be detected; actual %+v", project)
+		}
+	})
+}
+
+// stageSqliteProject writes a project manifest with two migrations backed by
+// a sqlite database within the staging directory
+func stageSqliteProject(t *testing.T) *Project {
+	project := &Project{
+		Driver:     "sqlite3",
+		Migrations: defaultMigrationDir,
+	}
+	if err := project.save(wd); err != nil {
+		t.Fatalf("failed to save project: %s", err)
+	}
+
+	path := filepath.Join(wd, project.Migrations)
+	os.MkdirAll(path, 0755)
+
+	files := map[string]string{
+		"0001_users.up.sql":   "CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT NOT NULL);",
+		"0001_users.down.sql": "DROP TABLE users;",
+		"0002_roles.up.sql":   "CREATE TABLE roles (id INTEGER PRIMARY KEY, name TEXT NOT NULL);",
+		"0002_roles.down.sql": "DROP TABLE roles;",
+	}
+	for name, content := range files {
+		ioutil.WriteFile(filepath.Join(path, name), []byte(content), 0644)
+	}
+
+	dsn = "file:" + filepath.Join(wd, "test.sqlite")
+	return project
+}
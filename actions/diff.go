@@ -0,0 +1,658 @@
+package actions
+
+import (
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	migrate "github.com/golang-migrate/migrate/v4"
+	"github.com/pkg/errors"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+const defaultSchemaDir = "sql/schema"
+
+var schemaDir string
+
+// schemaColumn describes a table column
+type schemaColumn struct {
+	Name    string
+	Type    string
+	NotNull bool
+	Default string
+	// AutoIncrement is whether the database generates the column value
+	AutoIncrement bool
+}
+
+// definition renders the column for use within an ALTER TABLE statement
+func (c schemaColumn) definition(d dbDriver) string {
+	def := quoteIdentifier(d, c.Name) + " " + c.Type
+	if c.NotNull {
+		def += " NOT NULL"
+	}
+	if c.Default != "" {
+		def += " DEFAULT " + c.Default
+	}
+	return def
+}
+
+// schemaTable describes a table along with the statement that creates it
+type schemaTable struct {
+	Name        string
+	Create      string
+	Columns     []schemaColumn
+	Constraints map[string]string
+	Indexes     map[string]string
+	PrimaryKey  []string
+	ForeignKeys []schemaForeignKey
+}
+
+// schemaForeignKey describes a column referencing a column of another table
+type schemaForeignKey struct {
+	Column           string
+	Table            string
+	ReferencedColumn string
+}
+
+// column looks up the named column
+func (t *schemaTable) column(name string) (schemaColumn, bool) {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return schemaColumn{}, false
+}
+
+// schemaModel lists the tables of a schema in creation order
+type schemaModel []*schemaTable
+
+// table looks up the named table
+func (s schemaModel) table(name string) *schemaTable {
+	for _, t := range s {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// schemaChange pairs the statement applying a change with the one reverting it
+type schemaChange struct {
+	Up   string
+	Down string
+}
+
+// schemaReaders describe the tables, columns, constraints and indexes of a
+// database, keyed by the supported driver name
+var schemaReaders = map[string]func(*sql.DB) (schemaModel, error){
+	"sqlite3":  readSqliteSchema,
+	"sqlite":   readSqliteSchema,
+	"postgres": readPostgresSchema,
+	"pgx":      readPostgresSchema,
+}
+
+func diffMigrationAction(c *cli.Context) error {
+	if wd == "" {
+		wd = "."
+	}
+
+	name := c.Args().First()
+	if name == "" {
+		return errors.New("a migration name is required")
+	}
+
+	project, err := loadProject(wd)
+	if err != nil {
+		return err
+	}
+	project.Migrations = migrationDir
+
+	e, err := projectEngine(project)
+	if err != nil {
+		return err
+	}
+
+	if !e.Declarative {
+		return errors.Errorf("diffing schema files requires the declarative migration engine, not %s", project.Migrator)
+	}
+
+	d, err := lookupDriver(project.Driver)
+	if err != nil {
+		return err
+	}
+
+	connStr := scratchDSN
+	if connStr == "" {
+		if !d.throwaway() {
+			return errors.Errorf("diffing %s schema files requires --dsn to an empty %s database", project.Driver, project.Driver)
+		}
+
+		dir, err := ioutil.TempDir("", "conseil-diff")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+		connStr = fmt.Sprintf("file:%s", filepath.Join(dir, "diff.sqlite"))
+	}
+
+	m, db, err := openMigrate(project, connStr)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	changes, err := diffSchemaFiles(m, db, project.Driver, filepath.Join(wd, schemaDir))
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		log.Println("the migrations are up to date with the schema")
+		return nil
+	}
+
+	migrator = project.Migrator
+	_, err = newDiffMigration(name, changes, time.Now())
+	return err
+}
+
+// newDiffMigration writes the next migration applying changes and returns the
+// version
+func newDiffMigration(name string, changes []schemaChange, now time.Time) (string, error) {
+	e, err := lookupEngine(migrator)
+	if err != nil {
+		return "", err
+	}
+
+	name, err = migrationSlug(name)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(wd, migrationDir)
+	version, err := nextVersion(e, path, now)
+	if err != nil {
+		return "", err
+	}
+
+	var up, down strings.Builder
+	for i := range changes {
+		fmt.Fprintf(&up, "%s;\n", changes[i].Up)
+		fmt.Fprintf(&down, "%s;\n", changes[len(changes)-1-i].Down)
+	}
+
+	log.Printf("creating migration %s_%s...", version, name)
+	return version, writeMigration(e, path, version, name, up.String(), down.String())
+}
+
+// diffSchemaFiles applies the migrations to an empty database, replaces the
+// resulting schema with the one declared within dir, and lists the changes
+// between the two
+func diffSchemaFiles(m *migrate.Migrate, db *sql.DB, driverName, dir string) ([]schemaChange, error) {
+	read, ok := schemaReaders[driverName]
+	if !ok {
+		return nil, errors.Errorf("schema diffs are not supported for the %s driver", driverName)
+	}
+
+	if _, _, err := m.Version(); err != migrate.ErrNilVersion {
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.New("refusing to diff the schema using a database with migrations applied")
+	}
+
+	log.Println("applying migrations...")
+	if err := ignoreNoChange(m.Up()); err != nil {
+		return nil, err
+	}
+
+	current, err := read(db)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(current) - 1; i >= 0; i-- {
+		if _, err := db.Exec(fmt.Sprintf("DROP TABLE %s", quoteIdentifier(drivers[driverName], current[i].Name))); err != nil {
+			return nil, err
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	log.Printf("applying schema files within %s...", dir)
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := db.Exec(string(data)); err != nil {
+			return nil, errors.Wrapf(err, "unable to apply %s", filepath.Base(file))
+		}
+	}
+
+	desired, err := read(db)
+	if err != nil {
+		return nil, err
+	}
+	return diffModels(current, desired, driverName)
+}
+
+// diffModels lists the changes that move the current schema to the desired one
+func diffModels(current, desired schemaModel, driverName string) ([]schemaChange, error) {
+	d := drivers[driverName]
+	changes := make([]schemaChange, 0)
+	for _, t := range desired {
+		from := current.table(t.Name)
+		if from == nil {
+			changes = append(changes, schemaChange{t.Create, "DROP TABLE " + quoteIdentifier(d, t.Name)})
+			changes = append(changes, indexChanges(d, t.Name, nil, t.Indexes)...)
+			continue
+		}
+
+		tableChanges, err := diffTable(from, t, driverName)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, tableChanges...)
+	}
+
+	for i := len(current) - 1; i >= 0; i-- {
+		t := current[i]
+		if desired.table(t.Name) == nil {
+			changes = append(changes, indexChanges(d, t.Name, t.Indexes, nil)...)
+			changes = append(changes, schemaChange{"DROP TABLE " + quoteIdentifier(d, t.Name), t.Create})
+		}
+	}
+	return changes, nil
+}
+
+// diffTable lists the column, constraint and index changes to a table
+func diffTable(from, to *schemaTable, driverName string) ([]schemaChange, error) {
+	d := drivers[driverName]
+	table := quoteIdentifier(d, to.Name)
+	changes := make([]schemaChange, 0)
+	for _, c := range to.Columns {
+		existing, ok := from.column(c.Name)
+		if !ok {
+			changes = append(changes, schemaChange{
+				fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", table, c.definition(d)),
+				fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", table, quoteIdentifier(d, c.Name)),
+			})
+			continue
+		}
+
+		if existing == c {
+			continue
+		}
+
+		if driverName == "sqlite3" || driverName == "sqlite" {
+			return nil, errors.Errorf("the %s.%s column changed, which requires rebuilding the table; write this migration by hand", to.Name, c.Name)
+		}
+		changes = append(changes, alterColumn(d, table, existing, c)...)
+	}
+
+	for i := len(from.Columns) - 1; i >= 0; i-- {
+		c := from.Columns[i]
+		if _, ok := to.column(c.Name); !ok {
+			changes = append(changes, schemaChange{
+				fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", table, quoteIdentifier(d, c.Name)),
+				fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", table, c.definition(d)),
+			})
+		}
+	}
+
+	for _, name := range changedKeys(from.Constraints, to.Constraints) {
+		if driverName == "sqlite3" || driverName == "sqlite" {
+			return nil, errors.Errorf("the %s constraint of %s changed, which requires rebuilding the table; write this migration by hand", name, to.Name)
+		}
+
+		if def, ok := from.Constraints[name]; ok {
+			changes = append(changes, schemaChange{
+				fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", table, quoteIdentifier(d, name)),
+				fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s %s", table, quoteIdentifier(d, name), def),
+			})
+		}
+		if def, ok := to.Constraints[name]; ok {
+			changes = append(changes, schemaChange{
+				fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s %s", table, quoteIdentifier(d, name), def),
+				fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", table, quoteIdentifier(d, name)),
+			})
+		}
+	}
+	return append(changes, indexChanges(d, to.Name, from.Indexes, to.Indexes)...), nil
+}
+
+// alterColumn lists the changes to the type, nullability and default of a
+// column of the quoted table
+func alterColumn(d dbDriver, table string, from, to schemaColumn) []schemaChange {
+	alter := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s ", table, quoteIdentifier(d, to.Name))
+	changes := make([]schemaChange, 0)
+	if from.Type != to.Type {
+		changes = append(changes, schemaChange{alter + "TYPE " + to.Type, alter + "TYPE " + from.Type})
+	}
+
+	if from.NotNull != to.NotNull {
+		set, drop := alter+"SET NOT NULL", alter+"DROP NOT NULL"
+		if to.NotNull {
+			changes = append(changes, schemaChange{set, drop})
+		} else {
+			changes = append(changes, schemaChange{drop, set})
+		}
+	}
+
+	if from.Default != to.Default {
+		changes = append(changes, schemaChange{setDefault(alter, to.Default), setDefault(alter, from.Default)})
+	}
+	return changes
+}
+
+func setDefault(alter, value string) string {
+	if value == "" {
+		return alter + "DROP DEFAULT"
+	}
+	return alter + "SET DEFAULT " + value
+}
+
+// indexChanges lists the indexes to drop and create, recreating any whose
+// definition changed
+func indexChanges(d dbDriver, table string, from, to map[string]string) []schemaChange {
+	changes := make([]schemaChange, 0)
+	for _, name := range changedKeys(from, to) {
+		if def, ok := from[name]; ok {
+			changes = append(changes, schemaChange{"DROP INDEX " + quoteIdentifier(d, name), def})
+		}
+		if def, ok := to[name]; ok {
+			changes = append(changes, schemaChange{def, "DROP INDEX " + quoteIdentifier(d, name)})
+		}
+	}
+	return changes
+}
+
+// changedKeys lists the sorted keys that were added, removed or whose value
+// differs between from and to
+func changedKeys(from, to map[string]string) []string {
+	keys := make([]string, 0)
+	for name, def := range from {
+		if to[name] != def {
+			keys = append(keys, name)
+		}
+	}
+	for name := range to {
+		if _, ok := from[name]; !ok {
+			keys = append(keys, name)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// readSqliteSchema reads the tables, columns and indexes from sqlite_master;
+// constraints are part of each table definition
+func readSqliteSchema(db *sql.DB) (schemaModel, error) {
+	model := make(schemaModel, 0)
+	rows, err := db.Query(`SELECT name, sql FROM sqlite_master
+WHERE type = 'table' AND name NOT LIKE 'sqlite_%' AND name NOT IN ('schema_migrations', 'schema_seeds')
+ORDER BY rowid`)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the schema")
+	}
+
+	for rows.Next() {
+		t := &schemaTable{Indexes: make(map[string]string)}
+		if err := rows.Scan(&t.Name, &t.Create); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		model = append(model, t)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, t := range model {
+		columns, err := db.Query(`SELECT name, type, "notnull", COALESCE(dflt_value, ''), pk FROM pragma_table_info(?) ORDER BY cid`, t.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make(map[int]string)
+		for columns.Next() {
+			var c schemaColumn
+			var pk int
+			if err := columns.Scan(&c.Name, &c.Type, &c.NotNull, &c.Default, &pk); err != nil {
+				columns.Close()
+				return nil, err
+			}
+
+			if pk > 0 {
+				keys[pk] = c.Name
+			}
+			t.Columns = append(t.Columns, c)
+		}
+		columns.Close()
+
+		for i := 1; i <= len(keys); i++ {
+			t.PrimaryKey = append(t.PrimaryKey, keys[i])
+		}
+
+		// a single INTEGER PRIMARY KEY column is an alias of the rowid
+		if len(t.PrimaryKey) == 1 {
+			for i := range t.Columns {
+				if t.Columns[i].Name == t.PrimaryKey[0] && strings.EqualFold(t.Columns[i].Type, "integer") {
+					t.Columns[i].AutoIncrement = true
+				}
+			}
+		}
+
+		if t.ForeignKeys, err = readForeignKeys(db, `SELECT "from", "table", COALESCE("to", '') FROM pragma_foreign_key_list(?) ORDER BY id, seq`, t.Name); err != nil {
+			return nil, err
+		}
+
+		indexes, err := db.Query(`SELECT name, sql FROM sqlite_master WHERE type = 'index' AND sql IS NOT NULL AND tbl_name = ?`, t.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		for indexes.Next() {
+			var name, def string
+			if err := indexes.Scan(&name, &def); err != nil {
+				indexes.Close()
+				return nil, err
+			}
+			t.Indexes[name] = def
+		}
+		indexes.Close()
+	}
+	return model, nil
+}
+
+// readPostgresSchema reads the tables, columns, constraints and indexes of the
+// current schema from the system catalogs
+func readPostgresSchema(db *sql.DB) (schemaModel, error) {
+	model := make(schemaModel, 0)
+	rows, err := db.Query(`SELECT c.relname FROM pg_class c
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = current_schema() AND c.relkind = 'r' AND c.relname NOT IN ('schema_migrations', 'schema_seeds')
+ORDER BY c.oid`)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the schema")
+	}
+
+	for rows.Next() {
+		t := &schemaTable{Constraints: make(map[string]string), Indexes: make(map[string]string)}
+		if err := rows.Scan(&t.Name); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		model = append(model, t)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, t := range model {
+		if err := readPostgresTable(db, t); err != nil {
+			return nil, err
+		}
+	}
+	return model, nil
+}
+
+func readPostgresTable(db *sql.DB, t *schemaTable) error {
+	// regclass parses its input as SQL, so reserved and mixed-case names are quoted
+	relation := quoteIdentifier(drivers["postgres"], t.Name)
+	columns, err := db.Query(`SELECT a.attname, format_type(a.atttypid, a.atttypmod), a.attnotnull, COALESCE(pg_get_expr(d.adbin, d.adrelid), '')
+FROM pg_attribute a
+LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+WHERE a.attrelid = $1::regclass AND a.attnum > 0 AND NOT a.attisdropped
+ORDER BY a.attnum`, relation)
+	if err != nil {
+		return err
+	}
+
+	for columns.Next() {
+		var c schemaColumn
+		if err := columns.Scan(&c.Name, &c.Type, &c.NotNull, &c.Default); err != nil {
+			columns.Close()
+			return err
+		}
+
+		// serial columns own their sequence, which is recreated with the table
+		if strings.HasPrefix(c.Default, "nextval(") {
+			c.Type = strings.Replace(strings.Replace(c.Type, "bigint", "bigserial", 1), "integer", "serial", 1)
+			c.Default = ""
+			c.AutoIncrement = true
+		}
+		t.Columns = append(t.Columns, c)
+	}
+	columns.Close()
+
+	constraints, err := db.Query(`SELECT conname, pg_get_constraintdef(oid) FROM pg_constraint
+WHERE conrelid = $1::regclass AND contype <> 'n'`, relation)
+	if err != nil {
+		return err
+	}
+
+	for constraints.Next() {
+		var name, def string
+		if err := constraints.Scan(&name, &def); err != nil {
+			constraints.Close()
+			return err
+		}
+		t.Constraints[name] = def
+	}
+	constraints.Close()
+
+	indexes, err := db.Query(`SELECT indexname, indexdef FROM pg_indexes
+WHERE schemaname = current_schema() AND tablename = $1
+AND indexname NOT IN (SELECT conname FROM pg_constraint WHERE conrelid = $2::regclass)`, t.Name, relation)
+	if err != nil {
+		return err
+	}
+
+	for indexes.Next() {
+		var name, def string
+		if err := indexes.Scan(&name, &def); err != nil {
+			indexes.Close()
+			return err
+		}
+		t.Indexes[name] = def
+	}
+	indexes.Close()
+
+	t.PrimaryKey, err = queryStrings(db, `SELECT a.attname FROM pg_index i
+JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+WHERE i.indrelid = $1::regclass AND i.indisprimary
+ORDER BY array_position(i.indkey::int2[], a.attnum)`, relation)
+	if err != nil {
+		return err
+	}
+
+	t.ForeignKeys, err = readForeignKeys(db, `SELECT a.attname, r.relname, af.attname
+FROM pg_constraint c
+JOIN pg_class r ON r.oid = c.confrelid
+CROSS JOIN LATERAL unnest(c.conkey, c.confkey) AS k(col, ref)
+JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = k.col
+JOIN pg_attribute af ON af.attrelid = c.confrelid AND af.attnum = k.ref
+WHERE c.conrelid = $1::regclass AND c.contype = 'f'
+ORDER BY c.conname`, relation)
+	if err != nil {
+		return err
+	}
+
+	lines := make([]string, 0, len(t.Columns)+len(t.Constraints))
+	for _, c := range t.Columns {
+		lines = append(lines, "    "+c.definition(drivers["postgres"]))
+	}
+	for _, name := range changedKeys(nil, t.Constraints) {
+		lines = append(lines, fmt.Sprintf("    CONSTRAINT %s %s", quoteIdentifier(drivers["postgres"], name), t.Constraints[name]))
+	}
+	t.Create = fmt.Sprintf("CREATE TABLE %s (\n%s\n)", quoteIdentifier(drivers["postgres"], t.Name), strings.Join(lines, ",\n"))
+	return nil
+}
+
+// queryStrings lists the first column of the rows returned by query
+func queryStrings(db *sql.DB, query string, args ...interface{}) ([]string, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := make([]string, 0)
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, rows.Err()
+}
+
+// readForeignKeys lists the foreign keys of table returned by query as rows
+// of the column, referenced table, and referenced column
+func readForeignKeys(db *sql.DB, query, table string) ([]schemaForeignKey, error) {
+	rows, err := db.Query(query, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]schemaForeignKey, 0)
+	for rows.Next() {
+		var fk schemaForeignKey
+		if err := rows.Scan(&fk.Column, &fk.Table, &fk.ReferencedColumn); err != nil {
+			return nil, err
+		}
+		keys = append(keys, fk)
+	}
+	return keys, rows.Err()
+}
+
+// stageSchema writes the starter schema file of a declarative project
+func stageSchema(templates *template.Template) error {
+	path := filepath.Join(wd, defaultSchemaDir)
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+
+	schema, _ := os.Create(filepath.Join(path, "schema.sql"))
+	return templates.Lookup("templates/sql/schema.tpl").Execute(schema, nil)
+}
@@ -0,0 +1,144 @@
+package actions
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/n3integration/conseil"
+)
+
+func TestDiffSchemaFiles(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		project := stageSqliteProject(t)
+		schema := filepath.Join(wd, defaultSchemaDir)
+		os.MkdirAll(schema, 0755)
+		files := map[string]string{
+			"01_users.sql": "CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT NOT NULL);\nCREATE INDEX users_name ON users (name);",
+			"02_posts.sql": "CREATE TABLE posts (id INTEGER PRIMARY KEY, title TEXT);",
+			"03_order.sql": "CREATE TABLE \"order\" (id INTEGER PRIMARY KEY);",
+		}
+		for name, content := range files {
+			ioutil.WriteFile(filepath.Join(schema, name), []byte(content), 0644)
+		}
+
+		m, db, err := openMigrate(project, dsn)
+		if err != nil {
+			t.Fatalf("failed to open migrations: %s", err)
+		}
+		defer m.Close()
+
+		changes, err := diffSchemaFiles(m, db, project.Driver, schema)
+		if err != nil {
+			t.Fatalf("failed to diff the schema: %s", err)
+		}
+
+		expected := []string{"CREATE INDEX users_name", "CREATE TABLE posts", "CREATE TABLE \"order\"", "DROP TABLE roles"}
+		if len(changes) != len(expected) {
+			t.Fatalf("expected %d changes; actual %v", len(expected), changes)
+		}
+
+		for i, change := range changes {
+			if !strings.HasPrefix(change.Up, expected[i]) {
+				t.Errorf("expected change %d to start with %s; actual %s", i, expected[i], change.Up)
+			}
+		}
+
+		if changes[2].Down != `DROP TABLE "order"` {
+			t.Errorf("expected the reserved table to be dropped quoted; actual %s", changes[2].Down)
+		}
+
+		if _, err := diffSchemaFiles(m, db, project.Driver, schema); err == nil {
+			t.Error("expected a migrated database to generate an error")
+		}
+	})
+}
+
+func TestDiffTable(t *testing.T) {
+	from := &schemaTable{
+		Name:    "users",
+		Columns: []schemaColumn{{Name: "id", Type: "integer", NotNull: true}},
+		Indexes: map[string]string{},
+	}
+	to := &schemaTable{
+		Name:    "users",
+		Columns: []schemaColumn{{Name: "id", Type: "bigint", NotNull: true}, {Name: "email", Type: "text", Default: "''"}},
+		Indexes: map[string]string{},
+	}
+
+	changes, err := diffTable(from, to, "postgres")
+	if err != nil {
+		t.Fatalf("failed to diff the table: %s", err)
+	}
+
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes; actual %v", changes)
+	}
+
+	if _, err := diffTable(from, to, "sqlite3"); err == nil {
+		t.Error("expected a sqlite column change to generate an error")
+	}
+}
+
+func TestDiffTableQuoted(t *testing.T) {
+	from := &schemaTable{
+		Name:        "order",
+		Columns:     []schemaColumn{{Name: "id", Type: "integer"}, {Name: "desc", Type: "text"}},
+		Constraints: map[string]string{"Order_Check": "CHECK (id > 0)"},
+		Indexes:     map[string]string{"Order_Id": "CREATE INDEX \"Order_Id\" ON \"order\" (id)"},
+	}
+	to := &schemaTable{
+		Name:        "order",
+		Columns:     []schemaColumn{{Name: "id", Type: "integer"}, {Name: "user", Type: "text"}},
+		Constraints: map[string]string{},
+		Indexes:     map[string]string{},
+	}
+
+	changes, err := diffTable(from, to, "postgres")
+	if err != nil {
+		t.Fatalf("failed to diff the table: %s", err)
+	}
+
+	var up []string
+	for _, change := range changes {
+		up = append(up, change.Up)
+	}
+
+	for _, expected := range []string{
+		`ALTER TABLE "order" ADD COLUMN "user" text`,
+		`ALTER TABLE "order" DROP COLUMN "desc"`,
+		`ALTER TABLE "order" DROP CONSTRAINT "Order_Check"`,
+		`DROP INDEX "Order_Id"`,
+	} {
+		if !strings.Contains(strings.Join(up, "\n"), expected) {
+			t.Errorf("expected the changes to contain %s; actual %v", expected, up)
+		}
+	}
+}
+
+func TestNewDiffMigration(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		migrationDir, timestamp = defaultMigrationDir, false
+		changes := []schemaChange{
+			{Up: "CREATE TABLE users (id INTEGER)", Down: "DROP TABLE users"},
+			{Up: "CREATE INDEX users_id ON users (id)", Down: "DROP INDEX users_id"},
+		}
+
+		version, err := newDiffMigration("users", changes, time.Now())
+		if err != nil {
+			t.Fatalf("failed to create migration: %s", err)
+		}
+
+		down, _ := ioutil.ReadFile(filepath.Join(wd, migrationDir, version+"_users.down.sql"))
+		if string(down) != "DROP INDEX users_id;\nDROP TABLE users;\n" {
+			t.Errorf("expected the down migration to revert the changes in reverse: \n%s", down)
+		}
+
+		if !conseil.FileExists(filepath.Join(wd, migrationDir, version+"_users.up.sql")) {
+			t.Errorf("expected %s_users.up.sql to be created", version)
+		}
+	})
+}
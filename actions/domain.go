@@ -0,0 +1,758 @@
+package actions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"gopkg.in/urfave/cli.v1"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	defaultDomainFile = "domain.yaml"
+	domainLockFile    = "domain.lock"
+	generatedHeader   = "// Code generated by conseil generate domain. DO NOT EDIT.\n\n"
+)
+
+var (
+	domainFile      string
+	domainMigration string
+)
+
+// domainSpec declares the entities of a domain.yaml file
+type domainSpec struct {
+	Entities []domainEntity `yaml:"entities"`
+}
+
+// domainEntity declares a table along with its model, repository, and
+// handlers
+type domainEntity struct {
+	Name      string           `yaml:"name"`
+	Fields    []domainField    `yaml:"fields"`
+	Indexes   []domainIndex    `yaml:"indexes"`
+	Relations []domainRelation `yaml:"relations"`
+}
+
+// domainField declares a column of an entity
+type domainField struct {
+	Name     string           `yaml:"name"`
+	Type     string           `yaml:"type"`
+	Unique   bool             `yaml:"unique"`
+	Index    bool             `yaml:"index"`
+	Null     bool             `yaml:"nullable"`
+	Default  string           `yaml:"default"`
+	Example  string           `yaml:"example"`
+	Validate domainValidation `yaml:"validate"`
+}
+
+// domainValidation declares the checks of a field; min and max bound the
+// length of strings and the value of numbers
+type domainValidation struct {
+	Required bool     `yaml:"required"`
+	Min      *float64 `yaml:"min"`
+	Max      *float64 `yaml:"max"`
+	Pattern  string   `yaml:"pattern"`
+}
+
+// domainIndex declares an index spanning the columns of an entity
+type domainIndex struct {
+	Columns []string `yaml:"columns"`
+	Unique  bool     `yaml:"unique"`
+}
+
+// domainRelation declares a single relation of an entity to another
+type domainRelation struct {
+	BelongsTo  string `yaml:"belongs_to"`
+	HasMany    string `yaml:"has_many"`
+	ManyToMany string `yaml:"many_to_many"`
+	// Optional is whether a belongs_to reference may be null
+	Optional bool `yaml:"optional"`
+}
+
+// domainLock records the schema generated by the previous run, which the
+// next run diffs against to emit an incremental migration
+type domainLock struct {
+	Entities []string    `json:"entities"`
+	Tables   schemaModel `json:"tables"`
+}
+
+// domainResource pairs the resource generated for an entity with its model
+type domainResource struct {
+	Resource *resourceSpec
+	Model    *tableModel
+}
+
+func generateDomainAction(_ *cli.Context) error {
+	if wd == "" {
+		wd = "."
+	}
+
+	project, err := loadProject(wd)
+	if err != nil {
+		return err
+	}
+
+	if project.Driver == "" {
+		return errors.New("generating a domain requires a project with database migrations")
+	}
+
+	if project.Framework == "" {
+		return errors.New("unable to determine the app framework of the project")
+	}
+
+	d, err := lookupDriver(project.Driver)
+	if err != nil {
+		return err
+	}
+
+	if _, err := projectEngine(project); err != nil {
+		return err
+	}
+
+	if _, err := projectORM(project); err != nil {
+		return err
+	}
+
+	module, err := projectModule(wd)
+	if err != nil {
+		return err
+	}
+
+	spec, err := readDomain(filepath.Join(wd, domainFile))
+	if err != nil {
+		return err
+	}
+
+	migrator, orm, replicas, appLogging = project.Migrator, project.ORM, project.Replicas, project.Logging
+	return generateDomain(parseTemplates(), spec, project.Framework, module, d, time.Now())
+}
+
+// readDomain parses a domain file, rejecting unknown keys
+func readDomain(file string) (*domainSpec, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &domainSpec{}
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse %s", filepath.Base(file))
+	}
+
+	if len(spec.Entities) == 0 {
+		return nil, errors.Errorf("%s does not declare any entities", filepath.Base(file))
+	}
+	return spec, nil
+}
+
+// generateDomain writes the migration moving the previously generated schema
+// to the one declared by spec, regenerates the models, repositories, and
+// handlers of its entities, and removes those of dropped entities
+func generateDomain(templates *template.Template, spec *domainSpec, framework, module string, d dbDriver, now time.Time) error {
+	if !resourceFrameworks[framework] {
+		return errors.Errorf("generating resource handlers is not supported for the %s framework", framework)
+	}
+
+	e, err := lookupEngine(migrator)
+	if err != nil {
+		return err
+	}
+
+	desired, resources, err := buildDomain(spec, framework, d)
+	if err != nil {
+		return err
+	}
+
+	lock, err := readDomainLock(wd)
+	if err != nil {
+		return err
+	}
+
+	changes, err := diffModels(lock.Tables, desired, d.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		log.Println("the migrations are up to date with the domain")
+	} else if _, err := newDiffMigration(domainMigration, changes, now); err != nil {
+		return err
+	}
+
+	entities := make([]string, len(resources))
+	for i, r := range resources {
+		entities[i] = r.Model.Table
+	}
+
+	previous := lock.Entities
+	lock = &domainLock{Entities: entities, Tables: desired}
+	if err := lock.save(wd); err != nil {
+		return err
+	}
+
+	if e.Declarative {
+		var schema strings.Builder
+		for _, t := range desired {
+			schema.WriteString(tableStatements(t))
+		}
+
+		if err := os.MkdirAll(filepath.Join(wd, defaultSchemaDir), 0755); err != nil {
+			return err
+		}
+
+		log.Println("creating schema domain.sql...")
+		if err := ioutil.WriteFile(filepath.Join(wd, defaultSchemaDir, "domain.sql"), []byte(schema.String()), 0644); err != nil {
+			return err
+		}
+	}
+
+	path := filepath.Join(wd, "sql")
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+
+	for _, r := range resources {
+		context := resourceContext(r.Resource, r.Model, module)
+		files := resourceFiles(r.Model.Table)
+		templateFiles := map[string]string{
+			"repository":    "templates/sql/repository.tpl",
+			"handlers":      fmt.Sprintf("templates/resource/%s.tpl", framework),
+			"handlers_test": "templates/resource/handlers_test.tpl",
+			"ent_schema":    "templates/sql/ent/schema.tpl",
+		}
+
+		log.Printf("generating %s...", r.Model.Table)
+		for _, kind := range []string{"repository", "handlers", "handlers_test", "ent_schema"} {
+			if _, ok := files[kind]; !ok {
+				continue
+			}
+
+			if err := writeGenerated(templates, templateFiles[kind], files[kind], context); err != nil {
+				return err
+			}
+		}
+
+		if err := registerRoutes(filepath.Join(wd, "app.go"), r.Model); err != nil {
+			return err
+		}
+	}
+
+	if err := writeDBAccessor(templates, path); err != nil {
+		return err
+	}
+
+	for _, table := range previous {
+		if desired.table(table) != nil {
+			continue
+		}
+
+		log.Printf("removing %s...", table)
+		for _, file := range resourceFiles(table) {
+			if err := removeGenerated(file); err != nil {
+				return err
+			}
+		}
+
+		if err := unregisterRoutes(filepath.Join(wd, "app.go"), table); err != nil {
+			return err
+		}
+	}
+	return writeStore(templates, path)
+}
+
+// buildDomain describes the tables declared by spec in dependency order,
+// along with the resources generated for its entities
+func buildDomain(spec *domainSpec, framework string, d dbDriver) (schemaModel, []*domainResource, error) {
+	tables := make(schemaModel, 0, len(spec.Entities))
+	fields := make(map[string][]domainField)
+	entities := make(map[string]*schemaTable)
+	for _, entity := range spec.Entities {
+		name, err := resourceTable(entity.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if entities[name] != nil {
+			return nil, nil, errors.Errorf("the %s entity is declared more than once", entity.Name)
+		}
+
+		args := make([]string, len(entity.Fields))
+		for i, f := range entity.Fields {
+			args[i] = f.Name + ":" + f.Type
+		}
+
+		parsed, err := parseResourceFields(args)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid %s entity", entity.Name)
+		}
+
+		for i, f := range entity.Fields {
+			parsed[i].Unique, parsed[i].Index, parsed[i].Null, parsed[i].Default = f.Unique, f.Index, f.Null, f.Default
+		}
+
+		t, err := newResourceTable(name, parsed, d)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid %s entity", entity.Name)
+		}
+
+		tables = append(tables, t)
+		entities[name] = t
+		fields[name] = entity.Fields
+	}
+
+	lookup := func(entity, name string) (*schemaTable, error) {
+		table, err := resourceTable(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if entities[table] == nil {
+			return nil, errors.Errorf("the %s entity relates to the undeclared %s entity", entity, name)
+		}
+		return entities[table], nil
+	}
+
+	belongs := make(map[string]bool)
+	joins := make(map[string]bool)
+	links := make(map[string][]*schemaTable)
+	for i, entity := range spec.Entities {
+		t := tables[i]
+		for _, relation := range entity.Relations {
+			var err error
+			switch {
+			case relation.BelongsTo != "" && relation.HasMany == "" && relation.ManyToMany == "":
+				var parent *schemaTable
+				if parent, err = lookup(entity.Name, relation.BelongsTo); err == nil {
+					err = addReference(d, t, parent, relation.Optional, belongs)
+				}
+			case relation.HasMany != "" && relation.BelongsTo == "" && relation.ManyToMany == "":
+				var child *schemaTable
+				if child, err = lookup(entity.Name, relation.HasMany); err == nil {
+					err = addReference(d, child, t, relation.Optional, belongs)
+				}
+			case relation.ManyToMany != "" && relation.BelongsTo == "" && relation.HasMany == "":
+				var other *schemaTable
+				if other, err = lookup(entity.Name, relation.ManyToMany); err == nil {
+					var join *schemaTable
+					if join, err = joinTable(d, t, other, joins); join != nil {
+						tables = append(tables, join)
+						links[t.Name] = append(links[t.Name], join)
+						links[other.Name] = append(links[other.Name], join)
+					}
+				}
+			default:
+				err = errors.New("each relation must declare exactly one of belongs_to, has_many, or many_to_many")
+			}
+
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "invalid %s entity", entity.Name)
+			}
+		}
+	}
+
+	for i, entity := range spec.Entities {
+		t := tables[i]
+		for _, index := range entity.Indexes {
+			if len(index.Columns) == 0 {
+				return nil, nil, errors.Errorf("an index of the %s entity does not declare any columns", entity.Name)
+			}
+
+			for _, c := range index.Columns {
+				if _, ok := t.column(c); !ok {
+					return nil, nil, errors.Errorf("an index of the %s entity references the undeclared %s column", entity.Name, c)
+				}
+			}
+			addIndex(d, t, index.Unique, index.Columns...)
+		}
+	}
+
+	for _, t := range tables {
+		t.Create = createStatement(d, t)
+	}
+
+	resources := make([]*domainResource, 0, len(spec.Entities))
+	for _, t := range tables[:len(spec.Entities)] {
+		m := newTableModel(t, d)
+		for _, fk := range t.ForeignKeys {
+			name := goName(fk.Column)
+			finder := modelFinder{
+				Name:   "ListBy" + name,
+				Column: fk.Column,
+				Param:  goParam(name),
+				Query:  fmt.Sprintf("SELECT %s FROM %s WHERE %s = %s ORDER BY id", m.Columns, quoteIdentifier(d, t.Name), quoteIdentifier(d, fk.Column), bindVar(d, 1)),
+			}
+
+			for _, f := range m.Fields {
+				if f.Column == fk.Column {
+					finder.Field, finder.Null = f.Name, strings.HasPrefix(f.Type, "sql.Null")
+				}
+			}
+			m.Finders = append(m.Finders, finder)
+		}
+
+		for _, join := range links[t.Name] {
+			m.Links = append(m.Links, newModelLink(d, t, join, entities))
+		}
+
+		if err := addValidations(m, fields[t.Name]); err != nil {
+			return nil, nil, err
+		}
+		resources = append(resources, &domainResource{newResourceSpec(t, framework), m})
+	}
+	return dependencyOrder(tables), resources, nil
+}
+
+// addReference adds a column to child referencing the id of parent, named
+// after the parent row
+func addReference(d dbDriver, child, parent *schemaTable, optional bool, added map[string]bool) error {
+	column := singular(parent.Name) + "_id"
+	if added[child.Name+"."+column] {
+		return nil
+	}
+	added[child.Name+"."+column] = true
+
+	if _, ok := child.column(column); ok {
+		return errors.Errorf("the %s column of the %s reference is already declared", column, parent.Name)
+	}
+
+	child.Columns = append(child.Columns, schemaColumn{Name: column, Type: referenceType(d), NotNull: !optional})
+	child.ForeignKeys = append(child.ForeignKeys, schemaForeignKey{Column: column, Table: parent.Name, ReferencedColumn: "id"})
+	child.Constraints[fmt.Sprintf("%s_%s_fkey", child.Name, column)] = fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (id)", column, quoteIdentifier(d, parent.Name))
+	addIndex(d, child, false, column)
+	return nil
+}
+
+// joinTable describes the table joining the rows of two entities, or nil
+// when the relation was already declared by the other entity
+func joinTable(d dbDriver, owner, other *schemaTable, added map[string]bool) (*schemaTable, error) {
+	if owner == other {
+		return nil, errors.Errorf("the %s entity cannot be related to itself many to many", owner.Name)
+	}
+
+	pair := []string{owner.Name, other.Name}
+	sort.Strings(pair)
+	if added[strings.Join(pair, ".")] {
+		return nil, nil
+	}
+	added[strings.Join(pair, ".")] = true
+
+	join := &schemaTable{
+		Name:        singular(owner.Name) + "_" + other.Name,
+		Constraints: make(map[string]string),
+		Indexes:     make(map[string]string),
+	}
+
+	for _, t := range []*schemaTable{owner, other} {
+		column := singular(t.Name) + "_id"
+		join.Columns = append(join.Columns, schemaColumn{Name: column, Type: referenceType(d), NotNull: true})
+		join.PrimaryKey = append(join.PrimaryKey, column)
+		join.ForeignKeys = append(join.ForeignKeys, schemaForeignKey{Column: column, Table: t.Name, ReferencedColumn: "id"})
+		join.Constraints[fmt.Sprintf("%s_%s_fkey", join.Name, column)] = fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (id) ON DELETE CASCADE", column, quoteIdentifier(d, t.Name))
+	}
+
+	// the primary key indexes the owner first
+	addIndex(d, join, false, join.PrimaryKey[1])
+	return join, nil
+}
+
+// referenceType is the column type referencing a generated id
+func referenceType(d dbDriver) string {
+	if d.throwaway() {
+		return "INTEGER"
+	}
+	return "BIGINT"
+}
+
+// newModelLink describes the methods of owner linking the rows of the other
+// table joined by join
+func newModelLink(d dbDriver, owner, join *schemaTable, entities map[string]*schemaTable) modelLink {
+	ownerKey, otherKey := join.ForeignKeys[0], join.ForeignKeys[1]
+	if ownerKey.Table != owner.Name {
+		ownerKey, otherKey = otherKey, ownerKey
+	}
+
+	other := newTableModel(entities[otherKey.Table], d)
+	table, joinName := quoteIdentifier(d, other.Table), quoteIdentifier(d, join.Name)
+	columns := make([]string, len(other.Fields))
+	for i, f := range other.Fields {
+		columns[i] = table + "." + quoteIdentifier(d, f.Column)
+	}
+
+	return modelLink{
+		Model:       other.Name,
+		Plural:      goName(other.Table),
+		Table:       other.Table,
+		Join:        join.Name,
+		Reversed:    ownerKey.Column != join.ForeignKeys[0].Column,
+		OwnerParam:  goParam(goName(ownerKey.Column)),
+		OtherParam:  goParam(goName(otherKey.Column)),
+		InsertQuery: fmt.Sprintf("INSERT INTO %s (%s, %s) VALUES (%s, %s)", joinName, ownerKey.Column, otherKey.Column, bindVar(d, 1), bindVar(d, 2)),
+		DeleteQuery: fmt.Sprintf("DELETE FROM %s WHERE %s = %s AND %s = %s", joinName, ownerKey.Column, bindVar(d, 1), otherKey.Column, bindVar(d, 2)),
+		ListQuery: fmt.Sprintf("SELECT %s FROM %s JOIN %s ON %s.%s = %s.id WHERE %s.%s = %s ORDER BY %s.id",
+			strings.Join(columns, ", "), table, joinName, joinName, otherKey.Column, table, joinName, ownerKey.Column, bindVar(d, 1), table),
+	}
+}
+
+// addValidations adds the checks declared by the fields of an entity to its
+// model, along with a sample body passing them for the handler tests
+func addValidations(m *tableModel, fields []domainField) error {
+	sample := make(map[string]interface{})
+	for _, f := range fields {
+		var field modelField
+		for _, mf := range m.Fields {
+			if mf.Column == f.Name {
+				field = mf
+			}
+		}
+
+		validations, zeroInvalid, err := fieldValidations(m, field, f)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s.%s validation", m.Table, f.Name)
+		}
+		m.Validations = append(m.Validations, validations...)
+		m.ZeroInvalid = m.ZeroInvalid || zeroInvalid
+
+		if len(validations) > 0 && !f.Null {
+			value, err := sampleValue(field, f)
+			if err != nil {
+				return errors.Wrapf(err, "invalid %s.%s example", m.Table, f.Name)
+			}
+			sample[f.Name] = value
+		}
+	}
+
+	if len(sample) > 0 {
+		data, err := json.Marshal(sample)
+		if err != nil {
+			return err
+		}
+		m.Sample = string(data)
+	}
+	return nil
+}
+
+// fieldValidations renders the checks of a field and whether its zero value
+// fails them
+func fieldValidations(m *tableModel, field modelField, f domainField) ([]modelValidation, bool, error) {
+	v := f.Validate
+	value, guard := "m."+field.Name, ""
+	kind := strings.TrimPrefix(field.Type, "sql.Null")
+	if strings.HasPrefix(field.Type, "sql.Null") {
+		guard = value + ".Valid && "
+		value += "." + kind
+		kind = strings.ToLower(kind)
+	}
+
+	validations := make([]modelValidation, 0)
+	zeroInvalid := false
+	if v.Required {
+		zeroInvalid = true
+		var check string
+		switch {
+		case guard != "":
+			return nil, false, errors.New("a null field cannot be required")
+		case kind == "string":
+			check = value + ` == ""`
+		case kind == "int64", kind == "float64":
+			check = value + " == 0"
+		case field.Type == "time.Time":
+			check = value + ".IsZero()"
+		default:
+			return nil, false, errors.Errorf("required is not supported by %s fields", f.Type)
+		}
+		validations = append(validations, modelValidation{Check: check, Message: f.Name + " is required"})
+	}
+
+	bounds := []struct {
+		Limit *float64
+		Op    string
+		Word  string
+	}{{v.Min, "<", "least"}, {v.Max, ">", "most"}}
+	for _, bound := range bounds {
+		if bound.Limit == nil {
+			continue
+		}
+		limit := *bound.Limit
+
+		switch kind {
+		case "string":
+			if limit < 0 || limit != math.Trunc(limit) {
+				return nil, false, errors.New("the length of a string must be bounded by a whole number")
+			}
+			validations = append(validations, modelValidation{
+				Check:   fmt.Sprintf("%sutf8.RuneCountInString(%s) %s %d", guard, value, bound.Op, int(limit)),
+				Message: fmt.Sprintf("%s must be at %s %d characters", f.Name, bound.Word, int(limit)),
+			})
+			zeroInvalid = zeroInvalid || (guard == "" && bound.Op == "<" && limit > 0)
+		case "int64", "float64":
+			if kind == "int64" && limit != math.Trunc(limit) {
+				return nil, false, errors.New("an integer must be bounded by a whole number")
+			}
+			n := strconv.FormatFloat(limit, 'f', -1, 64)
+			validations = append(validations, modelValidation{
+				Check:   fmt.Sprintf("%s%s %s %s", guard, value, bound.Op, n),
+				Message: fmt.Sprintf("%s must be at %s %s", f.Name, bound.Word, n),
+			})
+			zeroInvalid = zeroInvalid || (guard == "" && ((bound.Op == "<" && 0 < limit) || (bound.Op == ">" && 0 > limit)))
+		default:
+			return nil, false, errors.Errorf("min and max are not supported by %s fields", f.Type)
+		}
+	}
+
+	if v.Pattern != "" {
+		if kind != "string" {
+			return nil, false, errors.Errorf("pattern is not supported by %s fields", f.Type)
+		}
+
+		re, err := regexp.Compile(v.Pattern)
+		if err != nil {
+			return nil, false, err
+		}
+
+		patternVar := lowerCamel(m.Name) + field.Name + "Pattern"
+		validations = append(validations, modelValidation{
+			Check:      fmt.Sprintf("%s!%s.MatchString(%s)", guard, patternVar, value),
+			Message:    fmt.Sprintf("%s is invalid", f.Name),
+			Pattern:    v.Pattern,
+			PatternVar: patternVar,
+		})
+		zeroInvalid = zeroInvalid || (guard == "" && !re.MatchString(""))
+	}
+	return validations, zeroInvalid, nil
+}
+
+// sampleValue is the example value of a field, or a value satisfying its
+// validations otherwise
+func sampleValue(field modelField, f domainField) (interface{}, error) {
+	v := f.Validate
+	switch field.Type {
+	case "string":
+		if f.Example != "" {
+			return f.Example, nil
+		}
+
+		if v.Pattern != "" {
+			return nil, errors.New("an example value is required to test a pattern")
+		}
+
+		n := 1
+		if v.Min != nil && int(*v.Min) > n {
+			n = int(*v.Min)
+		}
+		return strings.Repeat("x", n), nil
+	case "int64", "float64":
+		if f.Example != "" {
+			return strconv.ParseFloat(f.Example, 64)
+		}
+
+		n := 1.0
+		if v.Min != nil {
+			n = *v.Min
+		}
+		if v.Max != nil && n > *v.Max {
+			n = *v.Max
+		}
+		return n, nil
+	case "time.Time":
+		if f.Example != "" {
+			return f.Example, nil
+		}
+		return "2020-01-01T00:00:00Z", nil
+	}
+	return nil, errors.Errorf("unable to sample %s fields", f.Type)
+}
+
+// readDomainLock reads the schema generated by the previous run within dir
+func readDomainLock(dir string) (*domainLock, error) {
+	lock := &domainLock{}
+	data, err := ioutil.ReadFile(filepath.Join(dir, domainLockFile))
+	if os.IsNotExist(err) {
+		return lock, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, lock); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse %s", domainLockFile)
+	}
+	return lock, nil
+}
+
+// save writes the domain lock to dir
+func (l *domainLock) save(dir string) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, domainLockFile), append(data, '\n'), 0644)
+}
+
+// generated reports whether file exists and was generated from the domain
+func generated(file string) (bool, error) {
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return bytes.HasPrefix(data, []byte(generatedHeader)), nil
+}
+
+// writeGenerated renders the named template to file as generated Go source,
+// leaving files that no longer carry the generated header to their owners
+func writeGenerated(templates *template.Template, name, file string, context *Context) error {
+	if _, err := os.Stat(file); err == nil {
+		if ok, err := generated(file); err != nil {
+			return err
+		} else if !ok {
+			log.Printf("skipping %s, which is not generated from the domain", file)
+			return nil
+		}
+	}
+
+	src, err := renderSource(templates, name, file, context)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, append([]byte(generatedHeader), src...), 0644)
+}
+
+// removeGenerated removes file when it was generated from the domain
+func removeGenerated(file string) error {
+	ok, err := generated(file)
+	if err != nil || !ok {
+		return err
+	}
+	return os.Remove(file)
+}
+
+// unregisterRoutes removes the route registration of the resource served
+// from table added to app.go by registerRoutes
+func unregisterRoutes(file, table string) error {
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	registration := regexp.MustCompile(fmt.Sprintf(`\n[ \t]*// Register %s endpoints\n[ \t]*register%sRoutes\([^\n]*\)\n`, regexp.QuoteMeta(table), modelName(table)))
+	if !registration.Match(data) {
+		return nil
+	}
+	return ioutil.WriteFile(file, registration.ReplaceAll(data, nil), 0644)
+}
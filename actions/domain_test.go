@@ -0,0 +1,234 @@
+package actions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/n3integration/conseil"
+)
+
+const testDomain = `
+entities:
+  - name: author
+    fields:
+      - name: name
+        type: string
+        validate:
+          required: true
+          max: 100
+      - name: email
+        type: string
+        unique: true
+        example: ada@example.com
+        validate:
+          pattern: '^[^@]+@[^@]+$'
+    relations:
+      - has_many: post
+  - name: post
+    fields:
+      - name: title
+        type: string
+      - name: rating
+        type: int
+        nullable: true
+        validate:
+          min: 1
+          max: 5
+    indexes:
+      - columns: [author_id, title]
+        unique: true
+    relations:
+      - many_to_many: tag
+  - name: tag
+    fields:
+      - name: label
+        type: string
+`
+
+func writeDomain(t *testing.T, src string) *domainSpec {
+	file := filepath.Join(wd, defaultDomainFile)
+	if err := ioutil.WriteFile(file, []byte(src), 0644); err != nil {
+		t.Fatalf("failed to write the domain file: %s", err)
+	}
+
+	spec, err := readDomain(file)
+	if err != nil {
+		t.Fatalf("failed to read the domain file: %s", err)
+	}
+	return spec
+}
+
+func TestBuildDomain(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		spec := writeDomain(t, testDomain)
+		tables, resources, err := buildDomain(spec, "gin", drivers["sqlite3"])
+		if err != nil {
+			t.Fatalf("failed to build the domain: %s", err)
+		}
+
+		names := make([]string, len(tables))
+		for i, table := range tables {
+			names[i] = table.Name
+		}
+
+		if strings.Join(names, ",") != "authors,posts,tags,post_tags" {
+			t.Errorf("unexpected table order: %v", names)
+		}
+
+		posts := tables.table("posts")
+		if c, ok := posts.column("author_id"); !ok || c.Type != "INTEGER" || !c.NotNull {
+			t.Errorf("expected posts to reference authors: %+v", posts.Columns)
+		}
+
+		for _, index := range []string{"posts_author_id_idx", "posts_author_id_title_key"} {
+			if _, ok := posts.Indexes[index]; !ok {
+				t.Errorf("expected the %s index: %v", index, posts.Indexes)
+			}
+		}
+
+		if join := tables.table("post_tags"); len(join.PrimaryKey) != 2 || !strings.Contains(join.Create, "ON DELETE CASCADE") {
+			t.Errorf("unexpected join table: \n%s", join.Create)
+		}
+
+		author, post, tag := resources[0].Model, resources[1].Model, resources[2].Model
+		if len(post.Finders) != 1 || post.Finders[0].Name != "ListByAuthorID" {
+			t.Errorf("unexpected finders: %+v", post.Finders)
+		}
+
+		if len(post.Links) != 1 || post.Links[0].Model != "Tag" || len(tag.Links) != 1 || tag.Links[0].Plural != "Posts" {
+			t.Errorf("unexpected links: %+v %+v", post.Links, tag.Links)
+		}
+
+		if len(author.Validations) != 3 || !author.ZeroInvalid || author.Sample != `{"email":"ada@example.com","name":"x"}` {
+			t.Errorf("unexpected author validations: %+v", author)
+		}
+
+		if len(post.Validations) != 2 || post.ZeroInvalid || !strings.HasPrefix(post.Validations[0].Check, "m.Rating.Valid && ") {
+			t.Errorf("unexpected post validations: %+v", post.Validations)
+		}
+
+		invalid := []string{
+			"entities:\n  - name: post\n    relations:\n      - belongs_to: author\n",
+			"entities:\n  - name: tag\n    relations:\n      - many_to_many: tag\n",
+			"entities:\n  - name: tag\n    fields:\n      - name: active\n        type: bool\n        validate:\n          required: true\n",
+			"entities:\n  - name: tag\n    fields:\n      - name: label\n        type: string\n        validate:\n          pattern: ^a\n",
+			"entities:\n  - name: tag\n    indexes:\n      - columns: [label]\n",
+		}
+
+		for _, src := range invalid {
+			if _, _, err := buildDomain(writeDomain(t, src), "gin", drivers["sqlite3"]); err == nil {
+				t.Errorf("expected the domain to generate an error: \n%s", src)
+			}
+		}
+
+		if err := ioutil.WriteFile(filepath.Join(wd, defaultDomainFile), []byte("entities:\n  - name: tag\n    colour: red\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := readDomain(filepath.Join(wd, defaultDomainFile)); err == nil {
+			t.Error("expected an unknown key to generate an error")
+		}
+	})
+}
+
+func TestGenerateDomain(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d, f, m, e string, b bool) {
+			driver, framework, module, migrator, migrations = d, f, m, e, b
+		}(driver, framework, module, migrator, migrations)
+		driver, framework, module, migrations = "sqlite3", "gin", "github.com/example/app", true
+		migrationDir, timestamp, migrator, domainMigration = defaultMigrationDir, false, "", "domain"
+
+		if err := createWebApp(templates); err != nil {
+			t.Fatalf("failed to create web application: %s", err)
+		}
+
+		if err := stageMigrations(templates); err != nil {
+			t.Fatalf("failed to stage migrations: %s", err)
+		}
+
+		if err := setupDb(templates); err != nil {
+			t.Fatalf("failed to setup database file: %s", err)
+		}
+
+		spec := writeDomain(t, testDomain)
+		if err := generateDomain(templates, spec, "gin", module, drivers["sqlite3"], time.Now()); err != nil {
+			t.Fatalf("failed to generate the domain: %s", err)
+		}
+
+		for _, file := range []string{"domain.lock", "sql/migrations/0002_domain.up.sql", "sql/posts_repository.go", "posts_handlers.go", "tags_handlers_test.go"} {
+			if !conseil.FileExists(filepath.Join(wd, file)) {
+				t.Errorf("expected %s to be created", file)
+			}
+		}
+
+		repository, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "authors_repository.go"))
+		for _, expected := range []string{generatedHeader, "func (m *Author) Validate() error", "authorEmailPattern.MatchString(m.Email)"} {
+			if !bytes.Contains(repository, []byte(expected)) {
+				t.Errorf("expected the authors repository to contain %q: \n%s", expected, repository)
+			}
+		}
+
+		if err := generateDomain(templates, spec, "gin", module, drivers["sqlite3"], time.Now()); err != nil {
+			t.Fatalf("failed to regenerate the domain: %s", err)
+		}
+
+		if conseil.FileExists(filepath.Join(wd, "sql", "migrations", "0003_domain.up.sql")) {
+			t.Error("expected an unchanged domain not to create a migration")
+		}
+
+		// the tags handlers are owned by the user once the header is removed
+		handlers := filepath.Join(wd, "tags_handlers.go")
+		if err := ioutil.WriteFile(handlers, []byte("package main\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		spec = writeDomain(t, strings.Replace(testDomain, "      - name: label\n", "      - name: color\n        type: string\n        nullable: true\n      - name: label\n", 1))
+		spec.Entities = spec.Entities[1:]
+		spec.Entities[0].Relations = nil
+		if err := generateDomain(templates, spec, "gin", module, drivers["sqlite3"], time.Now()); err == nil {
+			t.Fatal("expected removing a sqlite foreign key to generate an error")
+		}
+
+		spec = writeDomain(t, strings.Replace(testDomain, "      - name: label\n", "      - name: color\n        type: string\n        nullable: true\n      - name: label\n", 1))
+		if err := generateDomain(templates, spec, "gin", module, drivers["sqlite3"], time.Now()); err != nil {
+			t.Fatalf("failed to regenerate the domain: %s", err)
+		}
+
+		up, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "migrations", "0003_domain.up.sql"))
+		if string(up) != "ALTER TABLE tags ADD COLUMN color VARCHAR(255);\n" {
+			t.Errorf("unexpected incremental migration: \n%s", up)
+		}
+
+		if data, _ := ioutil.ReadFile(handlers); string(data) != "package main\n" {
+			t.Errorf("expected the user owned handlers to be preserved: \n%s", data)
+		}
+
+		if data, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "tags_repository.go")); !bytes.Contains(data, []byte("Color")) {
+			t.Errorf("expected the tags repository to be regenerated: \n%s", data)
+		}
+
+		if err := os.Remove(handlers); err != nil {
+			t.Fatal(err)
+		}
+
+		spec = writeDomain(t, "entities:\n  - name: tag\n    fields:\n      - name: color\n        type: string\n        nullable: true\n      - name: label\n        type: string\n")
+		if err := generateDomain(templates, spec, "gin", module, drivers["sqlite3"], time.Now()); err != nil {
+			t.Fatalf("failed to remove entities from the domain: %s", err)
+		}
+
+		if conseil.FileExists(filepath.Join(wd, "sql", "authors_repository.go")) || conseil.FileExists(filepath.Join(wd, "posts_handlers.go")) {
+			t.Error("expected the generated files of removed entities to be deleted")
+		}
+
+		app, _ := ioutil.ReadFile(filepath.Join(wd, "app.go"))
+		if bytes.Contains(app, []byte("registerAuthorRoutes(")) || !bytes.Contains(app, []byte("registerTagRoutes(")) {
+			t.Errorf("unexpected route registrations: \n%s", app)
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package actions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const migrateBase = "github.com/golang-migrate/migrate/v4/database"
+
+// schema queries describe the tables, columns and indexes of a database,
+// excluding the golang-migrate schema_migrations table
+const (
+	postgresSchema = `SELECT table_name, column_name, data_type, is_nullable, COALESCE(column_default, '')
+FROM information_schema.columns
+WHERE table_schema = current_schema() AND table_name <> 'schema_migrations'
+UNION ALL
+SELECT tablename, indexname, indexdef, '', ''
+FROM pg_indexes
+WHERE schemaname = current_schema() AND tablename <> 'schema_migrations'
+ORDER BY 1, 2, 3`
+
+	sqliteSchema = `SELECT type, name, tbl_name, sql
+FROM sqlite_master
+WHERE tbl_name <> 'schema_migrations' AND name NOT LIKE 'sqlite_%'
+ORDER BY type, name`
+
+	mysqlSchema = `SELECT table_name, column_name, column_type, is_nullable, COALESCE(column_default, '')
+FROM information_schema.columns
+WHERE table_schema = DATABASE() AND table_name <> 'schema_migrations'
+UNION ALL
+SELECT table_name, index_name, column_name, non_unique, seq_in_index
+FROM information_schema.statistics
+WHERE table_schema = DATABASE() AND table_name <> 'schema_migrations'
+ORDER BY 1, 2, 3`
+
+	sqlserverSchema = `SELECT TABLE_NAME, COLUMN_NAME, DATA_TYPE, IS_NULLABLE, COALESCE(COLUMN_DEFAULT, '')
+FROM INFORMATION_SCHEMA.COLUMNS
+WHERE TABLE_NAME <> 'schema_migrations'
+UNION ALL
+SELECT t.name, i.name, i.type_desc, CAST(i.is_unique AS VARCHAR(1)), ''
+FROM sys.indexes i JOIN sys.tables t ON i.object_id = t.object_id
+WHERE i.name IS NOT NULL AND t.name <> 'schema_migrations'
+ORDER BY 1, 2, 3`
+)
+
+// dbDriver describes a supported database driver
+type dbDriver struct {
+	// Name is the name the driver registers with database/sql
+	Name string
+	// Import is the database/sql driver package
+	Import string
+	// Migrate is the golang-migrate database driver package
+	Migrate string
+	// Dialect selects the starter migration templates; empty for the defaults
+	Dialect string
+	// DSN formats the default connection string for the named app
+	DSN string
+	// Schema queries a description of the database schema
+	Schema string
+	// System is the OpenTelemetry db.system.name of the traced queries
+	System string
+}
+
+var drivers = map[string]dbDriver{
+	"postgres": {
+		Name:    "postgres",
+		Import:  "github.com/lib/pq",
+		Migrate: migrateBase + "/postgres",
+		DSN:     "postgres://localhost:5432/%s",
+		Schema:  postgresSchema,
+		System:  "postgresql",
+	},
+	"pgx": {
+		Name:    "pgx",
+		Import:  "github.com/jackc/pgx/v5/stdlib",
+		Migrate: migrateBase + "/pgx/v5",
+		DSN:     "postgres://localhost:5432/%s",
+		Schema:  postgresSchema,
+		System:  "postgresql",
+	},
+	"sqlite3": {
+		Name:    "sqlite3",
+		Import:  "github.com/mattn/go-sqlite3",
+		Migrate: migrateBase + "/sqlite3",
+		DSN:     "file:%s.sqlite",
+		Schema:  sqliteSchema,
+		System:  "sqlite",
+	},
+	"sqlite": {
+		Name:    "sqlite",
+		Import:  "modernc.org/sqlite",
+		Migrate: migrateBase + "/sqlite",
+		DSN:     "file:%s.sqlite",
+		Schema:  sqliteSchema,
+		System:  "sqlite",
+	},
+	"mysql": {
+		Name:    "mysql",
+		Import:  "github.com/go-sql-driver/mysql",
+		Migrate: migrateBase + "/mysql",
+		Dialect: "mysql",
+		DSN:     "root@tcp(localhost:3306)/%s?parseTime=true&multiStatements=true",
+		Schema:  mysqlSchema,
+		System:  "mysql",
+	},
+	"sqlserver": {
+		Name:    "sqlserver",
+		Import:  "github.com/microsoft/go-mssqldb",
+		Migrate: migrateBase + "/sqlserver",
+		Dialect: "sqlserver",
+		DSN:     "sqlserver://sa@localhost:1433?database=%s",
+		Schema:  sqlserverSchema,
+		System:  "microsoft.sql_server",
+	},
+	"cockroachdb": {
+		Name:    "postgres",
+		Import:  "github.com/lib/pq",
+		Migrate: migrateBase + "/cockroachdb",
+		Dialect: "cockroachdb",
+		DSN:     "postgres://root@localhost:26257/%s?sslmode=disable",
+		Schema:  postgresSchema,
+		System:  "cockroachdb",
+	},
+}
+
+// throwaway reports whether the driver can verify migrations against a
+// temporary database file without a database server
+func (d dbDriver) throwaway() bool {
+	return d.Name == "sqlite3" || d.Name == "sqlite"
+}
+
+// sqlName names the database/sql driver the commands connect to the project
+// database with; the sqlite3 driver linked into conseil opens the database
+// files of projects using the cgo-free sqlite driver, which is not linked
+func (d dbDriver) sqlName() string {
+	if d.Name == "sqlite" {
+		return "sqlite3"
+	}
+	return d.Name
+}
+
+// lookupDriver retrieves the descriptor for the named driver
+func lookupDriver(name string) (dbDriver, error) {
+	d, ok := drivers[name]
+	if !ok {
+		return dbDriver{}, errors.Errorf("%s is not a supported database driver", name)
+	}
+	return d, nil
+}
+
+func conn(driver string) (string, error) {
+	d, err := lookupDriver(driver)
+	if err != nil {
+		return "", err
+	}
+
+	wd, _ := os.Getwd()
+	return fmt.Sprintf(d.DSN, filepath.Base(wd)), nil
+}
+
+func listDrivers() []string {
+	driverList := make([]string, 0, len(drivers))
+	for name := range drivers {
+		driverList = append(driverList, name)
+	}
+	sort.Strings(driverList)
+	return driverList
+}
@@ -0,0 +1,38 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestConn(t *testing.T) {
+	cwd, _ := os.Getwd()
+	app := filepath.Base(cwd)
+
+	for _, name := range listDrivers() {
+		dsn, err := conn(name)
+		if err != nil {
+			t.Errorf("failed to format %s connection string: %s", name, err)
+		}
+		if !strings.Contains(dsn, app) {
+			t.Errorf("expected %s connection string to reference %s; actual %s", name, app, dsn)
+		}
+	}
+
+	if _, err := conn("oracle"); err == nil {
+		t.Error("expected an unsupported driver to generate an error")
+	}
+}
+
+func TestListDrivers(t *testing.T) {
+	driverList := listDrivers()
+	if len(driverList) != len(drivers) {
+		t.Fatalf("expected %d drivers; actual %d", len(drivers), len(driverList))
+	}
+	if !sort.StringsAreSorted(driverList) {
+		t.Errorf("expected drivers to be sorted: %v", driverList)
+	}
+}
@@ -0,0 +1,167 @@
+package actions
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultMigrator = "golang-migrate"
+
+	gooseSeparator = "-- +goose Down"
+	ternSeparator  = "---- create above / drop below ----"
+)
+
+// Migrator drives the schema migrations of a project independently of the
+// migration engine; implementations return migrate.ErrNoChange when there is
+// nothing to apply or roll back and migrate.ErrNilVersion when no migrations
+// have been applied
+type Migrator interface {
+	// Up applies all pending migrations
+	Up() error
+	// Down rolls back all applied migrations
+	Down() error
+	// Steps applies the next n migrations, or rolls back the last -n
+	Steps(n int) error
+	// Migrate moves up or down to version
+	Migrate(version uint64) error
+	// Force sets the version without running any migrations
+	Force(version int) error
+	// Version reports the current version and whether it is dirty
+	Version() (uint64, bool, error)
+	// Close releases the database connection
+	Close() error
+}
+
+// migrationEngine describes a supported schema migration engine
+type migrationEngine struct {
+	// Template renders the generated sql/migrations.go
+	Template string
+	// Format formats a sequential migration version
+	Format string
+	// Join combines the up and down statements of a migration into a single
+	// file; nil when they are written to separate files
+	Join func(up, down string) string
+	// Timestamps is whether migrations may be versioned using a timestamp
+	Timestamps bool
+	// Declarative is whether migrations are generated from schema files
+	Declarative bool
+	// Drivers lists the supported database drivers; empty for all drivers
+	Drivers []string
+	// Open connects the engine to the project database
+	Open func(project *Project, connStr string) (Migrator, *sql.DB, error)
+}
+
+var engines = map[string]migrationEngine{
+	"golang-migrate": {
+		Template:   "templates/sql/migrations.tpl",
+		Format:     "%04d",
+		Timestamps: true,
+		Open:       openGolangMigrator,
+	},
+	"declarative": {
+		Template:    "templates/sql/migrations.tpl",
+		Format:      "%04d",
+		Timestamps:  true,
+		Declarative: true,
+		Open:        openGolangMigrator,
+	},
+	"goose": {
+		Template: "templates/sql/goose/migrations.tpl",
+		Format:   "%05d",
+		Join: func(up, down string) string {
+			return fmt.Sprintf("-- +goose Up\n%s\n\n%s\n%s", up, gooseSeparator, down)
+		},
+		Timestamps: true,
+		Open:       openGooseMigrator,
+	},
+	"tern": {
+		Template: "templates/sql/tern/migrations.tpl",
+		Format:   "%03d",
+		Join: func(up, down string) string {
+			return fmt.Sprintf("%s\n\n%s\n\n%s", up, ternSeparator, down)
+		},
+		Drivers: []string{"postgres", "pgx", "cockroachdb"},
+		Open:    openTernMigrator,
+	},
+}
+
+// paired reports whether the engine writes up and down migrations to
+// separate files
+func (e migrationEngine) paired() bool {
+	return e.Join == nil
+}
+
+// supports reports whether the engine can migrate the named driver
+func (e migrationEngine) supports(driverName string) bool {
+	if len(e.Drivers) == 0 {
+		return true
+	}
+
+	for _, name := range e.Drivers {
+		if name == driverName {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupEngine retrieves the descriptor for the named migration engine,
+// defaulting to golang-migrate
+func lookupEngine(name string) (migrationEngine, error) {
+	if name == "" {
+		name = defaultMigrator
+	}
+
+	e, ok := engines[name]
+	if !ok {
+		return migrationEngine{}, errors.Errorf("%s is not a supported migration engine", name)
+	}
+	return e, nil
+}
+
+// projectEngine retrieves the migration engine of the project, failing when
+// it cannot migrate the project driver
+func projectEngine(project *Project) (migrationEngine, error) {
+	e, err := lookupEngine(project.Migrator)
+	if err != nil {
+		return e, err
+	}
+
+	if !e.supports(project.Driver) {
+		return e, errors.Errorf("the %s migration engine does not support the %s driver", project.Migrator, project.Driver)
+	}
+	return e, nil
+}
+
+// openMigrator connects the project migration engine to the database using
+// connStr, or the generated project connection when empty
+func openMigrator(project *Project, connStr string) (Migrator, *sql.DB, error) {
+	if project.Driver == "" {
+		return nil, nil, errors.New("unable to determine the project database driver")
+	}
+
+	e, err := projectEngine(project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if connStr == "" {
+		if connStr, err = conn(project.Driver); err != nil {
+			return nil, nil, err
+		}
+	}
+	return e.Open(project, connStr)
+}
+
+func listEngines() []string {
+	engineList := make([]string, 0, len(engines))
+	for name := range engines {
+		engineList = append(engineList, name)
+	}
+	sort.Strings(engineList)
+	return engineList
+}
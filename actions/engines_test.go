@@ -0,0 +1,119 @@
+package actions
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	migrate "github.com/golang-migrate/migrate/v4"
+)
+
+func TestLookupEngine(t *testing.T) {
+	e, err := lookupEngine("")
+	if err != nil {
+		t.Fatalf("failed to lookup the default engine: %s", err)
+	}
+
+	if e.Template != engines[defaultMigrator].Template || !e.paired() {
+		t.Errorf("expected the default engine to be %s", defaultMigrator)
+	}
+
+	if _, err := lookupEngine("flyway"); err == nil {
+		t.Error("expected an unsupported engine to generate an error")
+	}
+}
+
+func TestProjectEngine(t *testing.T) {
+	tests := []struct {
+		Migrator string
+		Driver   string
+		Error    bool
+	}{
+		{"", "sqlite3", false},
+		{"goose", "mysql", false},
+		{"declarative", "postgres", false},
+		{"tern", "pgx", false},
+		{"tern", "sqlite3", true},
+		{"flyway", "postgres", true},
+	}
+
+	for _, test := range tests {
+		_, err := projectEngine(&Project{Driver: test.Driver, Migrator: test.Migrator})
+		if test.Error != (err != nil) {
+			t.Errorf("unexpected %s engine result for the %s driver: %v", test.Migrator, test.Driver, err)
+		}
+	}
+}
+
+func TestListEngines(t *testing.T) {
+	engineList := listEngines()
+	if len(engineList) != len(engines) {
+		t.Fatalf("expected %d engines; actual %d", len(engines), len(engineList))
+	}
+	if !sort.StringsAreSorted(engineList) {
+		t.Errorf("expected engines to be sorted: %v", engineList)
+	}
+}
+
+func TestGooseMigrator(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		project := stageSqliteProject(t)
+		project.Migrator = "goose"
+
+		path := filepath.Join(wd, project.Migrations)
+		os.RemoveAll(path)
+		os.MkdirAll(path, 0755)
+		files := map[string]string{
+			"00001_users.sql": "-- +goose Up\nCREATE TABLE users (id INTEGER PRIMARY KEY);\n\n-- +goose Down\nDROP TABLE users;",
+			"00002_roles.sql": "-- +goose Up\nCREATE TABLE roles (id INTEGER PRIMARY KEY);\n\n-- +goose Down\nDROP TABLE roles;",
+		}
+		for name, content := range files {
+			ioutil.WriteFile(filepath.Join(path, name), []byte(content), 0644)
+		}
+
+		m, _, err := openMigrator(project, dsn)
+		if err != nil {
+			t.Fatalf("failed to open migrations: %s", err)
+		}
+		defer m.Close()
+
+		if _, _, err := m.Version(); err != migrate.ErrNilVersion {
+			t.Errorf("expected no version; actual %v", err)
+		}
+
+		if err := m.Steps(1); err != nil {
+			t.Fatalf("failed to apply a migration: %s", err)
+		}
+
+		if err := m.Up(); err != nil {
+			t.Fatalf("failed to apply migrations: %s", err)
+		}
+
+		if err := m.Up(); err != migrate.ErrNoChange {
+			t.Errorf("expected no change; actual %v", err)
+		}
+
+		if version, _, _ := m.Version(); version != 2 {
+			t.Errorf("expected version 2; actual %d", version)
+		}
+
+		if err := m.Migrate(1); err != nil {
+			t.Fatalf("failed to migrate to version 1: %s", err)
+		}
+
+		if err := m.Down(); err != nil {
+			t.Fatalf("failed to roll back migrations: %s", err)
+		}
+
+		if err := m.Steps(-1); err != migrate.ErrNoChange {
+			t.Errorf("expected no change; actual %v", err)
+		}
+
+		ioutil.WriteFile(filepath.Join(path, "00003_seed.go"), []byte("package migrations"), 0644)
+		if _, _, err := openMigrator(project, dsn); err == nil {
+			t.Error("expected a Go migration to generate an error")
+		}
+	})
+}
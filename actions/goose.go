@@ -0,0 +1,136 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+
+	migrate "github.com/golang-migrate/migrate/v4"
+	"github.com/pkg/errors"
+	"github.com/pressly/goose/v3"
+)
+
+// gooseDialects maps the supported drivers to their goose dialect
+var gooseDialects = map[string]goose.Dialect{
+	"postgres":    goose.DialectPostgres,
+	"pgx":         goose.DialectPostgres,
+	"cockroachdb": goose.DialectPostgres,
+	"sqlite3":     goose.DialectSQLite3,
+	"sqlite":      goose.DialectSQLite3,
+	"mysql":       goose.DialectMySQL,
+	"sqlserver":   goose.DialectMSSQL,
+}
+
+// gooseMigrator drives goose SQL migrations; Go migrations are compiled into
+// the generated application and must be run using its migrate subcommand
+type gooseMigrator struct {
+	ctx      context.Context
+	provider *goose.Provider
+}
+
+func openGooseMigrator(project *Project, connStr string) (Migrator, *sql.DB, error) {
+	d, err := lookupDriver(project.Driver)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dir := filepath.Join(wd, project.Migrations)
+	migrationList, err := scanMigrations(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, m := range migrationList {
+		if filepath.Ext(m.Path) == ".go" {
+			return nil, nil, errors.Errorf("%s is a Go migration; use the generated application's migrate subcommand instead", filepath.Base(m.Path))
+		}
+	}
+
+	db, err := sql.Open(d.sqlName(), connStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	provider, err := goose.NewProvider(gooseDialects[project.Driver], db, os.DirFS(dir))
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+	return &gooseMigrator{context.Background(), provider}, db, nil
+}
+
+func (g *gooseMigrator) Up() error {
+	results, err := g.provider.Up(g.ctx)
+	if err == nil && len(results) == 0 {
+		return migrate.ErrNoChange
+	}
+	return err
+}
+
+func (g *gooseMigrator) Down() error {
+	results, err := g.provider.DownTo(g.ctx, 0)
+	if err == nil && len(results) == 0 {
+		return migrate.ErrNoChange
+	}
+	return err
+}
+
+func (g *gooseMigrator) Steps(n int) error {
+	step := g.provider.UpByOne
+	if n < 0 {
+		step, n = g.provider.Down, -n
+	}
+
+	for i := 0; i < n; i++ {
+		if _, err := step(g.ctx); err == goose.ErrNoNextVersion {
+			if i == 0 {
+				return migrate.ErrNoChange
+			}
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *gooseMigrator) Migrate(version uint64) error {
+	current, err := g.provider.GetDBVersion(g.ctx)
+	if err != nil {
+		return err
+	}
+
+	var results []*goose.MigrationResult
+	switch target := int64(version); {
+	case target > current:
+		results, err = g.provider.UpTo(g.ctx, target)
+	case target < current:
+		results, err = g.provider.DownTo(g.ctx, target)
+	}
+
+	if err == nil && len(results) == 0 {
+		return migrate.ErrNoChange
+	}
+	return err
+}
+
+func (g *gooseMigrator) Force(version int) error {
+	return errors.New("goose does not track a dirty state, so there is no version to force")
+}
+
+func (g *gooseMigrator) Version() (uint64, bool, error) {
+	version, err := g.provider.GetDBVersion(g.ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if version == 0 {
+		return 0, false, migrate.ErrNilVersion
+	}
+	return uint64(version), false, nil
+}
+
+func (g *gooseMigrator) Close() error {
+	return g.provider.Close()
+}
@@ -0,0 +1,765 @@
+package actions
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+const importName = "import_schema"
+
+var (
+	// importReaders describe the tables of an existing database, keyed by the
+	// supported driver name
+	importReaders = map[string]func(*sql.DB) (schemaModel, error){
+		"sqlite3":  readSqliteSchema,
+		"sqlite":   readSqliteSchema,
+		"postgres": readPostgresSchema,
+		"pgx":      readPostgresSchema,
+		"mysql":    readMysqlSchema,
+	}
+
+	// trackingTables record the state of the migration engines and seeds
+	trackingTables = map[string]bool{
+		"schema_migrations": true,
+		"schema_seeds":      true,
+		"goose_db_version":  true,
+		"schema_version":    true,
+	}
+
+	// reservedNames are declared by the generated sql package
+	reservedNames = map[string]bool{
+		"DB": true, "Open": true, "Close": true, "Migrate": true, "RunMigrations": true,
+		"Migrations": true, "Seed": true, "SeedDB": true, "Seeds": true,
+		"Store": true, "Querier": true, "Router": true,
+	}
+
+	initialisms = map[string]bool{
+		"api": true, "html": true, "http": true, "id": true, "ip": true, "json": true,
+		"sql": true, "uri": true, "url": true, "uuid": true, "xml": true,
+	}
+
+	// reservedWords are quoted when used as identifiers
+	reservedWords = map[string]bool{
+		"check": true, "default": true, "desc": true, "from": true, "group": true, "index": true,
+		"key": true, "limit": true, "order": true, "references": true, "select": true,
+		"table": true, "to": true, "user": true, "where": true,
+	}
+
+	simpleIdentifier    = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+	identifierSeparator = regexp.MustCompile(`[^A-Za-z0-9]+`)
+	autoIncrementOption = regexp.MustCompile(` AUTO_INCREMENT=\d+`)
+	dbAccessor          = regexp.MustCompile(`(?m)^func DB\(\) `)
+)
+
+func init() {
+	register(cli.Command{
+		Name:   "import-schema",
+		Usage:  "generate a baseline migration, models, and repositories from an existing database",
+		Action: importSchemaAction,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:        "driver",
+				Usage:       "database driver (default: the project driver)",
+				Destination: &driver,
+			},
+			cli.StringFlag{
+				Name:        "dsn",
+				Usage:       "the connection string of the database to import",
+				Destination: &dsn,
+			},
+			cli.StringFlag{
+				Name:        "dir",
+				Value:       defaultMigrationDir,
+				Usage:       "the migrations directory",
+				Destination: &migrationDir,
+			},
+		},
+	})
+}
+
+// modelField maps a column to a field of a generated model
+type modelField struct {
+	Name    string
+	Column  string
+	Type    string
+	Tag     string
+	Comment string
+}
+
+// modelFinder lists the rows of a model matching a foreign key
+type modelFinder struct {
+	Name   string
+	Column string
+	Param  string
+	Query  string
+	// Field is the model field of the foreign key, which is an
+	// sql.NullInt64 when Null
+	Field string
+	Null  bool
+}
+
+// modelLink adds, removes, and lists the rows of another model joined to a
+// model through a join table
+type modelLink struct {
+	Model  string
+	Plural string
+	Table  string
+	Join   string
+	// Reversed is whether the owner is referenced by the second foreign key
+	// of the join table
+	Reversed    bool
+	OwnerParam  string
+	OtherParam  string
+	InsertQuery string
+	DeleteQuery string
+	ListQuery   string
+}
+
+// modelValidation rejects a model when Check holds
+type modelValidation struct {
+	Check   string
+	Message string
+	// Pattern is matched by the package variable PatternVar
+	Pattern    string
+	PatternVar string
+}
+
+// tableModel describes the model and repository generated for a table
+type tableModel struct {
+	Table string
+	Name  string
+	// Plural names the aggregate of the table within the generated Store
+	Plural      string
+	Fields      []modelField
+	AutoKey     *modelField
+	Returning   bool
+	Columns     string
+	ScanArgs    string
+	KeyParams   string
+	KeyArgs     string
+	InsertArgs  string
+	UpdateArgs  string
+	ListQuery   string
+	GetQuery    string
+	InsertQuery string
+	UpdateQuery string
+	DeleteQuery string
+	Finders     []modelFinder
+	Links       []modelLink
+	Validations []modelValidation
+	// ZeroInvalid is whether the zero value of the model fails validation
+	ZeroInvalid bool
+	// Sample is a JSON body accepted by the generated handlers
+	Sample string
+
+	keys []modelField
+}
+
+func importSchemaAction(_ *cli.Context) error {
+	if wd == "" {
+		wd = "."
+	}
+
+	project, err := loadProject(wd)
+	if err != nil {
+		return err
+	}
+
+	if driver == "" {
+		driver = project.Driver
+	}
+
+	if driver == "" {
+		return errors.New("unable to determine the database driver; use --driver")
+	}
+
+	if dsn == "" {
+		return errors.New("the connection string of the database to import is required; use --dsn")
+	}
+
+	d, err := lookupDriver(driver)
+	if err != nil {
+		return err
+	}
+
+	db, err := sql.Open(d.sqlName(), dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	log.Println("reading the database schema...")
+	model, err := importSchema(db, driver)
+	if err != nil {
+		return err
+	}
+
+	if len(model) == 0 {
+		return errors.New("the database does not contain any tables")
+	}
+
+	migrator, orm, replicas = project.Migrator, project.ORM, project.Replicas
+	version, err := newImportMigration(model, d, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if err := writeModels(parseTemplates(), model, d); err != nil {
+		return err
+	}
+
+	log.Printf("the imported database already matches migration %s; mark it as applied before running any later migrations against it", version)
+	return nil
+}
+
+// importSchema reads the tables of an existing database, ordered so that
+// referenced tables are created first
+func importSchema(db *sql.DB, driverName string) (schemaModel, error) {
+	read, ok := importReaders[driverName]
+	if !ok {
+		return nil, errors.Errorf("importing a schema is not supported for the %s driver", driverName)
+	}
+
+	model, err := read(db)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make(schemaModel, 0, len(model))
+	for _, t := range model {
+		if !trackingTables[t.Name] {
+			tables = append(tables, t)
+		}
+	}
+	return dependencyOrder(tables), nil
+}
+
+// dependencyOrder sorts the tables so each follows the tables it references,
+// keeping the original order otherwise; self references and cycles are
+// ignored
+func dependencyOrder(model schemaModel) schemaModel {
+	ordered := make(schemaModel, 0, len(model))
+	visited := make(map[string]bool)
+
+	var visit func(t *schemaTable)
+	visit = func(t *schemaTable) {
+		if visited[t.Name] {
+			return
+		}
+		visited[t.Name] = true
+
+		for _, fk := range t.ForeignKeys {
+			if ref := model.table(fk.Table); ref != nil {
+				visit(ref)
+			}
+		}
+		ordered = append(ordered, t)
+	}
+
+	for _, t := range model {
+		visit(t)
+	}
+	return ordered
+}
+
+// newImportMigration writes the next migration creating the imported tables
+// and returns the version
+func newImportMigration(model schemaModel, d dbDriver, now time.Time) (string, error) {
+	e, err := lookupEngine(migrator)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(wd, migrationDir)
+	version, err := nextVersion(e, path, now)
+	if err != nil {
+		return "", err
+	}
+
+	var up, down strings.Builder
+	for i, t := range model {
+		fmt.Fprintf(&up, "%s;\n", t.Create)
+		for _, name := range changedKeys(nil, t.Indexes) {
+			fmt.Fprintf(&up, "%s;\n", t.Indexes[name])
+		}
+		fmt.Fprintf(&down, "DROP TABLE %s;\n", quoteIdentifier(d, model[len(model)-1-i].Name))
+	}
+
+	log.Printf("creating migration %s_%s...", version, importName)
+	return version, writeMigration(e, path, version, importName, up.String(), down.String())
+}
+
+// writeModels writes the model structs to sql/models.go and a repository
+// file for each table to the generated sql package
+func writeModels(templates *template.Template, model schemaModel, d dbDriver) error {
+	path := filepath.Join(wd, "sql")
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+
+	context := &Context{}
+	for _, t := range model {
+		context.Models = append(context.Models, newTableModel(t, d))
+	}
+	context.Imports = modelImports(nil, context.Models...)
+
+	log.Println("creating models...")
+	if err := writeSource(templates, "templates/sql/models.tpl", filepath.Join(path, "models.go"), context); err != nil {
+		return err
+	}
+
+	for _, m := range context.Models {
+		file := filepath.Join(path, repositoryFile(m.Table))
+		if err := writeSource(templates, "templates/sql/repository.tpl", file, &Context{Model: m, Imports: groupImports(repositoryImports(m)), ORM: repositoryORM(), Replicas: replicas}); err != nil {
+			return err
+		}
+
+		if orm == "ent" {
+			if err := writeEntSchema(templates, m); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := writeDBAccessor(templates, path); err != nil {
+		return err
+	}
+	return writeStore(templates, path)
+}
+
+// modelImports adds the packages referenced by the fields and validations of
+// the models to imports
+func modelImports(imports []string, models ...*tableModel) []string {
+	var null, times, validates, patterns, runes bool
+	for _, m := range models {
+		for _, f := range m.Fields {
+			null = null || strings.HasPrefix(f.Type, "sql.")
+			times = times || f.Type == "time.Time"
+		}
+
+		for _, v := range m.Validations {
+			validates = true
+			patterns = patterns || v.Pattern != ""
+			runes = runes || strings.Contains(v.Check, "utf8.")
+		}
+	}
+
+	has := func(name string) bool {
+		for _, i := range imports {
+			if i == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	if null && !has("database/sql") {
+		imports = append(imports, "database/sql")
+	}
+
+	if times && !has("time") {
+		imports = append(imports, "time")
+	}
+
+	if validates && !has("errors") {
+		imports = append(imports, "errors")
+	}
+
+	if patterns && !has("regexp") {
+		imports = append(imports, "regexp")
+	}
+
+	if runes && !has("unicode/utf8") {
+		imports = append(imports, "unicode/utf8")
+	}
+	return imports
+}
+
+// repositoryFile names the file of the repository for table
+func repositoryFile(table string) string {
+	name := strings.Trim(unsafeName.ReplaceAllString(strings.ToLower(table), "_"), "_")
+	return fmt.Sprintf("%s_repository.go", name)
+}
+
+// writeDBAccessor writes sql/db.go exposing the database opened by Open to
+// the generated repositories, unless the package already declares it
+func writeDBAccessor(templates *template.Template, path string) error {
+	files, err := filepath.Glob(filepath.Join(path, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		if dbAccessor.Match(data) {
+			return nil
+		}
+	}
+	return writeSource(templates, "templates/sql/db.tpl", filepath.Join(path, "db.go"), &Context{})
+}
+
+// writeSource renders the named template to file as formatted Go source
+func writeSource(templates *template.Template, name, file string, context *Context) error {
+	src, err := renderSource(templates, name, file, context)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, src, 0644)
+}
+
+// renderSource renders the named template for file as formatted Go source
+func renderSource(templates *template.Template, name, file string, context *Context) ([]byte, error) {
+	var src bytes.Buffer
+	if err := templates.Lookup(name).Execute(&src, context); err != nil {
+		return nil, err
+	}
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to format %s", filepath.Base(file))
+	}
+	return formatted, nil
+}
+
+// newTableModel maps the columns of a table to the fields of a model and
+// prepares the statements of its repository
+func newTableModel(t *schemaTable, d dbDriver) *tableModel {
+	m := &tableModel{Table: t.Name, Name: modelName(t.Name), Plural: goName(t.Name)}
+
+	references := make(map[string]schemaForeignKey)
+	for _, fk := range t.ForeignKeys {
+		references[fk.Column] = fk
+	}
+
+	keys := make(map[string]bool)
+	for _, name := range t.PrimaryKey {
+		keys[name] = true
+	}
+
+	names := make(map[string]bool)
+	for _, c := range t.Columns {
+		f := modelField{Name: goName(c.Name), Column: c.Name, Type: goType(c.Type, c.NotNull || keys[c.Name])}
+		for names[f.Name] {
+			f.Name += "_"
+		}
+		names[f.Name] = true
+
+		if fk, ok := references[c.Name]; ok {
+			f.Comment = fmt.Sprintf("references %s(%s)", fk.Table, fk.ReferencedColumn)
+		}
+
+		auto := c.AutoIncrement && len(t.PrimaryKey) == 1 && keys[c.Name] && f.Type == "int64"
+		f.Tag = fieldTag(c.Name, keys[c.Name], auto)
+		if auto {
+			key := f
+			m.AutoKey = &key
+		}
+		m.Fields = append(m.Fields, f)
+	}
+
+	bind := func(n int) string {
+		return bindVar(d, n)
+	}
+
+	columns := make([]string, 0, len(m.Fields))
+	scanArgs := make([]string, 0, len(m.Fields))
+	var key, keyParams, keyArgs, values, insertArgs, sets, updateArgs []string
+	for _, f := range m.Fields {
+		column := quoteIdentifier(d, f.Column)
+		columns = append(columns, column)
+		scanArgs = append(scanArgs, "&m."+f.Name)
+
+		if !keys[f.Column] {
+			sets = append(sets, fmt.Sprintf("%s = %s", column, bind(len(sets)+1)))
+			updateArgs = append(updateArgs, "m."+f.Name)
+		}
+
+		if m.AutoKey == nil || m.AutoKey.Column != f.Column {
+			values = append(values, column)
+			insertArgs = append(insertArgs, "m."+f.Name)
+		}
+	}
+
+	for _, name := range t.PrimaryKey {
+		for _, f := range m.Fields {
+			if f.Column == name {
+				param := goParam(f.Name)
+				key = append(key, quoteIdentifier(d, name))
+				keyParams = append(keyParams, param+" "+f.Type)
+				keyArgs = append(keyArgs, param)
+				m.keys = append(m.keys, f)
+			}
+		}
+	}
+
+	table := quoteIdentifier(d, t.Name)
+	m.Columns = strings.Join(columns, ", ")
+	m.ScanArgs = strings.Join(scanArgs, ", ")
+	m.ListQuery = fmt.Sprintf("SELECT %s FROM %s", strings.Join(columns, ", "), table)
+	if len(key) > 0 {
+		m.ListQuery += " ORDER BY " + strings.Join(key, ", ")
+		m.KeyParams = strings.Join(keyParams, ", ")
+		m.KeyArgs = strings.Join(keyArgs, ", ")
+		m.GetQuery = fmt.Sprintf("SELECT %s FROM %s WHERE %s", strings.Join(columns, ", "), table, keyFilter(d, key, 1))
+		m.DeleteQuery = fmt.Sprintf("DELETE FROM %s WHERE %s", table, keyFilter(d, key, 1))
+
+		if len(sets) > 0 {
+			m.UpdateQuery = fmt.Sprintf("UPDATE %s SET %s WHERE %s", table, strings.Join(sets, ", "), keyFilter(d, key, len(sets)+1))
+			for _, name := range t.PrimaryKey {
+				for _, f := range m.Fields {
+					if f.Column == name {
+						updateArgs = append(updateArgs, "m."+f.Name)
+					}
+				}
+			}
+			m.UpdateArgs = strings.Join(updateArgs, ", ")
+		}
+	}
+
+	// postgres returns the generated key after the values and sqlserver
+	// outputs it before them
+	var output, returning string
+	if m.AutoKey != nil {
+		switch d.Name {
+		case "postgres", "pgx":
+			m.Returning = true
+			returning = " RETURNING " + quoteIdentifier(d, m.AutoKey.Column)
+		case "sqlserver":
+			m.Returning = true
+			output = " OUTPUT INSERTED." + quoteIdentifier(d, m.AutoKey.Column)
+		}
+	}
+
+	switch {
+	case len(values) > 0:
+		binds := make([]string, len(values))
+		for i := range values {
+			binds[i] = bind(i + 1)
+		}
+		m.InsertQuery = fmt.Sprintf("INSERT INTO %s (%s)%s VALUES (%s)%s", table, strings.Join(values, ", "), output, strings.Join(binds, ", "), returning)
+		m.InsertArgs = strings.Join(insertArgs, ", ")
+	case d.Name == "mysql":
+		m.InsertQuery = fmt.Sprintf("INSERT INTO %s () VALUES ()", table)
+	default:
+		m.InsertQuery = fmt.Sprintf("INSERT INTO %s%s DEFAULT VALUES%s", table, output, returning)
+	}
+	return m
+}
+
+// KeyMatch compares the key fields of row with those of other, or with the
+// key parameters when other is empty
+func (m *tableModel) KeyMatch(row, other string) string {
+	matches := make([]string, len(m.keys))
+	for i, f := range m.keys {
+		left, right := row+"."+f.Name, goParam(f.Name)
+		if other != "" {
+			right = other + "." + f.Name
+		}
+
+		if f.Type == "[]byte" {
+			left, right = "string("+left+")", "string("+right+")"
+		}
+		matches[i] = left + " == " + right
+	}
+	return strings.Join(matches, " && ")
+}
+
+// modelName names the model of the rows of table
+func modelName(table string) string {
+	name := goName(singular(table))
+	if reservedNames[name] {
+		name += "Row"
+	}
+	return name
+}
+
+// keyFilter matches the key columns using placeholders numbered from start
+func keyFilter(d dbDriver, key []string, start int) string {
+	filter := make([]string, len(key))
+	for i, column := range key {
+		filter[i] = fmt.Sprintf("%s = %s", column, bindVar(d, start+i))
+	}
+	return strings.Join(filter, " AND ")
+}
+
+// goType maps a column type to the Go type of a model field, using the
+// database/sql null types for nullable columns
+func goType(sqlType string, notNull bool) string {
+	t := strings.ToLower(sqlType)
+	var base, nullable string
+	switch {
+	case strings.HasPrefix(t, "bool") || strings.HasPrefix(t, "tinyint(1)") || t == "bit":
+		base, nullable = "bool", "sql.NullBool"
+	case strings.Contains(t, "interval"):
+		base, nullable = "string", "sql.NullString"
+	case strings.Contains(t, "int") || strings.Contains(t, "serial"):
+		base, nullable = "int64", "sql.NullInt64"
+	case strings.Contains(t, "real") || strings.Contains(t, "floa") || strings.Contains(t, "doub") ||
+		strings.Contains(t, "numeric") || strings.Contains(t, "decimal"):
+		base, nullable = "float64", "sql.NullFloat64"
+	case strings.Contains(t, "date") || strings.Contains(t, "time"):
+		base, nullable = "time.Time", "sql.NullTime"
+	case strings.Contains(t, "blob") || strings.Contains(t, "bytea") || strings.Contains(t, "binary"):
+		return "[]byte"
+	default:
+		base, nullable = "string", "sql.NullString"
+	}
+
+	if notNull {
+		return base
+	}
+	return nullable
+}
+
+// goName converts an identifier to an exported Go name
+func goName(name string) string {
+	var b strings.Builder
+	for _, part := range identifierSeparator.Split(name, -1) {
+		if part == "" {
+			continue
+		}
+
+		if initialisms[strings.ToLower(part)] {
+			b.WriteString(strings.ToUpper(part))
+		} else {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+
+	goName := b.String()
+	if goName == "" || (goName[0] >= '0' && goName[0] <= '9') {
+		goName = "X" + goName
+	}
+	return goName
+}
+
+// goParam converts an exported Go name to a parameter name that does not
+// shadow the receiver, context, or model of the generated repositories
+func goParam(name string) string {
+	param := lowerCamel(name)
+	switch {
+	case token.IsKeyword(param), param == "ctx", param == "r", param == "m", param == "err":
+		return param + "Key"
+	}
+	return param
+}
+
+// lowerCamel converts an exported Go name to an unexported one
+func lowerCamel(name string) string {
+	// lower the leading initialism, keeping the start of the following word
+	upper := 0
+	for upper < len(name) && name[upper] >= 'A' && name[upper] <= 'Z' {
+		upper++
+	}
+
+	param := strings.ToLower(name[:1]) + name[1:]
+	switch {
+	case upper == len(name):
+		param = strings.ToLower(name)
+	case upper > 1:
+		param = strings.ToLower(name[:upper-1]) + name[upper-1:]
+	}
+	return param
+}
+
+// singular naively converts a plural table name to the name of a row
+func singular(name string) string {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, "ies") && len(name) > 3:
+		return name[:len(name)-3] + "y"
+	case strings.HasSuffix(lower, "sses"), strings.HasSuffix(lower, "xes"), strings.HasSuffix(lower, "ches"), strings.HasSuffix(lower, "shes"):
+		return name[:len(name)-2]
+	case strings.HasSuffix(lower, "s") && !strings.HasSuffix(lower, "ss") && !strings.HasSuffix(lower, "us") && len(name) > 1:
+		return name[:len(name)-1]
+	}
+	return name
+}
+
+// quoteIdentifier quotes identifiers that are not plain lower case names or
+// that are commonly reserved words
+func quoteIdentifier(d dbDriver, name string) string {
+	if simpleIdentifier.MatchString(name) && !reservedWords[name] {
+		return name
+	}
+
+	if d.Name == "mysql" {
+		return "`" + strings.Replace(name, "`", "``", -1) + "`"
+	}
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+// readMysqlSchema reads the tables, columns and keys of the current database
+// from the information schema; indexes are part of each table definition
+func readMysqlSchema(db *sql.DB) (schemaModel, error) {
+	names, err := queryStrings(db, `SELECT table_name FROM information_schema.tables
+WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE'
+ORDER BY create_time, table_name`)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the schema")
+	}
+
+	model := make(schemaModel, 0, len(names))
+	for _, name := range names {
+		t := &schemaTable{Name: name, Constraints: make(map[string]string), Indexes: make(map[string]string)}
+		var table string
+		if err := db.QueryRow("SHOW CREATE TABLE "+quoteIdentifier(drivers["mysql"], name)).Scan(&table, &t.Create); err != nil {
+			return nil, err
+		}
+		t.Create = autoIncrementOption.ReplaceAllString(t.Create, "")
+
+		columns, err := db.Query(`SELECT column_name, column_type, is_nullable = 'NO', COALESCE(column_default, ''), extra LIKE '%auto_increment%'
+FROM information_schema.columns
+WHERE table_schema = DATABASE() AND table_name = ?
+ORDER BY ordinal_position`, t.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		for columns.Next() {
+			var c schemaColumn
+			if err := columns.Scan(&c.Name, &c.Type, &c.NotNull, &c.Default, &c.AutoIncrement); err != nil {
+				columns.Close()
+				return nil, err
+			}
+			t.Columns = append(t.Columns, c)
+		}
+		columns.Close()
+
+		t.PrimaryKey, err = queryStrings(db, `SELECT column_name FROM information_schema.key_column_usage
+WHERE table_schema = DATABASE() AND table_name = ? AND constraint_name = 'PRIMARY'
+ORDER BY ordinal_position`, t.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		t.ForeignKeys, err = readForeignKeys(db, `SELECT column_name, referenced_table_name, referenced_column_name
+FROM information_schema.key_column_usage
+WHERE table_schema = DATABASE() AND table_name = ? AND referenced_table_name IS NOT NULL
+ORDER BY constraint_name, ordinal_position`, t.Name)
+		if err != nil {
+			return nil, err
+		}
+		model = append(model, t)
+	}
+	return model, nil
+}
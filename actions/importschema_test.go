@@ -0,0 +1,205 @@
+package actions
+
+import (
+	"bytes"
+	"database/sql"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/n3integration/conseil"
+)
+
+const legacySchema = `CREATE TABLE posts (id INTEGER PRIMARY KEY, user_id INTEGER NOT NULL REFERENCES users(id), title TEXT NOT NULL, published_at DATETIME);
+CREATE TABLE users (id INTEGER PRIMARY KEY, email VARCHAR(255) NOT NULL, "order" INTEGER);
+CREATE UNIQUE INDEX users_email ON users (email);
+CREATE TABLE post_tags (post_id INTEGER NOT NULL REFERENCES posts(id), tag TEXT NOT NULL, PRIMARY KEY (post_id, tag));
+CREATE TABLE schema_seeds (env TEXT, name TEXT);`
+
+func TestImportSchema(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(m string) { migrator = m }(migrator)
+		migrationDir, timestamp, migrator = defaultMigrationDir, false, ""
+
+		db, err := sql.Open("sqlite3", "file:"+filepath.Join(wd, "legacy.sqlite"))
+		if err != nil {
+			t.Fatalf("failed to open the database: %s", err)
+		}
+		defer db.Close()
+
+		if _, err := db.Exec(legacySchema); err != nil {
+			t.Fatalf("failed to create the legacy schema: %s", err)
+		}
+
+		model, err := importSchema(db, "sqlite3")
+		if err != nil {
+			t.Fatalf("failed to import the schema: %s", err)
+		}
+
+		tables := make([]string, len(model))
+		for i, table := range model {
+			tables[i] = table.Name
+		}
+
+		if strings.Join(tables, ",") != "users,posts,post_tags" {
+			t.Fatalf("expected referenced tables first; actual %v", tables)
+		}
+
+		if pk := model.table("post_tags").PrimaryKey; len(pk) != 2 {
+			t.Errorf("expected a composite primary key; actual %v", pk)
+		}
+
+		version, err := newImportMigration(model, drivers["sqlite3"], time.Now())
+		if err != nil {
+			t.Fatalf("failed to create migration: %s", err)
+		}
+
+		up, _ := ioutil.ReadFile(filepath.Join(wd, migrationDir, version+"_import_schema.up.sql"))
+		if !bytes.Contains(up, []byte("CREATE UNIQUE INDEX users_email ON users (email);")) {
+			t.Errorf("expected the migration to create the indexes: \n%s", up)
+		}
+
+		down, _ := ioutil.ReadFile(filepath.Join(wd, migrationDir, version+"_import_schema.down.sql"))
+		if string(down) != "DROP TABLE post_tags;\nDROP TABLE posts;\nDROP TABLE users;\n" {
+			t.Errorf("expected the tables to be dropped in reverse: \n%s", down)
+		}
+
+		if err := writeModels(templates, model, drivers["sqlite3"]); err != nil {
+			t.Fatalf("failed to write models: %s", err)
+		}
+
+		models, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "models.go"))
+		for _, expected := range []string{"type PostTag struct", "sql.NullTime", "// references users(id)", "sql.NullInt64 `db:\"order\" json:\"order\"`"} {
+			if !bytes.Contains(models, []byte(expected)) {
+				t.Errorf("expected the models to contain %s: \n%s", expected, models)
+			}
+		}
+
+		for _, table := range tables {
+			if !conseil.FileExists(filepath.Join(wd, "sql", table+"_repository.go")) {
+				t.Errorf("expected a %s repository to be created", table)
+			}
+		}
+
+		if _, err := importSchema(db, "sqlserver"); err == nil {
+			t.Error("expected an unsupported driver to generate an error")
+		}
+	})
+}
+
+func TestImportReservedTable(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(m string) { migrator = m }(migrator)
+		migrationDir, timestamp, migrator = defaultMigrationDir, false, ""
+
+		db, err := sql.Open("sqlite3", "file:"+filepath.Join(wd, "legacy.sqlite"))
+		if err != nil {
+			t.Fatalf("failed to open the database: %s", err)
+		}
+		defer db.Close()
+
+		if _, err := db.Exec(`CREATE TABLE "order" (id INTEGER PRIMARY KEY, total INTEGER NOT NULL)`); err != nil {
+			t.Fatalf("failed to create the legacy schema: %s", err)
+		}
+
+		model, err := importSchema(db, "sqlite3")
+		if err != nil {
+			t.Fatalf("failed to import the schema: %s", err)
+		}
+
+		version, err := newImportMigration(model, drivers["sqlite3"], time.Now())
+		if err != nil {
+			t.Fatalf("failed to create migration: %s", err)
+		}
+
+		down, _ := ioutil.ReadFile(filepath.Join(wd, migrationDir, version+"_import_schema.down.sql"))
+		if string(down) != "DROP TABLE \"order\";\n" {
+			t.Errorf("expected the reserved table name to be quoted: \n%s", down)
+		}
+
+		if _, err := db.Exec(string(down)); err != nil {
+			t.Errorf("failed to apply the down migration: %s", err)
+		}
+	})
+}
+
+func TestNewTableModel(t *testing.T) {
+	table := &schemaTable{
+		Name: "users",
+		Columns: []schemaColumn{
+			{Name: "id", Type: "bigserial", NotNull: true, AutoIncrement: true},
+			{Name: "email", Type: "text", NotNull: true},
+		},
+		PrimaryKey: []string{"id"},
+	}
+
+	m := newTableModel(table, drivers["postgres"])
+	if m.Name != "User" || m.AutoKey == nil || !m.Returning {
+		t.Fatalf("expected a User model with a generated key: %+v", m)
+	}
+
+	if m.InsertQuery != "INSERT INTO users (email) VALUES ($1) RETURNING id" {
+		t.Errorf("unexpected insert statement: %s", m.InsertQuery)
+	}
+
+	if m.UpdateQuery != "UPDATE users SET email = $1 WHERE id = $2" {
+		t.Errorf("unexpected update statement: %s", m.UpdateQuery)
+	}
+
+	if m = newTableModel(&schemaTable{Name: "seeds", Columns: table.Columns}, drivers["mysql"]); m.Name != "SeedRow" {
+		t.Errorf("expected a reserved name to be suffixed; actual %s", m.Name)
+	}
+}
+
+func TestGoNames(t *testing.T) {
+	tests := []struct {
+		Column string
+		Name   string
+		Param  string
+	}{
+		{"id", "ID", "id"},
+		{"user_id", "UserID", "userID"},
+		{"api-key", "APIKey", "apiKey"},
+		{"type", "Type", "typeKey"},
+		{"2fa", "X2fa", "x2fa"},
+	}
+
+	for _, test := range tests {
+		if name := goName(test.Column); name != test.Name {
+			t.Errorf("expected %s to be named %s; actual %s", test.Column, test.Name, name)
+		} else if param := goParam(name); param != test.Param {
+			t.Errorf("expected %s to be passed as %s; actual %s", name, test.Param, param)
+		}
+	}
+
+	for plural, expected := range map[string]string{"users": "user", "categories": "category", "addresses": "address", "status": "status", "tags": "tag"} {
+		if actual := singular(plural); actual != expected {
+			t.Errorf("expected %s to be singular %s; actual %s", plural, expected, actual)
+		}
+	}
+}
+
+func TestGoType(t *testing.T) {
+	tests := []struct {
+		Type     string
+		NotNull  bool
+		Expected string
+	}{
+		{"INTEGER", true, "int64"},
+		{"bigint", false, "sql.NullInt64"},
+		{"tinyint(1)", true, "bool"},
+		{"character varying(255)", false, "sql.NullString"},
+		{"timestamp with time zone", true, "time.Time"},
+		{"numeric(10,2)", true, "float64"},
+		{"bytea", false, "[]byte"},
+		{"interval", true, "string"},
+	}
+
+	for _, test := range tests {
+		if actual := goType(test.Type, test.NotNull); actual != test.Expected {
+			t.Errorf("expected %s to map to %s; actual %s", test.Type, test.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,427 @@
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+)
+
+var (
+	lintFormat string
+	lintFailOn string
+
+	createTablePattern = regexp.MustCompile(`^CREATE\s+TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([^\s(]+)`)
+	dropTablePattern   = regexp.MustCompile(`^DROP\s+TABLE\s+(?:IF\s+EXISTS\s+)?(\S+)`)
+	alterTablePattern  = regexp.MustCompile(`^ALTER\s+TABLE\s+(?:IF\s+EXISTS\s+)?(?:ONLY\s+)?(\S+)\s+(.*)$`)
+	addColumnPattern   = regexp.MustCompile(`^ADD\s+(?:COLUMN\s+)?(?:IF\s+NOT\s+EXISTS\s+)?(\S+)\s+(.*)$`)
+	dropColumnPattern  = regexp.MustCompile(`^DROP\s+COLUMN\s+(?:IF\s+EXISTS\s+)?(\S+)`)
+	pgAlterTypePattern = regexp.MustCompile(`^ALTER\s+(?:COLUMN\s+)?\S+\s+(?:SET\s+DATA\s+)?TYPE\b`)
+	myAlterTypePattern = regexp.MustCompile(`^(?:MODIFY|CHANGE)\s+`)
+	msAlterTypePattern = regexp.MustCompile(`^ALTER\s+COLUMN\s+\S+\s+(\S+)`)
+	createIndexPattern = regexp.MustCompile(`^CREATE\s+(?:UNIQUE\s+)?INDEX\s+(CONCURRENTLY\s+)?(?:IF\s+NOT\s+EXISTS\s+)?(\S+)\s+ON\s+(?:ONLY\s+)?([^\s(]+)`)
+	dropIndexPattern   = regexp.MustCompile(`^DROP\s+INDEX\s+(?:CONCURRENTLY\s+)?(?:IF\s+EXISTS\s+)?(\S+)`)
+	notNullPattern     = regexp.MustCompile(`\bNOT\s+NULL\b`)
+	defaultPattern     = regexp.MustCompile(`\bDEFAULT\b|\bIDENTITY\b|\bAUTO_INCREMENT\b|\bGENERATED\b|\bSERIAL\b`)
+	whitespacePattern  = regexp.MustCompile(`\s+`)
+	dollarQuotePattern = regexp.MustCompile(`^\$[A-Za-z_]*\$`)
+
+	// concurrentIndexDrivers support building indexes without blocking writes
+	concurrentIndexDrivers = map[string]bool{"postgres": true, "pgx": true}
+	severityRank           = map[string]int{severityWarning: 1, severityError: 2}
+)
+
+// Finding describes a risky statement reported by the migration linter
+type Finding struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Severity string `json:"severity"`
+	Rule     string `json:"rule"`
+	Message  string `json:"message"`
+}
+
+// statement is a single SQL statement and the line on which it begins
+type statement struct {
+	Line int
+	Text string
+}
+
+func lintMigrationAction(_ *cli.Context) error {
+	if wd == "" {
+		wd = "."
+	}
+
+	if lintFormat != "text" && lintFormat != "json" {
+		return errors.Errorf("unsupported output format: %s", lintFormat)
+	}
+
+	if _, ok := severityRank[lintFailOn]; !ok {
+		return errors.Errorf("unsupported severity: %s", lintFailOn)
+	}
+
+	project, err := loadProject(wd)
+	if err != nil {
+		return err
+	}
+
+	if e, err := lookupEngine(project.Migrator); err != nil {
+		return err
+	} else if !e.paired() {
+		return errors.Errorf("linting is not supported for %s migrations", project.Migrator)
+	}
+
+	if driver == "" {
+		driver = project.Driver
+	}
+
+	findings, err := lintMigrations(filepath.Join(wd, migrationDir), driver)
+	if err != nil {
+		return err
+	}
+
+	if err := printFindings(os.Stdout, findings, lintFormat); err != nil {
+		return err
+	}
+
+	failures := 0
+	for _, f := range findings {
+		if severityRank[f.Severity] >= severityRank[lintFailOn] {
+			failures++
+		}
+	}
+
+	if failures > 0 {
+		return cli.NewExitError(fmt.Sprintf("migration lint found %d issue(s)", failures), 1)
+	}
+	return nil
+}
+
+// lintMigrations checks the migrations within dir for destructive or locking
+// statements and for down migrations that do not reverse their up migration
+func lintMigrations(dir string, driverName string) ([]Finding, error) {
+	migrationList, err := scanMigrations(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	downs := make(map[uint64]Migration)
+	for _, m := range migrationList {
+		if m.Direction == "down" {
+			downs[m.Version] = m
+		}
+	}
+
+	findings := make([]Finding, 0)
+	for _, up := range migrationList {
+		if up.Direction != "up" {
+			continue
+		}
+
+		upStatements, err := readStatements(up.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		report := func(line int, severity, rule, format string, args ...interface{}) {
+			findings = append(findings, Finding{
+				File:     relPath(up.Path),
+				Line:     line,
+				Severity: severity,
+				Rule:     rule,
+				Message:  fmt.Sprintf(format, args...),
+			})
+		}
+
+		reversals := lintStatements(upStatements, driverName, report)
+
+		down, ok := downs[up.Version]
+		if !ok {
+			report(1, severityError, "missing-down", "no down migration found for version %d", up.Version)
+			continue
+		}
+
+		downStatements, err := readStatements(down.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(downStatements) == 0 && len(upStatements) > 0 {
+			report(1, severityWarning, "irreversible-down", "%s is empty", filepath.Base(down.Path))
+			continue
+		}
+
+		reverted := make(map[string]bool)
+		for _, s := range downStatements {
+			for _, r := range reversalsOf(s.Text) {
+				reverted[r] = true
+			}
+		}
+
+		for _, r := range reversals {
+			if !reverted[r.Key] && !reverted[r.Table] {
+				report(r.Line, severityWarning, "irreversible-down", "%s does not %s", filepath.Base(down.Path), r.Description)
+			}
+		}
+	}
+	return findings, nil
+}
+
+// reportFunc records a finding at a line of the migration being linted
+type reportFunc func(line int, severity, rule, format string, args ...interface{})
+
+// reversal is a change applied by an up migration that its down migration
+// is expected to revert
+type reversal struct {
+	Line        int
+	Key         string
+	Table       string
+	Description string
+}
+
+// lintStatements reports risky up statements and returns the changes that
+// the corresponding down migration is expected to revert
+func lintStatements(statements []statement, driverName string, report reportFunc) []reversal {
+	created := make(map[string]bool)
+	reversals := make([]reversal, 0)
+	for _, s := range statements {
+		text := normalizeStatement(s.Text)
+		switch {
+		case createTablePattern.MatchString(text):
+			table := identifier(createTablePattern.FindStringSubmatch(text)[1])
+			created[table] = true
+			reversals = append(reversals, reversal{s.Line, "table:" + table, "table:" + table, fmt.Sprintf("drop table %s", table)})
+		case dropTablePattern.MatchString(text):
+			report(s.Line, severityWarning, "drop-table", "drops table %s", identifier(dropTablePattern.FindStringSubmatch(text)[1]))
+		case createIndexPattern.MatchString(text):
+			matches := createIndexPattern.FindStringSubmatch(text)
+			index, table := identifier(matches[2]), identifier(matches[3])
+			if concurrentIndexDrivers[driverName] && matches[1] == "" && !created[table] {
+				report(s.Line, severityWarning, "index-not-concurrent", "index %s blocks writes to %s; use CREATE INDEX CONCURRENTLY", index, table)
+			}
+			reversals = append(reversals, reversal{s.Line, "index:" + index, "table:" + table, fmt.Sprintf("drop index %s", index)})
+		case alterTablePattern.MatchString(text):
+			matches := alterTablePattern.FindStringSubmatch(text)
+			table := identifier(matches[1])
+			for _, clause := range splitClauses(matches[2]) {
+				reversals = append(reversals, lintAlterClause(s.Line, table, clause, driverName, created[table], report)...)
+			}
+		}
+	}
+	return reversals
+}
+
+func lintAlterClause(line int, table, clause, driverName string, created bool, report reportFunc) []reversal {
+	switch {
+	case dropColumnPattern.MatchString(clause):
+		column := identifier(dropColumnPattern.FindStringSubmatch(clause)[1])
+		report(line, severityWarning, "drop-column", "drops column %s.%s", table, column)
+	case addColumnPattern.MatchString(clause):
+		matches := addColumnPattern.FindStringSubmatch(clause)
+		column := identifier(matches[1])
+		if column == "constraint" || column == "primary" || column == "unique" || column == "foreign" || column == "index" {
+			return nil
+		}
+		if !created && notNullPattern.MatchString(matches[2]) && !defaultPattern.MatchString(matches[2]) {
+			report(line, severityError, "not-null-without-default", "column %s.%s is NOT NULL without a default", table, column)
+		}
+		return []reversal{{line, "column:" + table + "." + column, "table:" + table, fmt.Sprintf("drop column %s.%s", table, column)}}
+	case alterTypeClause(clause, driverName):
+		report(line, severityWarning, "alter-column-type", "changes a column type on %s, which may rewrite or lock the table", table)
+	}
+	return nil
+}
+
+func alterTypeClause(clause, driverName string) bool {
+	switch driverName {
+	case "mysql":
+		return myAlterTypePattern.MatchString(clause)
+	case "sqlserver":
+		matches := msAlterTypePattern.FindStringSubmatch(clause)
+		return matches != nil && matches[1] != "SET" && matches[1] != "DROP" && matches[1] != "ADD"
+	}
+	return pgAlterTypePattern.MatchString(clause)
+}
+
+// reversalsOf lists the keys of the changes reverted by a down statement
+func reversalsOf(text string) []string {
+	text = normalizeStatement(text)
+	switch {
+	case dropTablePattern.MatchString(text):
+		return []string{"table:" + identifier(dropTablePattern.FindStringSubmatch(text)[1])}
+	case dropIndexPattern.MatchString(text):
+		return []string{"index:" + identifier(dropIndexPattern.FindStringSubmatch(text)[1])}
+	case alterTablePattern.MatchString(text):
+		matches := alterTablePattern.FindStringSubmatch(text)
+		table := identifier(matches[1])
+		keys := make([]string, 0)
+		for _, clause := range splitClauses(matches[2]) {
+			if m := dropColumnPattern.FindStringSubmatch(clause); m != nil {
+				keys = append(keys, "column:"+table+"."+identifier(m[1]))
+			} else if m := dropIndexPattern.FindStringSubmatch(clause); m != nil {
+				keys = append(keys, "index:"+identifier(m[1]))
+			}
+		}
+		return keys
+	}
+	return nil
+}
+
+func printFindings(w io.Writer, findings []Finding, format string) error {
+	if format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(findings)
+	}
+
+	for _, f := range findings {
+		fmt.Fprintf(w, "%s:%d: %s: %s [%s]\n", f.File, f.Line, f.Severity, f.Message, f.Rule)
+	}
+	return nil
+}
+
+func readStatements(path string) ([]statement, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return splitStatements(string(data)), nil
+}
+
+// splitStatements splits src into statements on semicolons, ignoring comments
+// and any semicolons within quoted strings, identifiers or dollar quotes
+func splitStatements(src string) []statement {
+	statements := make([]statement, 0)
+	var current strings.Builder
+	line, start := 1, 0
+
+	flush := func() {
+		if text := strings.TrimSpace(current.String()); text != "" {
+			statements = append(statements, statement{Line: start, Text: text})
+		}
+		current.Reset()
+		start = 0
+	}
+
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case c == '-' && strings.HasPrefix(src[i:], "--"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			i--
+			continue
+		case c == '/' && strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				end = len(src) - i - 2
+			}
+			line += strings.Count(src[i:i+end+2], "\n")
+			i += end + 3
+			current.WriteByte(' ')
+			continue
+		case c == ';':
+			flush()
+			continue
+		case c == '\n':
+			line++
+		}
+
+		if start == 0 && !isSpace(c) {
+			start = line
+		}
+
+		var end int
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			end = closingQuote(src, i, string(c))
+		case c == '[':
+			end = closingQuote(src, i, "]")
+		case c == '$' && dollarQuotePattern.MatchString(src[i:]):
+			tag := dollarQuotePattern.FindString(src[i:])
+			if j := strings.Index(src[i+len(tag):], tag); j >= 0 {
+				end = i + len(tag) + j + len(tag) - 1
+			} else {
+				end = len(src) - 1
+			}
+		default:
+			current.WriteByte(c)
+			continue
+		}
+
+		current.WriteString(src[i : end+1])
+		line += strings.Count(src[i:end+1], "\n")
+		i = end
+	}
+	flush()
+	return statements
+}
+
+func closingQuote(src string, i int, quote string) int {
+	for j := i + 1; j < len(src); j++ {
+		if strings.HasPrefix(src[j:], quote) {
+			if strings.HasPrefix(src[j+1:], quote) && quote != "]" {
+				j++
+				continue
+			}
+			return j
+		}
+	}
+	return len(src) - 1
+}
+
+// splitClauses splits the comma separated clauses of an ALTER TABLE statement
+func splitClauses(text string) []string {
+	clauses := make([]string, 0)
+	depth, last := 0, 0
+	for i, c := range text {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				clauses = append(clauses, strings.TrimSpace(text[last:i]))
+				last = i + 1
+			}
+		}
+	}
+	return append(clauses, strings.TrimSpace(text[last:]))
+}
+
+func normalizeStatement(text string) string {
+	return strings.ToUpper(whitespacePattern.ReplaceAllString(strings.TrimSpace(text), " "))
+}
+
+// identifier normalizes a possibly quoted or schema qualified identifier
+func identifier(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.ToLower(strings.Trim(name, "\"`[]"))
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func relPath(path string) string {
+	if rel, err := filepath.Rel(wd, path); err == nil {
+		return rel
+	}
+	return path
+}
@@ -0,0 +1,114 @@
+package actions
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestSplitStatements(t *testing.T) {
+	src := `-- leading comment; with a semicolon
+CREATE TABLE users (
+    name TEXT DEFAULT 'a;b'
+);
+/* block
+   comment; */ INSERT INTO users VALUES ('it''s');
+CREATE FUNCTION f() RETURNS void AS $$ BEGIN; END; $$ LANGUAGE plpgsql;`
+
+	statements := splitStatements(src)
+	if len(statements) != 3 {
+		t.Fatalf("expected 3 statements; actual %d: %v", len(statements), statements)
+	}
+
+	for i, line := range []int{2, 6, 7} {
+		if statements[i].Line != line {
+			t.Errorf("expected statement %d to begin on line %d; actual %d", i, line, statements[i].Line)
+		}
+	}
+}
+
+func TestLintMigrations(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		path := filepath.Join(wd, defaultMigrationDir)
+		os.MkdirAll(path, 0755)
+
+		files := map[string]string{
+			"0001_users.up.sql":     "CREATE TABLE users(id INT NOT NULL);\nCREATE INDEX users_id ON users(id);",
+			"0001_users.down.sql":   "DROP TABLE users;",
+			"0002_email.up.sql":     "ALTER TABLE users ADD COLUMN email TEXT NOT NULL;\n\nCREATE INDEX users_email ON users (email);",
+			"0002_email.down.sql":   "ALTER TABLE users DROP COLUMN email;",
+			"0003_cleanup.up.sql":   "ALTER TABLE users DROP COLUMN email, ALTER COLUMN id TYPE BIGINT;\nDROP TABLE accounts;",
+			"0004_roles.up.sql":     "CREATE TABLE roles(id INT);",
+			"0004_roles.down.sql":   "-- nothing to see here",
+			"0005_indexes.up.sql":   "CREATE INDEX CONCURRENTLY users_name ON users (name);",
+			"0005_indexes.down.sql": "DROP INDEX CONCURRENTLY users_name;",
+		}
+		for name, content := range files {
+			ioutil.WriteFile(filepath.Join(path, name), []byte(content), 0644)
+		}
+
+		findings, err := lintMigrations(path, "postgres")
+		if err != nil {
+			t.Fatalf("failed to lint migrations: %s", err)
+		}
+
+		expected := map[string]int{
+			"0002_email.up.sql:1:not-null-without-default": 1,
+			"0002_email.up.sql:3:index-not-concurrent":     1,
+			"0002_email.up.sql:3:irreversible-down":        1,
+			"0003_cleanup.up.sql:1:drop-column":            1,
+			"0003_cleanup.up.sql:1:alter-column-type":      1,
+			"0003_cleanup.up.sql:2:drop-table":             1,
+			"0003_cleanup.up.sql:1:missing-down":           1,
+			"0004_roles.up.sql:1:irreversible-down":        1,
+		}
+
+		actual := make(map[string]int)
+		for _, f := range findings {
+			actual[filepath.Base(f.File)+":"+strconv.Itoa(f.Line)+":"+f.Rule]++
+		}
+
+		for key, count := range expected {
+			if actual[key] != count {
+				t.Errorf("expected %d %s finding(s); actual %d", count, key, actual[key])
+			}
+		}
+
+		if len(findings) != len(expected) {
+			t.Errorf("expected %d findings; actual %d: %+v", len(expected), len(findings), findings)
+		}
+
+		var out bytes.Buffer
+		if err := printFindings(&out, findings, "json"); err != nil {
+			t.Fatalf("failed to print findings: %s", err)
+		}
+
+		var decoded []Finding
+		if err := json.Unmarshal(out.Bytes(), &decoded); err != nil || len(decoded) != len(findings) {
+			t.Errorf("expected json findings to round trip: %s", err)
+		}
+	})
+}
+
+func TestLintMysqlMigrations(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		path := filepath.Join(wd, defaultMigrationDir)
+		os.MkdirAll(path, 0755)
+
+		ioutil.WriteFile(filepath.Join(path, "0001_users.up.sql"), []byte("ALTER TABLE `users` MODIFY COLUMN `id` BIGINT;\nCREATE INDEX users_id ON users (id);"), 0644)
+		ioutil.WriteFile(filepath.Join(path, "0001_users.down.sql"), []byte("DROP INDEX users_id ON users;"), 0644)
+
+		findings, err := lintMigrations(path, "mysql")
+		if err != nil {
+			t.Fatalf("failed to lint migrations: %s", err)
+		}
+
+		if len(findings) != 1 || findings[0].Rule != "alter-column-type" {
+			t.Errorf("expected a single alter-column-type finding; actual %+v", findings)
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package actions
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+var appLogging bool
+
+// stageLogging writes the logging package configuring slog, along with the
+// request logging middleware of the app framework
+func stageLogging(templates *template.Template) error {
+	path := filepath.Join(wd, "logging")
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+
+	log.Println("staging logging...")
+	if err := writeSource(templates, "templates/logging/logging.tpl", filepath.Join(path, "logging.go"), &Context{}); err != nil {
+		return err
+	}
+
+	context := &Context{Module: module}
+	return writeSource(templates, fmt.Sprintf("templates/logging/%s.tpl", framework), filepath.Join(wd, "logging.go"), context)
+}
@@ -0,0 +1,108 @@
+package actions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestStageLogging(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(m string) { module = m }(module)
+		module = "github.com/example/app"
+
+		for _, app := range listApps() {
+			framework = app
+			if err := stageLogging(templates); err != nil {
+				t.Fatalf("failed to stage the %s logging: %s", app, err)
+			}
+
+			expected := "func requestLogger("
+			if app == "grpc" {
+				expected = "func unaryLogger("
+			}
+
+			src, _ := ioutil.ReadFile(filepath.Join(wd, "logging.go"))
+			for _, s := range []string{expected, `"github.com/example/app/logging"`, "logging.WithRequestID("} {
+				if !bytes.Contains(src, []byte(s)) {
+					t.Errorf("generated %s middleware did not contain %s: \n%s", app, s, src)
+				}
+			}
+		}
+
+		src, _ := ioutil.ReadFile(filepath.Join(wd, "logging", "logging.go"))
+		for _, expected := range []string{"func Setup(format, level string) error {", "func FromContext(ctx context.Context) *slog.Logger {", `const RequestIDHeader = "X-Request-ID"`} {
+			if !bytes.Contains(src, []byte(expected)) {
+				t.Errorf("generated logging package did not contain %s: \n%s", expected, src)
+			}
+		}
+	})
+}
+
+func TestCreateWebAppLogging(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(m string, l bool) { module, appLogging = m, l }(module, appLogging)
+		module, appLogging = "github.com/example/app", true
+		host, port = "localhost", 8080
+
+		middleware := map[string]string{
+			"echo": "requestLogger(),",
+			"gin":  "r := gin.New()",
+			"grpc": "grpc.NewServer(serverOptions()...)",
+			"iris": "app.Use(requestLogger)",
+			"ozzo": "requestLogger,",
+		}
+
+		for _, app := range listApps() {
+			framework = app
+			if err := createWebApp(templates); err != nil {
+				t.Fatalf("failed to create %s web application: %s", framework, err)
+			}
+
+			actual, _ := ioutil.ReadFile(filepath.Join(wd, "app.go"))
+			for _, expected := range []string{`"github.com/example/app/logging"`, "_LOG_LEVEL\")); err != nil {", middleware[app]} {
+				if !bytes.Contains(actual, []byte(expected)) {
+					t.Errorf("generated %s application did not contain %s: \n%s", app, expected, actual)
+				}
+			}
+
+			for _, logger := range []string{"gin.Default()", "middleware.Logger()", "access.Logger("} {
+				if bytes.Contains(actual, []byte(logger)) {
+					t.Errorf("expected the %s application not to use %s: \n%s", app, logger, actual)
+				}
+			}
+
+			if app == "grpc" {
+				src, _ := ioutil.ReadFile(filepath.Join(wd, "server.go"))
+				if !bytes.Contains(src, []byte("grpc.ChainUnaryInterceptor(unaryLogger),")) {
+					t.Errorf("expected the grpc server to log its calls: \n%s", src)
+				}
+			}
+		}
+	})
+}
+
+func TestResourceLogging(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(l bool) { appLogging = l }(appLogging)
+		appLogging = true
+
+		fields, _ := parseResourceFields([]string{"email:string"})
+		r, err := newResource("user", fields, "gin", drivers["postgres"])
+		if err != nil {
+			t.Fatalf("failed to describe the resource: %s", err)
+		}
+
+		model := newTableModel(r.Table, drivers["postgres"])
+		src, err := renderSource(templates, "templates/resource/gin.tpl", "users_handlers.go", resourceContext(r, model, "github.com/example/app"))
+		if err != nil {
+			t.Fatalf("failed to render the handlers: %s", err)
+		}
+
+		expected := `logging.FromContext(c.Request.Context()).Error("failed to list users", "error", err)`
+		if !bytes.Contains(src, []byte(expected)) {
+			t.Errorf("expected the handlers to log with the request logger: \n%s", src)
+		}
+	})
+}
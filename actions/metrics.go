@@ -0,0 +1,36 @@
+package actions
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// defaultMetricsPort is the admin port serving the metrics of gRPC apps,
+// which cannot serve them on the main port
+const defaultMetricsPort = 2112
+
+var (
+	appMetrics  bool
+	metricsPort int
+)
+
+// stageMetrics writes the metrics package registering the Prometheus metrics,
+// along with the middleware of the app framework recording them
+func stageMetrics(templates *template.Template) error {
+	path := filepath.Join(wd, "metrics")
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+
+	log.Println("staging metrics...")
+	context := &Context{App: framework, Migrations: migrations}
+	if err := writeSource(templates, "templates/metrics/metrics.tpl", filepath.Join(path, "metrics.go"), context); err != nil {
+		return err
+	}
+
+	context = &Context{Module: module}
+	return writeSource(templates, fmt.Sprintf("templates/metrics/%s.tpl", framework), filepath.Join(wd, "metrics.go"), context)
+}
@@ -0,0 +1,144 @@
+package actions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestStageMetrics(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(m string, b bool) { module, migrations = m, b }(module, migrations)
+		module, migrations = "github.com/example/app", true
+
+		for _, app := range listApps() {
+			framework = app
+			if err := stageMetrics(templates); err != nil {
+				t.Fatalf("failed to stage the %s metrics: %s", app, err)
+			}
+
+			expected := []string{"func recordMetrics(", "metrics.StartRequest()"}
+			if app == "grpc" {
+				expected = []string{"func unaryMetrics(", "metrics.StartCall()"}
+			}
+
+			src, _ := ioutil.ReadFile(filepath.Join(wd, "metrics.go"))
+			for _, s := range append(expected, `"github.com/example/app/metrics"`) {
+				if !bytes.Contains(src, []byte(s)) {
+					t.Errorf("generated %s middleware did not contain %s: \n%s", app, s, src)
+				}
+			}
+
+			pkg, _ := ioutil.ReadFile(filepath.Join(wd, "metrics", "metrics.go"))
+			for _, s := range []string{"collectors.NewGoCollector()", "func RegisterDB(db *sql.DB, name string) error {", "func Serve(addr string) (*http.Server, error) {"} {
+				if !bytes.Contains(pkg, []byte(s)) {
+					t.Errorf("generated %s metrics package did not contain %s: \n%s", app, s, pkg)
+				}
+			}
+		}
+
+		migrations = false
+		if err := stageMetrics(templates); err != nil {
+			t.Fatalf("failed to stage the metrics: %s", err)
+		}
+
+		if src, _ := ioutil.ReadFile(filepath.Join(wd, "metrics", "metrics.go")); bytes.Contains(src, []byte("RegisterDB")) {
+			t.Errorf("expected no database metrics without migrations: \n%s", src)
+		}
+	})
+}
+
+func TestCreateWebAppMetrics(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(m string, b bool, p int) { module, appMetrics, metricsPort = m, b, p }(module, appMetrics, metricsPort)
+		module, appMetrics = "github.com/example/app", true
+		host, port = "localhost", 8080
+
+		endpoints := map[string]string{
+			"echo": "r.GET(\"/metrics\", echo.WrapHandler(metrics.Handler()))",
+			"gin":  "r.GET(\"/metrics\", gin.WrapH(metrics.Handler()))",
+			"iris": "app.Get(\"/metrics\", iris.FromStd(metrics.Handler()))",
+			"ozzo": "r.Get(\"/metrics\", routing.HTTPHandler(metrics.Handler()))",
+		}
+
+		for _, app := range listApps() {
+			framework, metricsPort = app, 0
+			if app == "grpc" {
+				metricsPort = defaultMetricsPort
+			}
+
+			if err := createWebApp(templates); err != nil {
+				t.Fatalf("failed to create %s web application: %s", framework, err)
+			}
+
+			actual, _ := ioutil.ReadFile(filepath.Join(wd, "app.go"))
+			if !bytes.Contains(actual, []byte(`"github.com/example/app/metrics"`)) {
+				t.Errorf("generated %s application did not import the metrics: \n%s", app, actual)
+			}
+
+			if app == "grpc" {
+				if !bytes.Contains(actual, []byte("metrics.Serve(")) {
+					t.Errorf("expected the grpc application to serve the metrics on the admin port: \n%s", actual)
+				}
+
+				src, _ := ioutil.ReadFile(filepath.Join(wd, "server.go"))
+				if !bytes.Contains(src, []byte("grpc.ChainUnaryInterceptor(unaryMetrics),")) {
+					t.Errorf("expected the grpc server to record the metrics of its calls: \n%s", src)
+				}
+				continue
+			}
+
+			if !bytes.Contains(actual, []byte(endpoints[app])) {
+				t.Errorf("generated %s application did not contain %s: \n%s", app, endpoints[app], actual)
+			}
+
+			metricsPort = 9100
+			if err := createWebApp(templates); err != nil {
+				t.Fatalf("failed to create %s web application: %s", framework, err)
+			}
+
+			actual, _ = ioutil.ReadFile(filepath.Join(wd, "app.go"))
+			if bytes.Contains(actual, []byte(endpoints[app])) || !bytes.Contains(actual, []byte(`var metricsAddr = "localhost:9100"`)) {
+				t.Errorf("expected the %s application to serve the metrics on the admin port: \n%s", app, actual)
+			}
+		}
+	})
+}
+
+func TestSetupDbMetrics(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d, m string, b bool) { driver, module, appMetrics = d, m, b }(driver, module, appMetrics)
+		driver, module, appMetrics = "postgres", "github.com/example/app", true
+
+		if err := setupDb(templates); err != nil {
+			t.Fatalf("failed to setup database file: %s", err)
+		}
+
+		src, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "sql.go"))
+		for _, expected := range []string{`"github.com/example/app/metrics"`, `metrics.RegisterDB(db, "postgres")`} {
+			if !bytes.Contains(src, []byte(expected)) {
+				t.Errorf("generated sql package did not contain %s: \n%s", expected, src)
+			}
+		}
+	})
+}
+
+func TestStageConfigMetrics(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(p int) { metricsPort = p }(metricsPort)
+		metricsPort = defaultMetricsPort
+		host, port = "localhost", 9000
+
+		if err := stageConfig(templates); err != nil {
+			t.Fatalf("failed to stage the config package: %s", err)
+		}
+
+		src, _ := ioutil.ReadFile(filepath.Join(wd, "config", "config.go"))
+		for _, expected := range []string{"MetricsPort:     2112,", `{"metrics-port", "admin port serving the metrics"},`, "func (c *Config) MetricsAddr() string {"} {
+			if !bytes.Contains(src, []byte(expected)) {
+				t.Errorf("generated config package did not contain %s: \n%s", expected, src)
+			}
+		}
+	})
+}
@@ -0,0 +1,390 @@
+package actions
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+const (
+	defaultMigrationDir = "sql/migrations"
+	timestampFormat     = "20060102150405"
+
+	// minTimestamp is the smallest version treated as a timestamp
+	minTimestamp = 19700101000000
+)
+
+var (
+	migrationDir string
+	timestamp    bool
+	goMigration  bool
+
+	migrationPattern = regexp.MustCompile(`^(\d+)(?:_([^.]+))?(?:\.(up|down))?\.(?:sql|go)$`)
+	unsafeName       = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+func init() {
+	register(cli.Command{
+		Name:    "migration",
+		Aliases: []string{"m"},
+		Usage:   "manage database schema migrations",
+		Subcommands: []cli.Command{
+			{
+				Name:      "new",
+				Usage:     "create a sequenced up/down migration",
+				ArgsUsage: "<name>",
+				Action:    newMigrationAction,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:        "dir",
+						Value:       defaultMigrationDir,
+						Usage:       "the migrations directory",
+						Destination: &migrationDir,
+					},
+					cli.BoolFlag{
+						Name:        "timestamp",
+						Destination: &timestamp,
+						Usage:       "whether or not to version the migration using a timestamp",
+					},
+					cli.BoolFlag{
+						Name:        "go",
+						Destination: &goMigration,
+						Usage:       "whether or not to create a goose Go migration",
+					},
+				},
+			},
+			{
+				Name:   "lint",
+				Usage:  "check migrations for destructive or locking operations",
+				Action: lintMigrationAction,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:        "dir",
+						Value:       defaultMigrationDir,
+						Usage:       "the migrations directory",
+						Destination: &migrationDir,
+					},
+					cli.StringFlag{
+						Name:        "driver",
+						Usage:       "database driver (default: the project driver)",
+						Destination: &driver,
+					},
+					cli.StringFlag{
+						Name:        "format",
+						Value:       "text",
+						Usage:       "output format [i.e. text, json]",
+						Destination: &lintFormat,
+					},
+					cli.StringFlag{
+						Name:        "fail-on",
+						Value:       severityWarning,
+						Usage:       "minimum severity that results in a non-zero exit [i.e. warning, error]",
+						Destination: &lintFailOn,
+					},
+				},
+			},
+			{
+				Name:   "verify",
+				Usage:  "apply each migration up, down, and up again, comparing the schema after each step",
+				Action: verifyMigrationAction,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:        "dir",
+						Value:       defaultMigrationDir,
+						Usage:       "the migrations directory",
+						Destination: &migrationDir,
+					},
+					cli.StringFlag{
+						Name:        "dsn",
+						Usage:       "an empty database to verify against (default: a throwaway database for sqlite projects)",
+						Destination: &scratchDSN,
+					},
+				},
+			},
+			{
+				Name:      "diff",
+				Usage:     "create a migration from the changes to the declarative schema files",
+				ArgsUsage: "<name>",
+				Action:    diffMigrationAction,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:        "dir",
+						Value:       defaultMigrationDir,
+						Usage:       "the migrations directory",
+						Destination: &migrationDir,
+					},
+					cli.StringFlag{
+						Name:        "schema",
+						Value:       defaultSchemaDir,
+						Usage:       "the schema files directory",
+						Destination: &schemaDir,
+					},
+					cli.BoolFlag{
+						Name:        "timestamp",
+						Destination: &timestamp,
+						Usage:       "whether or not to version the migration using a timestamp",
+					},
+					cli.StringFlag{
+						Name:        "dsn",
+						Usage:       "an empty database to compare the schema with (default: a throwaway sqlite database)",
+						Destination: &scratchDSN,
+					},
+				},
+			},
+			{
+				Name:   "squash",
+				Usage:  "replace the migrations up to a version with a single baseline migration",
+				Action: squashMigrationAction,
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:        "to",
+						Usage:       "the last migration version to squash",
+						Destination: &squashTo,
+					},
+					cli.StringFlag{
+						Name:        "dir",
+						Value:       defaultMigrationDir,
+						Usage:       "the migrations directory",
+						Destination: &migrationDir,
+					},
+					cli.StringFlag{
+						Name:        "archive",
+						Value:       defaultArchiveDir,
+						Usage:       "the directory to move the squashed migrations to",
+						Destination: &archiveDir,
+					},
+					cli.StringFlag{
+						Name:        "dsn",
+						Usage:       "an empty database to apply the migrations to (default: a throwaway sqlite database)",
+						Destination: &scratchDSN,
+					},
+				},
+			},
+		},
+	})
+}
+
+// Migration describes a single migration file; the direction is empty for
+// engines combining the up and down statements within the same file
+type Migration struct {
+	Version   uint64
+	Name      string
+	Direction string
+	Path      string
+}
+
+// migrationName is the file name of the migration without its direction
+func migrationName(m Migration) string {
+	return strings.SplitN(filepath.Base(m.Path), ".", 2)[0]
+}
+
+func newMigrationAction(c *cli.Context) error {
+	if wd == "" {
+		wd = "."
+	}
+
+	name := c.Args().First()
+	if name == "" {
+		return errors.New("a migration name is required")
+	}
+
+	project, err := loadProject(wd)
+	if err != nil {
+		return err
+	}
+	migrator = project.Migrator
+
+	_, err = newMigration(parseTemplates(), name, time.Now())
+	return err
+}
+
+// newMigration writes the next up/down migration using the file layout of the
+// selected migration engine and returns the version
+func newMigration(templates *template.Template, name string, now time.Time) (string, error) {
+	e, err := lookupEngine(migrator)
+	if err != nil {
+		return "", err
+	}
+
+	if goMigration && migrator != "goose" {
+		return "", errors.New("Go migrations are only supported by goose")
+	}
+
+	name, err = migrationSlug(name)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(wd, migrationDir)
+	version, err := nextVersion(e, path, now)
+	if err != nil {
+		return "", err
+	}
+
+	context := &Context{
+		Version: version,
+		Name:    name,
+	}
+
+	log.Printf("creating migration %s_%s...", version, name)
+	if goMigration {
+		f, err := os.Create(filepath.Join(path, fmt.Sprintf("%s_%s.go", version, name)))
+		if err != nil {
+			return "", err
+		}
+		defer f.Close()
+		return version, templates.Lookup("templates/sql/goose/migration.go.tpl").Execute(f, context)
+	}
+
+	var up, down bytes.Buffer
+	if err := templates.Lookup("templates/sql/migration.up.tpl").Execute(&up, context); err != nil {
+		return "", err
+	}
+
+	if err := templates.Lookup("templates/sql/migration.down.tpl").Execute(&down, context); err != nil {
+		return "", err
+	}
+	return version, writeMigration(e, path, version, name, up.String(), down.String())
+}
+
+// migrationSlug normalizes name for use within a migration file name
+func migrationSlug(name string) (string, error) {
+	name = unsafeName.ReplaceAllString(strings.ToLower(name), "_")
+	name = strings.Trim(name, "_")
+	if name == "" {
+		return "", errors.New("the migration name must contain at least one letter or digit")
+	}
+	return name, nil
+}
+
+// nextVersion returns the version following the latest migration within path,
+// using a timestamp when enabled
+func nextVersion(e migrationEngine, path string, now time.Time) (string, error) {
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return "", err
+	}
+
+	existing, err := scanMigrations(path)
+	if err != nil {
+		return "", err
+	}
+
+	versions, err := migrationVersions(existing)
+	if err != nil {
+		return "", err
+	}
+
+	for _, gap := range migrationGaps(versions) {
+		if gap.From == gap.To {
+			log.Printf("warning: migration version %d is missing", gap.From)
+		} else {
+			log.Printf("warning: migration versions %d-%d are missing", gap.From, gap.To)
+		}
+	}
+
+	var latest uint64
+	if len(versions) > 0 {
+		latest = versions[len(versions)-1]
+	}
+
+	if !timestamp {
+		return fmt.Sprintf(e.Format, latest+1), nil
+	}
+
+	if !e.Timestamps {
+		return "", errors.Errorf("%s migrations cannot be versioned using a timestamp", migrator)
+	}
+
+	version := now.UTC().Format(timestampFormat)
+	if v, _ := strconv.ParseUint(version, 10, 64); v <= latest {
+		return "", errors.Errorf("refusing to create migration %s before the latest version %d", version, latest)
+	}
+	return version, nil
+}
+
+// scanMigrations lists the migration files within dir ordered by version
+func scanMigrations(dir string) ([]Migration, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	migrationList := make([]Migration, 0)
+	for _, f := range files {
+		matches := migrationPattern.FindStringSubmatch(f.Name())
+		if f.IsDir() || matches == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(matches[1], 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid migration version: %s", f.Name())
+		}
+
+		migrationList = append(migrationList, Migration{
+			Version:   version,
+			Name:      matches[2],
+			Direction: matches[3],
+			Path:      filepath.Join(dir, f.Name()),
+		})
+	}
+
+	sort.SliceStable(migrationList, func(i, j int) bool {
+		return migrationList[i].Version < migrationList[j].Version
+	})
+	return migrationList, nil
+}
+
+// migrationVersions returns the sorted unique versions, failing when more than
+// one migration shares the same version and direction
+func migrationVersions(migrationList []Migration) ([]uint64, error) {
+	seen := make(map[string]string)
+	versions := make([]uint64, 0)
+	for _, m := range migrationList {
+		key := fmt.Sprintf("%d.%s", m.Version, m.Direction)
+		if other, ok := seen[key]; ok {
+			return nil, errors.Errorf("duplicate migration version %d: %s and %s", m.Version, filepath.Base(other), filepath.Base(m.Path))
+		}
+		seen[key] = m.Path
+
+		if len(versions) == 0 || versions[len(versions)-1] != m.Version {
+			versions = append(versions, m.Version)
+		}
+	}
+	return versions, nil
+}
+
+// versionGap is an inclusive range of missing migration versions
+type versionGap struct {
+	From uint64
+	To   uint64
+}
+
+// migrationGaps lists the ranges of missing versions within a sequentially
+// numbered set of migrations; timestamped migrations are not expected to be
+// contiguous
+func migrationGaps(versions []uint64) []versionGap {
+	gaps := make([]versionGap, 0)
+	for i := 1; i < len(versions) && versions[i] < minTimestamp; i++ {
+		if versions[i] > versions[i-1]+1 {
+			gaps = append(gaps, versionGap{versions[i-1] + 1, versions[i] - 1})
+		}
+	}
+	return gaps
+}
@@ -0,0 +1,199 @@
+package actions
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/n3integration/conseil"
+)
+
+func TestNewMigration(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		migrationDir, timestamp = defaultMigrationDir, false
+		if err := stageMigrations(templates); err != nil {
+			t.Fatalf("failed to stage migrations: %s", err)
+		}
+
+		version, err := newMigration(templates, "Add Users!", time.Now())
+		if err != nil {
+			t.Fatalf("failed to create migration: %s", err)
+		}
+
+		if version != "0002" {
+			t.Errorf("expected version 0002; actual %s", version)
+		}
+
+		for _, f := range []string{"0002_add_users.up.sql", "0002_add_users.down.sql"} {
+			if !conseil.FileExists(filepath.Join(wd, migrationDir, f)) {
+				t.Errorf("expected %s to be created", f)
+			}
+		}
+	})
+}
+
+func TestNewTimestampMigration(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		migrationDir, timestamp = defaultMigrationDir, true
+		defer func() { timestamp = false }()
+
+		now := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+		version, err := newMigration(templates, "users", now)
+		if err != nil {
+			t.Fatalf("failed to create migration: %s", err)
+		}
+
+		if version != "20190102030405" {
+			t.Errorf("expected version 20190102030405; actual %s", version)
+		}
+
+		if _, err := newMigration(templates, "accounts", now.Add(-time.Hour)); err == nil {
+			t.Error("expected an out-of-order migration to generate an error")
+		}
+	})
+}
+
+func TestNewMigrationDuplicate(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		migrationDir, timestamp = defaultMigrationDir, false
+		path := filepath.Join(wd, migrationDir)
+		os.MkdirAll(path, 0755)
+		for _, f := range []string{"0001_users.up.sql", "0001_accounts.up.sql"} {
+			ioutil.WriteFile(filepath.Join(path, f), nil, 0644)
+		}
+
+		if _, err := newMigration(templates, "roles", time.Now()); err == nil {
+			t.Error("expected duplicate versions to generate an error")
+		}
+	})
+}
+
+func TestNewMigrationUnnamed(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		migrationDir, timestamp = defaultMigrationDir, false
+		path := filepath.Join(wd, migrationDir)
+		os.MkdirAll(path, 0755)
+		for _, f := range []string{"1.up.sql", "1.down.sql"} {
+			ioutil.WriteFile(filepath.Join(path, f), nil, 0644)
+		}
+
+		version, err := newMigration(templates, "users", time.Now())
+		if err != nil {
+			t.Fatalf("failed to create migration: %s", err)
+		}
+
+		if version != "0002" {
+			t.Errorf("expected the unnamed migrations to be versioned; actual %s", version)
+		}
+
+		for _, f := range []string{"0002_users.up.sql", "0002_users.down.sql"} {
+			if !conseil.FileExists(filepath.Join(path, f)) {
+				t.Errorf("expected %s to be created", f)
+			}
+		}
+	})
+}
+
+func TestNewMigrationLargeGap(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		migrationDir, timestamp = defaultMigrationDir, false
+		path := filepath.Join(wd, migrationDir)
+		os.MkdirAll(path, 0755)
+		for _, f := range []string{"0001_init.up.sql", "20240101_users.up.sql"} {
+			ioutil.WriteFile(filepath.Join(path, f), nil, 0644)
+		}
+
+		version, err := newMigration(templates, "roles", time.Now())
+		if err != nil {
+			t.Fatalf("failed to create migration: %s", err)
+		}
+
+		if version != "20240102" {
+			t.Errorf("expected version 20240102; actual %s", version)
+		}
+	})
+}
+
+func TestNewEngineMigration(t *testing.T) {
+	defer func(m string) { migrator, goMigration = m, false }(migrator)
+
+	tests := []struct {
+		Migrator    string
+		GoMigration bool
+		File        string
+		Error       bool
+	}{
+		{"goose", false, "00001_users.sql", false},
+		{"goose", true, "00001_users.go", false},
+		{"tern", false, "001_users.sql", false},
+		{"declarative", false, "0001_users.up.sql", false},
+		{"golang-migrate", true, "", true},
+	}
+
+	for _, test := range tests {
+		stageTest(t, func(t *testing.T) {
+			migrationDir, timestamp = defaultMigrationDir, false
+			migrator, goMigration = test.Migrator, test.GoMigration
+
+			_, err := newMigration(templates, "users", time.Now())
+			if test.Error {
+				if err == nil {
+					t.Errorf("expected a %s Go migration to generate an error", test.Migrator)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed to create %s migration: %s", test.Migrator, err)
+			}
+
+			if !conseil.FileExists(filepath.Join(wd, migrationDir, test.File)) {
+				t.Errorf("expected %s to be created", test.File)
+			}
+		})
+	}
+}
+
+func TestJoinedMigration(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(m string) { migrator = m }(migrator)
+		migrationDir, timestamp, migrator = defaultMigrationDir, false, "goose"
+
+		if _, err := newMigration(templates, "users", time.Now()); err != nil {
+			t.Fatalf("failed to create migration: %s", err)
+		}
+
+		actual, _ := ioutil.ReadFile(filepath.Join(wd, migrationDir, "00001_users.sql"))
+		if !strings.HasPrefix(string(actual), "-- +goose Up\n") || !strings.Contains(string(actual), gooseSeparator) {
+			t.Errorf("expected goose annotations: \n%s", actual)
+		}
+
+		timestamp, migrator = true, "tern"
+		defer func() { timestamp = false }()
+		if _, err := newMigration(templates, "roles", time.Now()); err == nil {
+			t.Error("expected a tern timestamp migration to generate an error")
+		}
+	})
+}
+
+func TestMigrationGaps(t *testing.T) {
+	tests := []struct {
+		Versions []uint64
+		Gaps     []versionGap
+	}{
+		{[]uint64{1, 2, 3}, []versionGap{}},
+		{[]uint64{1, 3, 6}, []versionGap{{2, 2}, {4, 5}}},
+		{[]uint64{1, 20240101}, []versionGap{{2, 20240100}}},
+		{[]uint64{20190102030405, 20190103030405}, []versionGap{}},
+	}
+
+	for _, test := range tests {
+		if actual := migrationGaps(test.Versions); !reflect.DeepEqual(actual, test.Gaps) {
+			t.Errorf("expected the gaps %v in %v; actual %v", test.Gaps, test.Versions, actual)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package actions
+
+import (
+	"database/sql"
+	"os"
+
+	migrate "github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/cockroachdb"
+	"github.com/golang-migrate/migrate/v4/database/mysql"
+	"github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/database/sqlite3"
+	"github.com/golang-migrate/migrate/v4/database/sqlserver"
+)
+
+// migrators open a golang-migrate database driver for a connection, keyed by
+// the supported driver name
+var migrators = map[string]func(*sql.DB) (database.Driver, error){
+	"postgres": func(db *sql.DB) (database.Driver, error) {
+		return postgres.WithInstance(db, &postgres.Config{})
+	},
+	"pgx": func(db *sql.DB) (database.Driver, error) {
+		return pgx.WithInstance(db, &pgx.Config{})
+	},
+	"sqlite3": func(db *sql.DB) (database.Driver, error) {
+		return sqlite3.WithInstance(db, &sqlite3.Config{})
+	},
+	"sqlite": func(db *sql.DB) (database.Driver, error) {
+		return sqlite3.WithInstance(db, &sqlite3.Config{})
+	},
+	"mysql": func(db *sql.DB) (database.Driver, error) {
+		return mysql.WithInstance(db, &mysql.Config{})
+	},
+	"sqlserver": func(db *sql.DB) (database.Driver, error) {
+		return sqlserver.WithInstance(db, &sqlserver.Config{})
+	},
+	"cockroachdb": func(db *sql.DB) (database.Driver, error) {
+		return cockroachdb.WithInstance(db, &cockroachdb.Config{})
+	},
+}
+
+// golangMigrator drives golang-migrate migrations
+type golangMigrator struct {
+	m *migrate.Migrate
+}
+
+func openGolangMigrator(project *Project, connStr string) (Migrator, *sql.DB, error) {
+	m, db, err := openMigrate(project, connStr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &golangMigrator{m}, db, nil
+}
+
+func (g *golangMigrator) Up() error {
+	return g.m.Up()
+}
+
+func (g *golangMigrator) Down() error {
+	return g.m.Down()
+}
+
+func (g *golangMigrator) Steps(n int) error {
+	if err := g.m.Steps(n); err != os.ErrNotExist {
+		return err
+	}
+	return migrate.ErrNoChange
+}
+
+func (g *golangMigrator) Migrate(version uint64) error {
+	return g.m.Migrate(uint(version))
+}
+
+func (g *golangMigrator) Force(version int) error {
+	return g.m.Force(version)
+}
+
+func (g *golangMigrator) Version() (uint64, bool, error) {
+	version, dirty, err := g.m.Version()
+	return uint64(version), dirty, err
+}
+
+func (g *golangMigrator) Close() error {
+	sourceErr, dbErr := g.m.Close()
+	if sourceErr != nil {
+		return sourceErr
+	}
+	return dbErr
+}
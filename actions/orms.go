@@ -0,0 +1,343 @@
+package actions
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+const defaultORM = "none"
+
+var orm string
+
+// queryLayer describes a supported ORM or query layer wrapping the database
+// opened by the generated sql package; migrations and seeds keep using the
+// underlying *sql.DB
+type queryLayer struct {
+	// Conn is the type of the handle returned by Accessor and used by the
+	// generated repositories; empty when they use the *sql.DB
+	Conn string
+	// Querier is the type of the handle used by the generated repositories
+	// both outside and within a transaction; empty when they use the
+	// Querier interface generated with the Store
+	Querier string
+	// Accessor names the function of the sql package returning the handle
+	Accessor string
+	// Repositories is whether the generated repositories query the handle
+	// rather than the *sql.DB
+	Repositories bool
+	// Tag formats the struct tag mapping a model field to its column, given
+	// whether it is part of the primary key and generated by the database
+	Tag func(column string, key, auto bool) string
+	// Binds is whether the layer rewrites ? placeholders for the driver
+	Binds bool
+	// Fallible is whether opening the handle also returns an error
+	Fallible bool
+	// Dialects map the supported drivers to the packages and expression
+	// wrapping db in the handle
+	Dialects map[string]layerDialect
+}
+
+// layerDialect opens the handle of a query layer for a driver
+type layerDialect struct {
+	// Imports lists the import specs of the packages opening the handle
+	Imports []string
+	Open    string
+}
+
+// ormContext describes the query layer rendered into the generated sql
+// package
+type ormContext struct {
+	Name string
+	queryLayer
+	layerDialect
+}
+
+var queryLayers = map[string]queryLayer{
+	"none": {},
+	"sqlx": {
+		Conn:         "*sqlx.DB",
+		Accessor:     "DB",
+		Repositories: true,
+		Dialects:     sqlxDialects(),
+	},
+	"gorm": {
+		Conn:         "*gorm.DB",
+		Querier:      "*gorm.DB",
+		Accessor:     "DB",
+		Repositories: true,
+		Tag: func(column string, key, _ bool) string {
+			if key {
+				return `gorm:"column:` + column + `;primaryKey"`
+			}
+			return `gorm:"column:` + column + `"`
+		},
+		Binds:    true,
+		Fallible: true,
+		Dialects: map[string]layerDialect{
+			"postgres":    gormDialect("postgres", "postgres.New(postgres.Config{Conn: db})"),
+			"pgx":         gormDialect("postgres", "postgres.New(postgres.Config{Conn: db})"),
+			"cockroachdb": gormDialect("postgres", "postgres.New(postgres.Config{Conn: db})"),
+			"mysql":       gormDialect("mysql", "mysql.New(mysql.Config{Conn: db})"),
+			"sqlite3":     gormDialect("sqlite", "sqlite.Dialector{Conn: db}"),
+			"sqlserver":   gormDialect("sqlserver", "sqlserver.New(sqlserver.Config{Conn: db})"),
+		},
+	},
+	"bun": {
+		Conn:         "*bun.DB",
+		Querier:      "bun.IDB",
+		Accessor:     "DB",
+		Repositories: true,
+		Tag: func(column string, key, auto bool) string {
+			switch {
+			case auto:
+				return `bun:"` + column + `,pk,autoincrement"`
+			case key:
+				return `bun:"` + column + `,pk"`
+			}
+			return `bun:"` + column + `"`
+		},
+		Binds: true,
+		Dialects: map[string]layerDialect{
+			"postgres":    bunDialect("pgdialect"),
+			"pgx":         bunDialect("pgdialect"),
+			"cockroachdb": bunDialect("pgdialect"),
+			"mysql":       bunDialect("mysqldialect"),
+			"sqlite3":     bunDialect("sqlitedialect"),
+			"sqlite":      bunDialect("sqlitedialect"),
+			"sqlserver":   bunDialect("mssqldialect"),
+		},
+	},
+	"ent": {
+		Conn:     "*ent.Client",
+		Accessor: "Client",
+		Dialects: map[string]layerDialect{
+			"postgres":    entDialect("Postgres"),
+			"pgx":         entDialect("Postgres"),
+			"cockroachdb": entDialect("Postgres"),
+			"mysql":       entDialect("MySQL"),
+			"sqlite3":     entDialect("SQLite"),
+			"sqlite":      entDialect("SQLite"),
+		},
+	},
+}
+
+// sqlxDialects wraps the database of every driver using the name it
+// registers with database/sql
+func sqlxDialects() map[string]layerDialect {
+	dialects := make(map[string]layerDialect)
+	for name, d := range drivers {
+		dialects[name] = layerDialect{
+			Imports: []string{`"github.com/jmoiron/sqlx"`},
+			Open:    `sqlx.NewDb(db, "` + d.Name + `")`,
+		}
+	}
+	return dialects
+}
+
+func gormDialect(pkg, dialector string) layerDialect {
+	return layerDialect{
+		Imports: []string{`"gorm.io/driver/` + pkg + `"`, `"gorm.io/gorm"`},
+		Open:    "gorm.Open(" + dialector + ", &gorm.Config{})",
+	}
+}
+
+func bunDialect(pkg string) layerDialect {
+	return layerDialect{
+		Imports: []string{`"github.com/uptrace/bun"`, `"github.com/uptrace/bun/dialect/` + pkg + `"`},
+		Open:    "bun.NewDB(db, " + pkg + ".New())",
+	}
+}
+
+func entDialect(name string) layerDialect {
+	return layerDialect{
+		Imports: []string{`"entgo.io/ent/dialect"`, `entsql "entgo.io/ent/dialect/sql"`},
+		Open:    "ent.NewClient(ent.Driver(entsql.OpenDB(dialect." + name + ", db)))",
+	}
+}
+
+// lookupORM retrieves the descriptor for the named query layer, defaulting
+// to none
+func lookupORM(name string) (queryLayer, error) {
+	if name == "" {
+		name = defaultORM
+	}
+
+	l, ok := queryLayers[name]
+	if !ok {
+		return queryLayer{}, errors.Errorf("%s is not a supported ORM", name)
+	}
+	return l, nil
+}
+
+// projectORM retrieves the query layer of the project, failing when it does
+// not support the project driver
+func projectORM(project *Project) (queryLayer, error) {
+	l, err := lookupORM(project.ORM)
+	if err != nil {
+		return l, err
+	}
+
+	if l.Dialects != nil {
+		if _, ok := l.Dialects[project.Driver]; !ok {
+			return l, errors.Errorf("the %s ORM does not support the %s driver", project.ORM, project.Driver)
+		}
+	}
+	return l, nil
+}
+
+// newORMContext describes the query layer of the sql package generated for
+// the driver, or nil when the package only exposes the *sql.DB
+func newORMContext(name, driverName string) (*ormContext, error) {
+	l, err := projectORM(&Project{Driver: driverName, ORM: name})
+	if err != nil || l.Conn == "" {
+		return nil, err
+	}
+	return &ormContext{Name: name, queryLayer: l, layerDialect: l.Dialects[driverName]}, nil
+}
+
+// repositoryORM describes the query layer of the generated repositories, or
+// nil when they use the *sql.DB
+func repositoryORM() *ormContext {
+	l, _ := lookupORM(orm)
+	if !l.Repositories {
+		return nil
+	}
+	return &ormContext{Name: orm, queryLayer: l}
+}
+
+// repositoryImports lists the packages referenced by the repository of m
+// using the query layer of the project
+func repositoryImports(m *tableModel) []string {
+	imports := []string{"context"}
+
+	// Get reports a missing row using sql.ErrNoRows
+	if m.GetQuery != "" {
+		imports = append(imports, "database/sql")
+	}
+
+	switch orm {
+	case "gorm":
+		return append(imports, "gorm.io/gorm")
+	case "bun":
+		return append(imports, "github.com/uptrace/bun")
+	}
+	return imports
+}
+
+// groupImports orders the standard library packages of imports before the
+// others, separated by an empty entry
+func groupImports(imports []string) []string {
+	var std, others []string
+	for _, i := range imports {
+		if strings.Contains(strings.SplitN(i, "/", 2)[0], ".") {
+			others = append(others, i)
+		} else {
+			std = append(std, i)
+		}
+	}
+
+	if len(others) == 0 {
+		return std
+	}
+	return append(append(std, ""), others...)
+}
+
+// bindVar is the n-th placeholder of a generated repository query, leaving
+// the driver placeholders to the query layers rewriting them
+func bindVar(d dbDriver, n int) string {
+	if l, _ := lookupORM(orm); l.Binds {
+		return "?"
+	}
+	return placeholder(d, n)
+}
+
+// fieldTag renders the struct tag of a model field mapped to column
+func fieldTag(column string, key, auto bool) string {
+	tag := fmt.Sprintf(`db:"%s"`, column)
+	if l, _ := lookupORM(orm); l.Tag != nil {
+		tag += " " + l.Tag(column, key, auto)
+	}
+	return tag + fmt.Sprintf(` json:"%s"`, column)
+}
+
+// EntField renders the ent schema builder of the field
+func (f modelField) EntField() string {
+	builders := map[string]string{
+		"string":    "String",
+		"int64":     "Int64",
+		"float64":   "Float",
+		"bool":      "Bool",
+		"time.Time": "Time",
+		"[]byte":    "Bytes",
+	}
+
+	base, null := f.Type, false
+	if strings.HasPrefix(base, "sql.Null") {
+		base, null = strings.ToLower(strings.TrimPrefix(base, "sql.Null")), true
+		if base == "time" {
+			base = "time.Time"
+		}
+	}
+
+	builder := fmt.Sprintf("field.%s(%q)", builders[base], f.Column)
+	switch {
+	case null:
+		builder += ".Optional().Nillable()"
+	case base == "[]byte":
+		builder += ".Optional()"
+	}
+	return builder
+}
+
+// stageEnt writes the go:generate directive of the ent client along with
+// its schema directory to the generated sql package within path
+func stageEnt(templates *template.Template, path string) error {
+	path = filepath.Join(path, "ent")
+	if err := os.MkdirAll(filepath.Join(path, "schema"), 0755); err != nil {
+		return err
+	}
+
+	log.Println("staging ent schema...")
+	generate, err := os.Create(filepath.Join(path, "generate.go"))
+	if err != nil {
+		return err
+	}
+	defer generate.Close()
+	return templates.Lookup("templates/sql/ent/generate.tpl").Execute(generate, nil)
+}
+
+// entSchemaFile names the ent schema file of the model of table
+func entSchemaFile(table string) string {
+	return filepath.Join(wd, "sql", "ent", "schema", strings.ToLower(modelName(table))+".go")
+}
+
+// writeEntSchema writes the ent schema of m, which ent only supports for
+// tables with a generated id key
+func writeEntSchema(templates *template.Template, m *tableModel) error {
+	if m.AutoKey == nil || m.AutoKey.Column != "id" {
+		log.Printf("skipping the ent schema of %s, which does not have a generated id column", m.Table)
+		return nil
+	}
+
+	file := entSchemaFile(m.Table)
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	return writeSource(templates, "templates/sql/ent/schema.tpl", file, &Context{Model: m})
+}
+
+func listORMs() []string {
+	ormList := make([]string, 0, len(queryLayers))
+	for name := range queryLayers {
+		ormList = append(ormList, name)
+	}
+	sort.Strings(ormList)
+	return ormList
+}
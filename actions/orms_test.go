@@ -0,0 +1,143 @@
+package actions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/n3integration/conseil"
+)
+
+func TestProjectORM(t *testing.T) {
+	tests := []struct {
+		ORM    string
+		Driver string
+		Error  bool
+	}{
+		{"", "sqlite3", false},
+		{"none", "sqlserver", false},
+		{"sqlx", "sqlite", false},
+		{"gorm", "cockroachdb", false},
+		{"gorm", "sqlite", true},
+		{"bun", "sqlserver", false},
+		{"ent", "mysql", false},
+		{"ent", "sqlserver", true},
+		{"xorm", "postgres", true},
+	}
+
+	for _, test := range tests {
+		_, err := projectORM(&Project{Driver: test.Driver, ORM: test.ORM})
+		if test.Error != (err != nil) {
+			t.Errorf("unexpected %s ORM result for the %s driver: %v", test.ORM, test.Driver, err)
+		}
+	}
+}
+
+func TestListORMs(t *testing.T) {
+	ormList := listORMs()
+	if len(ormList) != len(queryLayers) {
+		t.Fatalf("expected %d ORMs; actual %d", len(queryLayers), len(ormList))
+	}
+	if !sort.StringsAreSorted(ormList) {
+		t.Errorf("expected ORMs to be sorted: %v", ormList)
+	}
+}
+
+func TestSetupORMDb(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d, o, m string) { driver, orm, module = d, o, m }(driver, orm, module)
+		module = "github.com/example/app"
+
+		tests := []struct {
+			ORM      string
+			Driver   string
+			Expected []string
+		}{
+			{"sqlx", "cockroachdb", []string{`conn = sqlx.NewDb(db, "postgres")`, "func DB() *sqlx.DB {"}},
+			{"gorm", "mysql", []string{`"gorm.io/driver/mysql"`, "if conn, err = gorm.Open(mysql.New(mysql.Config{Conn: db}), &gorm.Config{}); err != nil {", "func DB() *gorm.DB {"}},
+			{"bun", "sqlserver", []string{`"github.com/uptrace/bun/dialect/mssqldialect"`, "conn = bun.NewDB(db, mssqldialect.New())"}},
+			{"ent", "sqlite3", []string{`"github.com/example/app/sql/ent"`, "entsql.OpenDB(dialect.SQLite, db)", "func Client() *ent.Client {"}},
+		}
+
+		for _, test := range tests {
+			os.RemoveAll(filepath.Join(wd, "sql"))
+			driver, orm = test.Driver, test.ORM
+			if err := setupDb(templates); err != nil {
+				t.Fatalf("failed to setup the %s database file: %s", test.ORM, err)
+			}
+
+			actual, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "sql.go"))
+			for _, expected := range test.Expected {
+				if !bytes.Contains(actual, []byte(expected)) {
+					t.Errorf("generated %s sql package did not contain %s: \n%s", test.ORM, expected, actual)
+				}
+			}
+
+			if staged := conseil.FileExists(filepath.Join(wd, "sql", "ent", "generate.go")); staged != (test.ORM == "ent") {
+				t.Errorf("unexpected %s ent client staging", test.ORM)
+			}
+		}
+
+		driver, orm = "sqlite", "gorm"
+		if err := setupDb(templates); err == nil {
+			t.Error("expected an unsupported ORM driver to generate an error")
+		}
+	})
+}
+
+func TestORMRepository(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(o string) { orm = o }(orm)
+
+		fields := []resourceField{{Column: "email", Type: "string"}, {Column: "age", Type: "int", Null: true}}
+		tests := []struct {
+			ORM      string
+			Expected []string
+		}{
+			{"", []string{"conn Querier", "rows.Scan(&m.ID, &m.Email, &m.Age)", "WHERE id = $1"}},
+			{"sqlx", []string{"conn Querier", "r.conn.SelectContext(ctx, &list, query, args...)", "r.conn.GetContext(ctx, &m,", "WHERE id = $1"}},
+			{"gorm", []string{`gorm:"column:id;primaryKey"`, `r.conn.WithContext(ctx).Table("users").Create(m).Error`, "return nil, sql.ErrNoRows", "WHERE id = ?"}},
+			{"bun", []string{"conn bun.IDB", `bun:"id,pk,autoincrement"`, "r.conn.NewRaw(query, args...).Scan(ctx, &list)", "WHERE id = ?"}},
+			{"ent", []string{"conn Querier", "WHERE id = $1"}},
+		}
+
+		for _, test := range tests {
+			orm = test.ORM
+			r, err := newResource("user", fields, "gin", drivers["postgres"])
+			if err != nil {
+				t.Fatalf("failed to describe the resource: %s", err)
+			}
+
+			model := newTableModel(r.Table, drivers["postgres"])
+			src, err := renderSource(templates, "templates/sql/repository.tpl", "users_repository.go", resourceContext(r, model, "github.com/example/app"))
+			if err != nil {
+				t.Fatalf("failed to render the %s repository: %s", test.ORM, err)
+			}
+
+			for _, expected := range test.Expected {
+				if !bytes.Contains(src, []byte(expected)) {
+					t.Errorf("generated %s repository did not contain %s: \n%s", test.ORM, expected, src)
+				}
+			}
+
+			if test.ORM != "ent" {
+				continue
+			}
+
+			if err := writeEntSchema(templates, model); err != nil {
+				t.Fatalf("failed to write the ent schema: %s", err)
+			}
+
+			schema, _ := ioutil.ReadFile(entSchemaFile("users"))
+			for _, expected := range []string{`entsql.Annotation{Table: "users"}`, `field.Int64("id"),`, `field.Int64("age").Optional().Nillable(),`} {
+				if !strings.Contains(string(schema), expected) {
+					t.Errorf("generated ent schema did not contain %s: \n%s", expected, schema)
+				}
+			}
+		}
+	})
+}
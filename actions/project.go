@@ -0,0 +1,138 @@
+package actions
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+const manifestFile = ".conseil.json"
+
+var (
+	// migrateImport matches the migrate driver imported by the generated
+	// migrations, which older projects import without the dbdriver alias
+	migrateImport = regexp.MustCompile(`"(github\.com/golang-migrate/migrate/v4/database/[^"]+)"`)
+
+	// frameworkImports match the imports of app.go to the app frameworks
+	frameworkImports = []struct {
+		Import    string
+		Framework string
+	}{
+		{`"github.com/gin-gonic/gin"`, "gin"},
+		{`"github.com/labstack/echo`, "echo"},
+		{`"github.com/kataras/iris`, "iris"},
+		{`"github.com/go-ozzo/ozzo-routing`, "ozzo"},
+		{`"google.golang.org/grpc`, "grpc"},
+		{`"net/http"`, "stdlib"},
+	}
+)
+
+// Project describes the options used to generate a project
+type Project struct {
+	Framework  string `json:"framework,omitempty"`
+	Driver     string `json:"driver,omitempty"`
+	Migrator   string `json:"migrator,omitempty"`
+	Migrations string `json:"migrations,omitempty"`
+	Seeds      string `json:"seeds,omitempty"`
+	ORM        string `json:"orm,omitempty"`
+	// Queries is the directory of the sqlc queries; empty when the project
+	// does not use sqlc
+	Queries string `json:"queries,omitempty"`
+	// Replicas is whether the sql package routes reads to replicas
+	Replicas bool `json:"replicas,omitempty"`
+	// Store is the embedded key-value store of the store package
+	Store string `json:"store,omitempty"`
+	// Logging is whether the app logs structured requests through slog
+	Logging bool `json:"logging,omitempty"`
+	// Config is whether the app loads its settings through the config package
+	Config bool `json:"config,omitempty"`
+	// Metrics is whether the app records Prometheus metrics
+	Metrics bool `json:"metrics,omitempty"`
+	// Tracing is whether the app traces requests through OpenTelemetry
+	Tracing bool `json:"tracing,omitempty"`
+	// Baseline is the version of the migration squashing all prior migrations
+	Baseline uint64 `json:"baseline,omitempty"`
+}
+
+// loadProject reads the project manifest within dir, falling back to
+// detecting the options from the generated sources of older projects
+func loadProject(dir string) (*Project, error) {
+	project := &Project{}
+	data, err := ioutil.ReadFile(filepath.Join(dir, manifestFile))
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, project); err != nil {
+			return nil, err
+		}
+	case os.IsNotExist(err):
+		project.Driver = detectDriver(dir)
+	default:
+		return nil, err
+	}
+
+	if project.Framework == "" {
+		project.Framework = detectFramework(dir)
+	}
+
+	if project.Migrator == "" {
+		project.Migrator = defaultMigrator
+	}
+
+	if project.Migrations == "" {
+		project.Migrations = defaultMigrationDir
+	}
+
+	if project.Seeds == "" {
+		project.Seeds = defaultSeedDir
+	}
+	return project, nil
+}
+
+// save writes the project manifest to dir
+func (p *Project) save(dir string) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, manifestFile), append(data, '\n'), 0644)
+}
+
+// detectDriver matches the migrate driver imported by the generated
+// migrations against the supported drivers
+func detectDriver(dir string) string {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "sql", "migrations.go"))
+	if err != nil {
+		return ""
+	}
+
+	matches := migrateImport.FindSubmatch(data)
+	if matches == nil {
+		return ""
+	}
+
+	for _, name := range listDrivers() {
+		if drivers[name].Migrate == string(matches[1]) {
+			return name
+		}
+	}
+	return ""
+}
+
+// detectFramework matches the packages imported by app.go against the app
+// frameworks, falling back to the standard library router
+func detectFramework(dir string) string {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "app.go"))
+	if err != nil {
+		return ""
+	}
+
+	for _, f := range frameworkImports {
+		if bytes.Contains(data, []byte(f.Import)) {
+			return f.Framework
+		}
+	}
+	return ""
+}
@@ -0,0 +1,30 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+var replicas bool
+
+// writeReplicas writes sql/replicas.go, which connects the replicas and
+// named databases configured through the environment and routes the read-only
+// statements of the generated repositories to the replicas
+func writeReplicas(templates *template.Template, path string, context *Context) error {
+	file, err := os.Create(filepath.Join(path, "replicas.go"))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return templates.Lookup("templates/sql/replicas.tpl").Execute(file, context)
+}
+
+// repositoryConn is the expression of the sql package passed to the
+// generated repositories
+func repositoryConn() string {
+	if replicas {
+		return "sql.Routed()"
+	}
+	return "sql.DB()"
+}
@@ -0,0 +1,78 @@
+package actions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestSetupReplicasDb(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d string, r bool) { driver, replicas = d, r }(driver, replicas)
+		driver, replicas = "pgx", true
+
+		if err := setupDb(templates); err != nil {
+			t.Fatalf("failed to setup database file: %s", err)
+		}
+
+		src, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "sql.go"))
+		for _, expected := range []string{`sql.Open("pgx", primaryURL())`, "if err := openReplicas(); err != nil {", "closeReplicas()"} {
+			if !bytes.Contains(src, []byte(expected)) {
+				t.Errorf("generated sql package did not contain %s: \n%s", expected, src)
+			}
+		}
+
+		routes, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "replicas.go"))
+		for _, expected := range []string{`conn, err := sql.Open("pgx", dsn)`, "func Routed() Router {", "func Database(name string) (*sql.DB, error) {"} {
+			if !bytes.Contains(routes, []byte(expected)) {
+				t.Errorf("generated replicas did not contain %s: \n%s", expected, routes)
+			}
+		}
+
+		replicas = false
+		if err := setupDb(templates); err != nil {
+			t.Fatalf("failed to setup database file: %s", err)
+		}
+
+		if src, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "sql.go")); bytes.Contains(src, []byte("primaryURL()")) {
+			t.Errorf("expected the sql package not to route replicas: \n%s", src)
+		}
+	})
+}
+
+func TestReplicaRepository(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(r bool) { replicas = r }(replicas)
+		replicas = true
+
+		fields, _ := parseResourceFields([]string{"email:string"})
+		r, err := newResource("user", fields, "gin", drivers["postgres"])
+		if err != nil {
+			t.Fatalf("failed to describe the resource: %s", err)
+		}
+
+		model := newTableModel(r.Table, drivers["postgres"])
+		src, err := renderSource(templates, "templates/sql/repository.tpl", "users_repository.go", resourceContext(r, model, "github.com/example/app"))
+		if err != nil {
+			t.Fatalf("failed to render the repository: %s", err)
+		}
+
+		if !bytes.Contains(src, []byte("conn Querier")) {
+			t.Errorf("expected the repository to use the Querier: \n%s", src)
+		}
+
+		app := filepath.Join(wd, "app.go")
+		if err := ioutil.WriteFile(app, []byte("package main\n\nfunc main() {\n\t// Create new router\n\tr := gin.Default()\n\tr.GET(\"/health\", health)\n}\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := registerRoutes(app, model); err != nil {
+			t.Fatalf("failed to register the routes: %s", err)
+		}
+
+		if data, _ := ioutil.ReadFile(app); !bytes.Contains(data, []byte("registerUserRoutes(r, sql.NewUserRepository(sql.Routed()))")) {
+			t.Errorf("expected the routes to use the router: \n%s", data)
+		}
+	})
+}
@@ -0,0 +1,588 @@
+package actions
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	// resourceTypes are the field types accepted by generate resource
+	resourceTypes = []string{"string", "text", "int", "bigint", "float", "bool", "time"}
+
+	// resourceFrameworks are the app frameworks with resource handler templates
+	resourceFrameworks = map[string]bool{"echo": true, "gin": true, "iris": true, "ozzo": true, "stdlib": true}
+
+	fieldName   = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	camelBound  = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+	sqlImport   = regexp.MustCompile(`"([^"]+)/sql"`)
+	moduleLine  = regexp.MustCompile(`(?m)^module\s+(\S+)`)
+	healthRoute = regexp.MustCompile(`(?m)^([ \t]*)(\w+)\.(?:GET|Get|HandleFunc)\("(?:GET )?/health", health\)\n`)
+)
+
+func init() {
+	register(cli.Command{
+		Name:    "generate",
+		Aliases: []string{"g"},
+		Usage:   "generate application code",
+		Subcommands: []cli.Command{
+			{
+				Name:      "resource",
+				Usage:     "create the migration, model, repository, handlers, and routes of a resource",
+				ArgsUsage: fmt.Sprintf("<name> <field:type[:unique|:index|:null]>... [i.e. types %v]", strings.Join(resourceTypes, ", ")),
+				Action:    generateResourceAction,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:        "dir",
+						Value:       defaultMigrationDir,
+						Usage:       "the migrations directory",
+						Destination: &migrationDir,
+					},
+					cli.BoolFlag{
+						Name:        "timestamp",
+						Destination: &timestamp,
+						Usage:       "whether or not to version the migration using a timestamp",
+					},
+				},
+			},
+			{
+				Name:   "domain",
+				Usage:  "create the migration, models, repositories, handlers, and routes of the entities declared by a domain file",
+				Action: generateDomainAction,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:        "file",
+						Value:       defaultDomainFile,
+						Usage:       "the domain file",
+						Destination: &domainFile,
+					},
+					cli.StringFlag{
+						Name:        "name",
+						Value:       "domain",
+						Usage:       "the name of the generated migration",
+						Destination: &domainMigration,
+					},
+					cli.StringFlag{
+						Name:        "dir",
+						Value:       defaultMigrationDir,
+						Usage:       "the migrations directory",
+						Destination: &migrationDir,
+					},
+					cli.BoolFlag{
+						Name:        "timestamp",
+						Destination: &timestamp,
+						Usage:       "whether or not to version the migration using a timestamp",
+					},
+				},
+			},
+		},
+	})
+}
+
+// resourceField describes a field declared as name:type[:modifier...]
+type resourceField struct {
+	Column  string
+	Type    string
+	Unique  bool
+	Index   bool
+	Null    bool
+	Default string
+}
+
+// resourceSpec describes the CRUD slice generated for a resource
+type resourceSpec struct {
+	// Name prefixes the unexported handler types of the resource
+	Name string
+	// Path is the route of the resource collection
+	Path      string
+	Framework string
+	Table     *schemaTable
+}
+
+func generateResourceAction(c *cli.Context) error {
+	if wd == "" {
+		wd = "."
+	}
+
+	if !c.Args().Present() {
+		return errors.New("a resource name is required")
+	}
+
+	project, err := loadProject(wd)
+	if err != nil {
+		return err
+	}
+
+	if project.Driver == "" {
+		return errors.New("generating a resource requires a project with database migrations")
+	}
+
+	if project.Framework == "" {
+		return errors.New("unable to determine the app framework of the project")
+	}
+
+	d, err := lookupDriver(project.Driver)
+	if err != nil {
+		return err
+	}
+
+	if _, err := projectEngine(project); err != nil {
+		return err
+	}
+
+	if _, err := projectORM(project); err != nil {
+		return err
+	}
+
+	module, err := projectModule(wd)
+	if err != nil {
+		return err
+	}
+
+	fields, err := parseResourceFields(c.Args().Tail())
+	if err != nil {
+		return err
+	}
+
+	r, err := newResource(c.Args().First(), fields, project.Framework, d)
+	if err != nil {
+		return err
+	}
+
+	migrator, orm, sqlc, replicas, appLogging = project.Migrator, project.ORM, project.Queries != "", project.Replicas, project.Logging
+	return generateResource(parseTemplates(), r, module, d, time.Now())
+}
+
+// projectModule reads the module path of the project from the sql package
+// import of app.go, falling back to go.mod
+func projectModule(dir string) (string, error) {
+	if data, err := ioutil.ReadFile(filepath.Join(dir, "app.go")); err == nil {
+		if matches := sqlImport.FindSubmatch(data); matches != nil {
+			return string(matches[1]), nil
+		}
+	}
+
+	if data, err := ioutil.ReadFile(filepath.Join(dir, "go.mod")); err == nil {
+		if matches := moduleLine.FindSubmatch(data); matches != nil {
+			return string(matches[1]), nil
+		}
+	}
+	return "", errors.New("unable to determine the module path of the project")
+}
+
+// parseResourceFields parses the field declarations of a resource
+func parseResourceFields(args []string) ([]resourceField, error) {
+	if len(args) == 0 {
+		return nil, errors.New("at least one field:type is required")
+	}
+
+	seen := map[string]bool{"id": true}
+	fields := make([]resourceField, 0, len(args))
+	for _, arg := range args {
+		parts := strings.Split(arg, ":")
+		if len(parts) < 2 {
+			return nil, errors.Errorf("invalid field %s; expected name:type", arg)
+		}
+
+		f := resourceField{Column: strings.ToLower(parts[0]), Type: strings.ToLower(parts[1])}
+		if !fieldName.MatchString(f.Column) {
+			return nil, errors.Errorf("invalid field name: %s", parts[0])
+		}
+
+		if seen[f.Column] {
+			return nil, errors.Errorf("the %s field is declared more than once or is reserved", f.Column)
+		}
+		seen[f.Column] = true
+
+		for _, modifier := range parts[2:] {
+			switch modifier {
+			case "unique":
+				f.Unique = true
+			case "index":
+				f.Index = true
+			case "null":
+				f.Null = true
+			default:
+				return nil, errors.Errorf("unknown modifier %s of the %s field", modifier, f.Column)
+			}
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// newResource describes the table of a resource and the routes serving it
+func newResource(name string, fields []resourceField, framework string, d dbDriver) (*resourceSpec, error) {
+	table, err := resourceTable(name)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := newResourceTable(table, fields, d)
+	if err != nil {
+		return nil, err
+	}
+	t.Create = createStatement(d, t)
+	return newResourceSpec(t, framework), nil
+}
+
+// resourceTable converts the name of a resource to the name of its table
+func resourceTable(name string) (string, error) {
+	name = strings.Trim(unsafeName.ReplaceAllString(strings.ToLower(camelBound.ReplaceAllString(name, "${1}_${2}")), "_"), "_")
+	if name == "" || !fieldName.MatchString(name) {
+		return "", errors.Errorf("invalid resource name %s; the name must start with a letter", name)
+	}
+	return plural(singular(name)), nil
+}
+
+// newResourceSpec describes the routes serving the rows of table
+func newResourceSpec(t *schemaTable, framework string) *resourceSpec {
+	return &resourceSpec{
+		Name:      lowerCamel(modelName(t.Name)),
+		Path:      "/" + strings.Replace(t.Name, "_", "-", -1),
+		Framework: framework,
+		Table:     t,
+	}
+}
+
+// newResourceTable describes a table with a generated id primary key and
+// the columns and indexes of fields
+func newResourceTable(name string, fields []resourceField, d dbDriver) (*schemaTable, error) {
+	id, err := idColumn(d)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &schemaTable{
+		Name:        name,
+		Columns:     []schemaColumn{{Name: "id", Type: id, NotNull: true, AutoIncrement: true}},
+		PrimaryKey:  []string{"id"},
+		Constraints: make(map[string]string),
+		Indexes:     make(map[string]string),
+	}
+
+	for _, f := range fields {
+		sqlType, err := columnType(d, f.Type)
+		if err != nil {
+			return nil, err
+		}
+		table.Columns = append(table.Columns, schemaColumn{Name: f.Column, Type: sqlType, NotNull: !f.Null, Default: f.Default})
+
+		switch {
+		case f.Unique:
+			addIndex(d, table, true, f.Column)
+		case f.Index:
+			addIndex(d, table, false, f.Column)
+		}
+	}
+	return table, nil
+}
+
+// addIndex adds an index of columns to a table, named after the table and
+// columns
+func addIndex(d dbDriver, t *schemaTable, unique bool, columns ...string) {
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = quoteIdentifier(d, c)
+	}
+
+	name, create := fmt.Sprintf("%s_%s_idx", t.Name, strings.Join(columns, "_")), "CREATE INDEX"
+	if unique {
+		name, create = fmt.Sprintf("%s_%s_key", t.Name, strings.Join(columns, "_")), "CREATE UNIQUE INDEX"
+	}
+	t.Indexes[name] = fmt.Sprintf("%s %s ON %s (%s)", create, name, quoteIdentifier(d, t.Name), strings.Join(quoted, ", "))
+}
+
+// createStatement renders the statement creating a table from its columns,
+// primary key, and constraints
+func createStatement(d dbDriver, t *schemaTable) string {
+	definitions := make([]string, 0, len(t.Columns)+len(t.Constraints)+1)
+	for _, c := range t.Columns {
+		definition := fmt.Sprintf("%s %s", quoteIdentifier(d, c.Name), c.Type)
+		if len(t.PrimaryKey) == 1 && t.PrimaryKey[0] == c.Name {
+			definitions = append(definitions, definition+" PRIMARY KEY")
+			continue
+		}
+
+		if c.NotNull {
+			definition += " NOT NULL"
+		}
+
+		if c.Default != "" {
+			definition += " DEFAULT " + c.Default
+		}
+		definitions = append(definitions, definition)
+	}
+
+	if len(t.PrimaryKey) > 1 {
+		definitions = append(definitions, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(t.PrimaryKey, ", ")))
+	}
+
+	for _, name := range changedKeys(nil, t.Constraints) {
+		definitions = append(definitions, fmt.Sprintf("CONSTRAINT %s %s", name, t.Constraints[name]))
+	}
+	return fmt.Sprintf("CREATE TABLE %s (\n\t%s\n)", quoteIdentifier(d, t.Name), strings.Join(definitions, ",\n\t"))
+}
+
+// idColumn is the type of the generated primary key of a resource table
+func idColumn(d dbDriver) (string, error) {
+	switch {
+	case d.throwaway():
+		return "INTEGER", nil
+	case d.Name == "mysql":
+		return "BIGINT AUTO_INCREMENT", nil
+	case d.Name == "sqlserver":
+		return "BIGINT IDENTITY(1,1)", nil
+	case d.Name == "postgres", d.Name == "pgx":
+		return "BIGSERIAL", nil
+	}
+	return "", errors.Errorf("generating a resource is not supported for the %s driver", d.Name)
+}
+
+// columnType maps a resource field type to the column type of the driver
+func columnType(d dbDriver, fieldType string) (string, error) {
+	sqlserver := d.Name == "sqlserver"
+	switch fieldType {
+	case "string":
+		if sqlserver {
+			return "NVARCHAR(255)", nil
+		}
+		return "VARCHAR(255)", nil
+	case "text":
+		if sqlserver {
+			return "NVARCHAR(MAX)", nil
+		}
+		return "TEXT", nil
+	case "int", "integer", "bigint":
+		if d.throwaway() {
+			return "INTEGER", nil
+		}
+		return "BIGINT", nil
+	case "float":
+		switch {
+		case d.throwaway():
+			return "REAL", nil
+		case d.Name == "mysql":
+			return "DOUBLE", nil
+		case sqlserver:
+			return "FLOAT", nil
+		}
+		return "DOUBLE PRECISION", nil
+	case "bool", "boolean":
+		if sqlserver {
+			return "BIT", nil
+		}
+		return "BOOLEAN", nil
+	case "time", "datetime", "timestamp":
+		switch {
+		case d.throwaway(), d.Name == "mysql":
+			return "DATETIME", nil
+		case sqlserver:
+			return "DATETIME2", nil
+		}
+		return "TIMESTAMP", nil
+	}
+	return "", errors.Errorf("unsupported field type %s; expected one of %v", fieldType, strings.Join(resourceTypes, ", "))
+}
+
+// plural naively converts the name of a row to a table name
+func plural(name string) string {
+	switch {
+	case strings.HasSuffix(name, "y") && len(name) > 1 && !strings.ContainsAny(name[len(name)-2:len(name)-1], "aeiou"):
+		return name[:len(name)-1] + "ies"
+	case strings.HasSuffix(name, "s"), strings.HasSuffix(name, "x"), strings.HasSuffix(name, "ch"), strings.HasSuffix(name, "sh"):
+		return name + "es"
+	}
+	return name + "s"
+}
+
+// generateResource writes the migration, model, repository, handlers, and
+// handler tests of a resource and registers its routes within app.go, or
+// only its migration and sqlc queries for projects using sqlc
+func generateResource(templates *template.Template, r *resourceSpec, module string, d dbDriver, now time.Time) error {
+	if !sqlc && !resourceFrameworks[r.Framework] {
+		return errors.Errorf("generating resource handlers is not supported for the %s framework", r.Framework)
+	}
+
+	e, err := lookupEngine(migrator)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(wd, "sql")
+	name := strings.TrimSuffix(repositoryFile(r.Table.Name), "_repository.go")
+	files := resourceFiles(r.Table.Name)
+	if sqlc {
+		files = map[string]string{"queries": queryFile(r.Table.Name)}
+	}
+
+	if e.Declarative {
+		files["schema"] = filepath.Join(wd, defaultSchemaDir, name+".sql")
+	}
+
+	for _, file := range files {
+		if _, err := os.Stat(file); err == nil {
+			return errors.Errorf("%s already exists", file)
+		}
+	}
+
+	if _, err := newResourceMigration(e, r.Table, d, now); err != nil {
+		return err
+	}
+
+	if e.Declarative {
+		log.Printf("creating schema %s.sql...", name)
+		if err := ioutil.WriteFile(files["schema"], []byte(tableStatements(r.Table)), 0644); err != nil {
+			return err
+		}
+	}
+
+	model := newTableModel(r.Table, d)
+	if sqlc {
+		log.Printf("creating %s queries...", r.Table.Name)
+		if err := writeQueries(templates, model); err != nil {
+			return err
+		}
+
+		log.Printf("run `go generate ./sql/queries` and serve the %s rows using sql.Queries()", r.Table.Name)
+		return nil
+	}
+
+	context := resourceContext(r, model, module)
+
+	log.Printf("creating %s repository...", r.Table.Name)
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+
+	if err := writeSource(templates, "templates/sql/repository.tpl", files["repository"], context); err != nil {
+		return err
+	}
+
+	if err := writeDBAccessor(templates, path); err != nil {
+		return err
+	}
+
+	if err := writeStore(templates, path); err != nil {
+		return err
+	}
+
+	log.Printf("creating %s handlers...", r.Table.Name)
+	if err := writeSource(templates, fmt.Sprintf("templates/resource/%s.tpl", r.Framework), files["handlers"], context); err != nil {
+		return err
+	}
+
+	if err := writeSource(templates, "templates/resource/handlers_test.tpl", files["handlers_test"], context); err != nil {
+		return err
+	}
+
+	if _, ok := files["ent_schema"]; ok {
+		if err := writeEntSchema(templates, model); err != nil {
+			return err
+		}
+	}
+	return registerRoutes(filepath.Join(wd, "app.go"), model)
+}
+
+// resourceFiles names the repository, handlers, handler test, and ent schema
+// files of the resource served from table
+func resourceFiles(table string) map[string]string {
+	name := strings.TrimSuffix(repositoryFile(table), "_repository.go")
+	files := map[string]string{
+		"repository":    filepath.Join(wd, "sql", repositoryFile(table)),
+		"handlers":      filepath.Join(wd, name+"_handlers.go"),
+		"handlers_test": filepath.Join(wd, name+"_handlers_test.go"),
+	}
+
+	if orm == "ent" {
+		files["ent_schema"] = entSchemaFile(table)
+	}
+	return files
+}
+
+// resourceContext prepares the context of the resource templates
+func resourceContext(r *resourceSpec, model *tableModel, module string) *Context {
+	return &Context{
+		Module:   module,
+		Model:    model,
+		Resource: r,
+		Imports:  groupImports(modelImports(repositoryImports(model), model)),
+		ORM:      repositoryORM(),
+		Replicas: replicas,
+		Logging:  appLogging,
+	}
+}
+
+// newResourceMigration writes the next migration creating the table of a
+// resource and returns the version
+func newResourceMigration(e migrationEngine, t *schemaTable, d dbDriver, now time.Time) (string, error) {
+	path := filepath.Join(wd, migrationDir)
+	version, err := nextVersion(e, path, now)
+	if err != nil {
+		return "", err
+	}
+
+	name := "create_" + t.Name
+	log.Printf("creating migration %s_%s...", version, name)
+	return version, writeMigration(e, path, version, name, tableStatements(t), fmt.Sprintf("DROP TABLE %s;\n", quoteIdentifier(d, t.Name)))
+}
+
+// tableStatements lists the statements creating a table and its indexes
+func tableStatements(t *schemaTable) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s;\n", t.Create)
+	for _, name := range changedKeys(nil, t.Indexes) {
+		fmt.Fprintf(&b, "%s;\n", t.Indexes[name])
+	}
+	return b.String()
+}
+
+// registerRoutes registers the routes of a resource following the health
+// endpoint of app.go, logging the registration instead when app.go does not
+// follow the layout of the app templates
+func registerRoutes(file string, m *tableModel) error {
+	register := fmt.Sprintf("register%sRoutes", m.Name)
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		log.Printf("app.go not found; register the %s routes using %s(router, sql.New%sRepository(%s))", m.Table, register, m.Name, repositoryConn())
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if bytes.Contains(data, []byte(register+"(")) {
+		return nil
+	}
+
+	route := healthRoute.FindSubmatchIndex(data)
+	if route == nil {
+		log.Printf("unable to locate the routes of app.go; register the %s routes using %s(router, sql.New%sRepository(%s))", m.Table, register, m.Name, repositoryConn())
+		return nil
+	}
+
+	indent, router := string(data[route[2]:route[3]]), string(data[route[4]:route[5]])
+	var src bytes.Buffer
+	src.Write(data[:route[1]])
+	fmt.Fprintf(&src, "\n%s// Register %s endpoints\n", indent, m.Table)
+	fmt.Fprintf(&src, "%s%s(%s, sql.New%sRepository(%s))\n", indent, register, router, m.Name, repositoryConn())
+	src.Write(data[route[1]:])
+	data = src.Bytes()
+
+	if !bytes.Contains(data, []byte("sql.Open()")) {
+		log.Println("app.go does not open the database; open it using sql.Open() before serving requests")
+	}
+
+	log.Printf("registering %s routes...", m.Table)
+	return ioutil.WriteFile(file, data, 0644)
+}
@@ -0,0 +1,260 @@
+package actions
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/n3integration/conseil"
+)
+
+func TestParseResourceFields(t *testing.T) {
+	fields, err := parseResourceFields([]string{"name:string", "email:string:unique", "age:int:index:null"})
+	if err != nil {
+		t.Fatalf("failed to parse fields: %s", err)
+	}
+
+	if len(fields) != 3 || !fields[1].Unique || !fields[2].Index || !fields[2].Null {
+		t.Errorf("unexpected fields: %+v", fields)
+	}
+
+	for _, args := range [][]string{nil, {"name"}, {"name:string:primary"}, {"id:int"}, {"name:string", "name:text"}, {"1st:string"}} {
+		if _, err := parseResourceFields(args); err == nil {
+			t.Errorf("expected %v to generate an error", args)
+		}
+	}
+}
+
+func TestNewResource(t *testing.T) {
+	fields := []resourceField{{Column: "email", Type: "string", Unique: true}, {Column: "age", Type: "int", Null: true}}
+	r, err := newResource("User", fields, "gin", drivers["sqlite3"])
+	if err != nil {
+		t.Fatalf("failed to describe the resource: %s", err)
+	}
+
+	if r.Table.Name != "users" || r.Name != "user" || r.Path != "/users" {
+		t.Errorf("unexpected resource: %+v", r)
+	}
+
+	expected := "CREATE TABLE users (\n\tid INTEGER PRIMARY KEY,\n\temail VARCHAR(255) NOT NULL,\n\tage INTEGER\n)"
+	if r.Table.Create != expected {
+		t.Errorf("unexpected create statement: \n%s", r.Table.Create)
+	}
+
+	if index := r.Table.Indexes["users_email_key"]; index != "CREATE UNIQUE INDEX users_email_key ON users (email)" {
+		t.Errorf("unexpected unique index: %s", index)
+	}
+
+	tests := []struct {
+		Driver string
+		Insert string
+	}{
+		{"postgres", "INSERT INTO users (email, age) VALUES ($1, $2) RETURNING id"},
+		{"mysql", "INSERT INTO users (email, age) VALUES (?, ?)"},
+		{"sqlserver", "INSERT INTO users (email, age) OUTPUT INSERTED.id VALUES (@p1, @p2)"},
+	}
+
+	for _, test := range tests {
+		r, err := newResource("user", fields, "gin", drivers[test.Driver])
+		if err != nil {
+			t.Fatalf("failed to describe the %s resource: %s", test.Driver, err)
+		}
+
+		if m := newTableModel(r.Table, drivers[test.Driver]); m.InsertQuery != test.Insert {
+			t.Errorf("unexpected %s insert statement: %s", test.Driver, m.InsertQuery)
+		}
+	}
+
+	if r, _ := newResource("BlogCategory", fields, "gin", drivers["sqlite3"]); r.Table.Name != "blog_categories" || r.Path != "/blog-categories" {
+		t.Errorf("unexpected resource: %+v", r)
+	}
+
+	if _, err := newResource("user", []resourceField{{Column: "data", Type: "json"}}, "gin", drivers["sqlite3"]); err == nil {
+		t.Error("expected an unsupported field type to generate an error")
+	}
+}
+
+func TestGenerateResource(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d, f, m, e string, b bool) {
+			driver, framework, module, migrator, migrations = d, f, m, e, b
+		}(driver, framework, module, migrator, migrations)
+		driver, framework, module, migrations = "sqlite3", "gin", "github.com/example/app", true
+		migrationDir, timestamp, migrator = defaultMigrationDir, false, ""
+
+		if err := createWebApp(templates); err != nil {
+			t.Fatalf("failed to create web application: %s", err)
+		}
+
+		if err := stageMigrations(templates); err != nil {
+			t.Fatalf("failed to stage migrations: %s", err)
+		}
+
+		if err := setupDb(templates); err != nil {
+			t.Fatalf("failed to setup database file: %s", err)
+		}
+
+		if detected := detectFramework(wd); detected != "gin" {
+			t.Errorf("expected the gin framework to be detected; actual %s", detected)
+		}
+
+		fields, _ := parseResourceFields([]string{"name:string", "email:string:unique"})
+		r, err := newResource("user", fields, "gin", drivers["sqlite3"])
+		if err != nil {
+			t.Fatalf("failed to describe the resource: %s", err)
+		}
+
+		if err := generateResource(templates, r, module, drivers["sqlite3"], time.Now()); err != nil {
+			t.Fatalf("failed to generate the resource: %s", err)
+		}
+
+		for _, file := range []string{"sql/migrations/0002_create_users.up.sql", "sql/users_repository.go", "sql/db.go", "users_handlers.go", "users_handlers_test.go"} {
+			if !conseil.FileExists(filepath.Join(wd, file)) {
+				t.Errorf("expected %s to be created", file)
+			}
+		}
+
+		app, _ := ioutil.ReadFile(filepath.Join(wd, "app.go"))
+		for _, expected := range []string{"registerUserRoutes(r, sql.NewUserRepository(sql.DB()))", "onClose(sql.Close)"} {
+			if !bytes.Contains(app, []byte(expected)) {
+				t.Errorf("expected app.go to contain %s: \n%s", expected, app)
+			}
+		}
+
+		if err := registerRoutes(filepath.Join(wd, "app.go"), newTableModel(r.Table, drivers["sqlite3"])); err != nil {
+			t.Fatalf("failed to register routes: %s", err)
+		}
+
+		app, _ = ioutil.ReadFile(filepath.Join(wd, "app.go"))
+		if strings.Count(string(app), "registerUserRoutes(") != 1 || strings.Count(string(app), "sql.Open()") != 1 {
+			t.Errorf("expected the routes to be registered and the database opened once: \n%s", app)
+		}
+
+		if err := generateResource(templates, r, module, drivers["sqlite3"], time.Now()); err == nil {
+			t.Error("expected an existing resource to generate an error")
+		}
+
+		r.Framework = "grpc"
+		if err := generateResource(templates, r, module, drivers["sqlite3"], time.Now()); err == nil {
+			t.Error("expected an unsupported framework to generate an error")
+		}
+	})
+}
+
+func TestNewResourceMigrationQuoted(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(e string) { migrator = e }(migrator)
+		migrationDir, timestamp, migrator = defaultMigrationDir, false, ""
+
+		e, err := lookupEngine(migrator)
+		if err != nil {
+			t.Fatalf("failed to look up the migration engine: %s", err)
+		}
+
+		table, err := newResourceTable("order", nil, drivers["postgres"])
+		if err != nil {
+			t.Fatalf("failed to describe the table: %s", err)
+		}
+		table.Create = createStatement(drivers["postgres"], table)
+
+		version, err := newResourceMigration(e, table, drivers["postgres"], time.Now())
+		if err != nil {
+			t.Fatalf("failed to create the migration: %s", err)
+		}
+
+		up, _ := ioutil.ReadFile(filepath.Join(wd, migrationDir, version+"_create_order.up.sql"))
+		if !strings.HasPrefix(string(up), `CREATE TABLE "order"`) {
+			t.Errorf("expected the up migration to quote the table: \n%s", up)
+		}
+
+		down, _ := ioutil.ReadFile(filepath.Join(wd, migrationDir, version+"_create_order.down.sql"))
+		if string(down) != "DROP TABLE \"order\";\n" {
+			t.Errorf("expected the down migration to quote the table: \n%s", down)
+		}
+	})
+}
+
+// stdlibApp is an app.go routing requests through an http.ServeMux
+const stdlibApp = `package main
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/example/app/sql"
+)
+
+func main() {
+	if err := sql.Open(); err != nil {
+		log.Fatal(err)
+	}
+	defer sql.Close()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", health)
+	log.Fatal(http.ListenAndServe(":8080", mux))
+}
+
+func health(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+`
+
+func TestGenerateResourceStdlib(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d, f, m, e string, b bool) {
+			driver, framework, module, migrator, migrations = d, f, m, e, b
+		}(driver, framework, module, migrator, migrations)
+		driver, framework, module, migrations = "sqlite3", "gin", "github.com/example/app", true
+		migrationDir, timestamp, migrator = defaultMigrationDir, false, ""
+
+		if err := stageMigrations(templates); err != nil {
+			t.Fatalf("failed to stage migrations: %s", err)
+		}
+
+		if err := setupDb(templates); err != nil {
+			t.Fatalf("failed to setup database file: %s", err)
+		}
+
+		if err := ioutil.WriteFile(filepath.Join(wd, "app.go"), []byte(stdlibApp), 0644); err != nil {
+			t.Fatalf("failed to write app.go: %s", err)
+		}
+
+		detected := detectFramework(wd)
+		if detected != "stdlib" {
+			t.Fatalf("expected the standard library router to be detected; actual %s", detected)
+		}
+
+		fields, _ := parseResourceFields([]string{"name:string"})
+		r, err := newResource("user", fields, detected, drivers["sqlite3"])
+		if err != nil {
+			t.Fatalf("failed to describe the resource: %s", err)
+		}
+
+		if err := generateResource(templates, r, module, drivers["sqlite3"], time.Now()); err != nil {
+			t.Fatalf("failed to generate the resource: %s", err)
+		}
+
+		app, _ := ioutil.ReadFile(filepath.Join(wd, "app.go"))
+		expected := "\tmux.HandleFunc(\"GET /health\", health)\n\n\t// Register users endpoints\n\tregisterUserRoutes(mux, sql.NewUserRepository(sql.DB()))\n"
+		if !strings.Contains(string(app), expected) {
+			t.Errorf("expected the routes to be registered with the mux: \n%s", app)
+		}
+
+		handlers, _ := ioutil.ReadFile(filepath.Join(wd, "users_handlers.go"))
+		if !bytes.Contains(handlers, []byte("func registerUserRoutes(mux *http.ServeMux, store userStore)")) {
+			t.Errorf("expected the handlers to register with an http.ServeMux: \n%s", handlers)
+		}
+
+		for _, file := range []string{"app.go", "users_handlers.go", "users_handlers_test.go"} {
+			if _, err := parser.ParseFile(token.NewFileSet(), filepath.Join(wd, file), nil, 0); err != nil {
+				t.Errorf("expected %s to be valid go source: %s", file, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,216 @@
+package actions
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+const (
+	defaultSeedDir = "sql/seeds"
+	defaultSeedEnv = "dev"
+	seedTable      = "schema_seeds"
+)
+
+var (
+	seeds   bool
+	seedEnv string
+
+	// seedEnvs are the environments staged for a new project
+	seedEnvs = []string{defaultSeedEnv, "test"}
+	envName  = regexp.MustCompile(`^[a-z0-9_-]+$`)
+)
+
+// seedSQL holds the statements used to record the applied seed files
+type seedSQL struct {
+	// Table records the applied seed files of each environment
+	Table string
+	// Create creates the seed table when it does not exist
+	Create string
+	// Applied counts the records of a seed file for an environment
+	Applied string
+	// Insert records a seed file as applied for an environment
+	Insert string
+}
+
+// seedStatements formats the seed table statements using the placeholders
+// of the driver
+func seedStatements(d dbDriver) seedSQL {
+	create := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (env VARCHAR(64) NOT NULL, name VARCHAR(255) NOT NULL, PRIMARY KEY (env, name))", seedTable)
+	if d.Name == "sqlserver" {
+		create = fmt.Sprintf("IF OBJECT_ID('%s', 'U') IS NULL CREATE TABLE %s (env VARCHAR(64) NOT NULL, name VARCHAR(255) NOT NULL, PRIMARY KEY (env, name))", seedTable, seedTable)
+	}
+	env, name := placeholder(d, 1), placeholder(d, 2)
+
+	return seedSQL{
+		Table:   seedTable,
+		Create:  create,
+		Applied: fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE env = %s AND name = %s", seedTable, env, name),
+		Insert:  fmt.Sprintf("INSERT INTO %s (env, name) VALUES (%s, %s)", seedTable, env, name),
+	}
+}
+
+// placeholder formats the nth query parameter for the driver
+func placeholder(d dbDriver, n int) string {
+	switch d.Name {
+	case "postgres", "pgx":
+		return fmt.Sprintf("$%d", n)
+	case "sqlserver":
+		return fmt.Sprintf("@p%d", n)
+	}
+	return "?"
+}
+
+func seedAction(_ *cli.Context) error {
+	if wd == "" {
+		wd = "."
+	}
+
+	project, err := loadProject(wd)
+	if err != nil {
+		return err
+	}
+
+	d, err := lookupDriver(project.Driver)
+	if err != nil {
+		return err
+	}
+
+	connStr := dsn
+	if connStr == "" {
+		if connStr, err = conn(project.Driver); err != nil {
+			return err
+		}
+	}
+
+	db, err := sql.Open(d.Name, connStr)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	applied, err := applySeeds(db, d, filepath.Join(wd, project.Seeds), seedEnv)
+	if err != nil {
+		return err
+	}
+
+	if len(applied) == 0 {
+		log.Println("no change")
+	}
+	return nil
+}
+
+// applySeeds applies the seed files within the env directory of dir that
+// have not been recorded as applied, in file name order, and returns their
+// names
+func applySeeds(db *sql.DB, d dbDriver, dir, env string) ([]string, error) {
+	if !envName.MatchString(env) {
+		return nil, errors.Errorf("invalid seed environment: %s", env)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, env, "*.sql"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, errors.Errorf("no seed files found for the %s environment within %s", env, dir)
+	}
+	sort.Strings(files)
+
+	stmts := seedStatements(d)
+	if _, err := db.Exec(stmts.Create); err != nil {
+		return nil, errors.Wrap(err, "unable to create the seed table")
+	}
+
+	applied := make([]string, 0)
+	for _, file := range files {
+		name := filepath.Base(file)
+
+		var count int
+		if err := db.QueryRow(stmts.Applied, env, name).Scan(&count); err != nil {
+			return nil, err
+		}
+
+		if count > 0 {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Printf("seeding %s/%s...", env, name)
+		if err := seedFile(db, stmts, env, name, string(data)); err != nil {
+			return nil, errors.Wrapf(err, "unable to apply %s/%s", env, name)
+		}
+		applied = append(applied, name)
+	}
+	return applied, nil
+}
+
+// seedFile applies a seed file and records it within a single transaction
+func seedFile(db *sql.DB, stmts seedSQL, env, name, src string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if hasStatements(src) {
+		if _, err := tx.Exec(src); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(stmts.Insert, env, name); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// hasStatements reports whether src contains anything besides blank lines
+// and comments, which some drivers reject as an empty query
+func hasStatements(src string) bool {
+	for _, line := range strings.Split(src, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return true
+		}
+	}
+	return false
+}
+
+// stageSeeds writes a starter seed file for each of the default environments
+func stageSeeds(templates *template.Template) error {
+	log.Println("staging seeds...")
+	for _, env := range seedEnvs {
+		path := filepath.Join(wd, defaultSeedDir, env)
+		if err := os.MkdirAll(path, 0755); err != nil {
+			return err
+		}
+
+		var seed bytes.Buffer
+		if err := templates.Lookup("templates/sql/seed.tpl").Execute(&seed, &Context{Name: env}); err != nil {
+			return err
+		}
+
+		if err := ioutil.WriteFile(filepath.Join(path, "0001_init.sql"), seed.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package actions
+
+import (
+	"bytes"
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/n3integration/conseil"
+)
+
+func TestSeedStatements(t *testing.T) {
+	tests := []struct {
+		Driver string
+		Insert string
+	}{
+		{"postgres", "VALUES ($1, $2)"},
+		{"cockroachdb", "VALUES ($1, $2)"},
+		{"sqlite3", "VALUES (?, ?)"},
+		{"mysql", "VALUES (?, ?)"},
+		{"sqlserver", "VALUES (@p1, @p2)"},
+	}
+
+	for _, test := range tests {
+		if stmts := seedStatements(drivers[test.Driver]); !strings.HasSuffix(stmts.Insert, test.Insert) {
+			t.Errorf("expected %s placeholders %s; actual %s", test.Driver, test.Insert, stmts.Insert)
+		}
+	}
+}
+
+func TestApplySeeds(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		stageSqliteProject(t)
+		dir := filepath.Join(wd, defaultSeedDir)
+		os.MkdirAll(filepath.Join(dir, "dev"), 0755)
+		files := map[string]string{
+			"0001_init.sql":  "-- nothing to seed",
+			"0002_users.sql": "CREATE TABLE users (name TEXT);\nINSERT INTO users (name) VALUES ('dev');",
+		}
+		for name, content := range files {
+			ioutil.WriteFile(filepath.Join(dir, "dev", name), []byte(content), 0644)
+		}
+
+		db, err := sql.Open("sqlite3", dsn)
+		if err != nil {
+			t.Fatalf("failed to open the database: %s", err)
+		}
+		defer db.Close()
+
+		applied, err := applySeeds(db, drivers["sqlite3"], dir, "dev")
+		if err != nil {
+			t.Fatalf("failed to apply seeds: %s", err)
+		}
+
+		if len(applied) != 2 {
+			t.Errorf("expected 2 seed files to be applied; actual %v", applied)
+		}
+
+		if applied, err = applySeeds(db, drivers["sqlite3"], dir, "dev"); err != nil || len(applied) != 0 {
+			t.Errorf("expected applied seed files to be skipped; actual %v (%v)", applied, err)
+		}
+
+		var count int
+		db.QueryRow("SELECT COUNT(*) FROM users").Scan(&count)
+		if count != 1 {
+			t.Errorf("expected 1 seeded row; actual %d", count)
+		}
+
+		for _, env := range []string{"prod", "../dev"} {
+			if _, err := applySeeds(db, drivers["sqlite3"], dir, env); err == nil {
+				t.Errorf("expected the %s environment to generate an error", env)
+			}
+		}
+	})
+}
+
+func TestStageSeeds(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d string, s bool) { driver, seeds = d, s }(driver, seeds)
+		driver, seeds = "sqlite3", true
+
+		if err := stageSeeds(templates); err != nil {
+			t.Fatalf("failed to stage seeds: %s", err)
+		}
+
+		for _, env := range seedEnvs {
+			if !conseil.FileExists(filepath.Join(wd, defaultSeedDir, env, "0001_init.sql")) {
+				t.Errorf("expected a %s seed file to be staged", env)
+			}
+		}
+
+		if err := setupDb(templates); err != nil {
+			t.Fatalf("failed to setup database file: %s", err)
+		}
+
+		migrationsTest, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "migrations_test.go"))
+		if !bytes.Contains(migrationsTest, []byte("func TestSeeds")) {
+			t.Errorf("expected the generated migrations test to load the seeds: \n%s", migrationsTest)
+		}
+
+		if !conseil.FileExists(filepath.Join(wd, "sql", "seeds.go")) {
+			t.Error("expected sql/seeds.go to be created")
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package actions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+const defaultQueryDir = "sql/queries"
+
+var sqlc bool
+
+// sqlcEngines map the drivers to the sqlc engine parsing their migrations
+var sqlcEngines = map[string]string{
+	"postgres":    "postgresql",
+	"pgx":         "postgresql",
+	"cockroachdb": "postgresql",
+	"mysql":       "mysql",
+	"sqlite3":     "sqlite",
+	"sqlite":      "sqlite",
+}
+
+// lookupSQLCEngine retrieves the sqlc engine of the named driver
+func lookupSQLCEngine(driverName string) (string, error) {
+	engine, ok := sqlcEngines[driverName]
+	if !ok {
+		return "", errors.Errorf("sqlc does not support the %s driver", driverName)
+	}
+	return engine, nil
+}
+
+// stageQueries writes the sqlc configuration compiling the queries of the
+// query directory against the migrations, along with an example query and
+// the go:generate directive of the queries package
+func stageQueries(templates *template.Template) error {
+	engine, err := lookupSQLCEngine(driver)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(wd, defaultQueryDir)
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+
+	log.Println("staging sqlc queries...")
+	context := &Context{Dialect: engine, Schema: defaultMigrationDir, Name: defaultQueryDir}
+	files := map[string]string{
+		"templates/sql/sqlc/sqlc.tpl":     filepath.Join(wd, "sqlc.yaml"),
+		"templates/sql/sqlc/example.tpl":  filepath.Join(path, "example.sql"),
+		"templates/sql/sqlc/generate.tpl": filepath.Join(path, "generate.go"),
+	}
+
+	for name, file := range files {
+		var src bytes.Buffer
+		if err := templates.Lookup(name).Execute(&src, context); err != nil {
+			return err
+		}
+
+		if err := ioutil.WriteFile(file, src.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryFile names the sqlc query file of table
+func queryFile(table string) string {
+	return filepath.Join(wd, defaultQueryDir, strings.TrimSuffix(repositoryFile(table), "_repository.go")+".sql")
+}
+
+// writeQueries writes the sqlc queries reading and writing the rows of the
+// table of m
+func writeQueries(templates *template.Template, m *tableModel) error {
+	file := queryFile(m.Table)
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+
+	var src bytes.Buffer
+	if err := templates.Lookup("templates/sql/sqlc/queries.tpl").Execute(&src, &Context{Model: m, Name: goName(m.Table)}); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, src.Bytes(), 0644)
+}
@@ -0,0 +1,100 @@
+package actions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/n3integration/conseil"
+)
+
+func TestStageQueries(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d, m string, b bool) { driver, module, sqlc = d, m, b }(driver, module, sqlc)
+		driver, module, sqlc = "pgx", "github.com/example/app", true
+
+		if err := stageQueries(templates); err != nil {
+			t.Fatalf("failed to stage the sqlc queries: %s", err)
+		}
+
+		config, _ := ioutil.ReadFile(filepath.Join(wd, "sqlc.yaml"))
+		for _, expected := range []string{`engine: "postgresql"`, `schema: "sql/migrations"`, `out: "sql/queries"`} {
+			if !bytes.Contains(config, []byte(expected)) {
+				t.Errorf("sqlc.yaml did not contain %s: \n%s", expected, config)
+			}
+		}
+
+		for _, file := range []string{"sql/queries/example.sql", "sql/queries/generate.go"} {
+			if !conseil.FileExists(filepath.Join(wd, file)) {
+				t.Errorf("expected %s to be created", file)
+			}
+		}
+
+		if err := setupDb(templates); err != nil {
+			t.Fatalf("failed to setup database file: %s", err)
+		}
+
+		src, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "sql.go"))
+		for _, expected := range []string{`"github.com/example/app/sql/queries"`, "func Queries() *queries.Queries {"} {
+			if !bytes.Contains(src, []byte(expected)) {
+				t.Errorf("generated sql package did not contain %s: \n%s", expected, src)
+			}
+		}
+
+		driver = "sqlserver"
+		if err := stageQueries(templates); err == nil {
+			t.Error("expected an unsupported sqlc driver to generate an error")
+		}
+	})
+}
+
+func TestGenerateQueries(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d, m, e string, b, s bool) {
+			driver, module, migrator, migrations, sqlc = d, m, e, b, s
+		}(driver, module, migrator, migrations, sqlc)
+		driver, module, migrations, sqlc = "postgres", "github.com/example/app", true, true
+		migrationDir, timestamp, migrator = defaultMigrationDir, false, ""
+
+		if err := stageMigrations(templates); err != nil {
+			t.Fatalf("failed to stage migrations: %s", err)
+		}
+
+		fields, _ := parseResourceFields([]string{"email:string:unique", "age:int:null"})
+		r, err := newResource("user", fields, "grpc", drivers["postgres"])
+		if err != nil {
+			t.Fatalf("failed to describe the resource: %s", err)
+		}
+
+		if err := generateResource(templates, r, module, drivers["postgres"], time.Now()); err != nil {
+			t.Fatalf("failed to generate the resource queries: %s", err)
+		}
+
+		queries, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "queries", "users.sql"))
+		for _, expected := range []string{
+			"-- name: ListUsers :many\nSELECT id, email, age FROM users ORDER BY id;",
+			"-- name: GetUser :one\nSELECT id, email, age FROM users WHERE id = $1;",
+			"-- name: CreateUser :one\nINSERT INTO users (email, age) VALUES ($1, $2) RETURNING id;",
+			"-- name: UpdateUser :exec\nUPDATE users SET email = $1, age = $2 WHERE id = $3;",
+			"-- name: DeleteUser :exec\nDELETE FROM users WHERE id = $1;",
+		} {
+			if !bytes.Contains(queries, []byte(expected)) {
+				t.Errorf("generated queries did not contain %s: \n%s", expected, queries)
+			}
+		}
+
+		if !conseil.FileExists(filepath.Join(wd, "sql", "migrations", "0002_create_users.up.sql")) {
+			t.Error("expected the users migration to be created")
+		}
+
+		if conseil.FileExists(filepath.Join(wd, "sql", "users_repository.go")) || conseil.FileExists(filepath.Join(wd, "users_handlers.go")) {
+			t.Error("expected the repository and handlers not to be generated for sqlc")
+		}
+
+		if err := generateResource(templates, r, module, drivers["postgres"], time.Now()); err == nil {
+			t.Error("expected existing queries to generate an error")
+		}
+	})
+}
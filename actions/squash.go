@@ -0,0 +1,294 @@
+package actions
+
+import (
+	"bufio"
+	"bytes"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	migrate "github.com/golang-migrate/migrate/v4"
+	"github.com/pkg/errors"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+const (
+	defaultArchiveDir = "sql/archive"
+	baselineName      = "baseline"
+)
+
+var (
+	squashTo   uint64
+	archiveDir string
+)
+
+func squashMigrationAction(_ *cli.Context) error {
+	if wd == "" {
+		wd = "."
+	}
+
+	if squashTo == 0 {
+		return errors.New("a version to squash to is required")
+	}
+
+	project, err := loadProject(wd)
+	if err != nil {
+		return err
+	}
+	project.Migrations = migrationDir
+
+	d, err := lookupDriver(project.Driver)
+	if err != nil {
+		return err
+	}
+
+	e, err := projectEngine(project)
+	if err != nil {
+		return err
+	}
+
+	if !e.paired() {
+		return errors.Errorf("squashing is not supported for %s migrations", project.Migrator)
+	}
+
+	connStr := scratchDSN
+	if connStr == "" {
+		if !d.throwaway() {
+			return errors.Errorf("squashing %s migrations requires --dsn to an empty %s database", project.Driver, project.Driver)
+		}
+
+		dir, err := ioutil.TempDir("", "conseil-squash")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+		connStr = fmt.Sprintf("file:%s", filepath.Join(dir, "squash.sqlite"))
+	}
+
+	m, db, err := openMigrator(project, connStr)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := squashMigrations(m, db, project, connStr, squashTo); err != nil {
+		return err
+	}
+
+	project.Baseline = squashTo
+	return project.save(wd)
+}
+
+// squashMigrations applies the project migrations up to version on an empty
+// database, replaces them with a baseline migration recreating the resulting
+// schema, and moves the squashed files to the archive directory
+func squashMigrations(m Migrator, db *sql.DB, project *Project, connStr string, version uint64) error {
+	dir := filepath.Join(wd, project.Migrations)
+	migrationList, err := scanMigrations(dir)
+	if err != nil {
+		return err
+	}
+
+	if _, err := migrationVersions(migrationList); err != nil {
+		return err
+	}
+
+	prefix := ""
+	for _, migration := range migrationList {
+		if migration.Version == version && migration.Direction == "up" {
+			prefix = strings.SplitN(filepath.Base(migration.Path), "_", 2)[0]
+		}
+	}
+	if prefix == "" {
+		return errors.Errorf("unable to find migration version %d", version)
+	}
+
+	if _, _, err := m.Version(); err != migrate.ErrNilVersion {
+		if err != nil {
+			return err
+		}
+		return errors.New("refusing to squash migrations using a database with migrations applied")
+	}
+
+	log.Printf("applying migrations up to version %d...", version)
+	if err := m.Migrate(version); err != nil {
+		return err
+	}
+
+	up, down, err := baselineDDL(project.Driver, db, connStr)
+	if err != nil {
+		return err
+	}
+
+	// stage the baseline before archiving, so a failure leaves the migrations
+	// directory untouched
+	log.Printf("creating migration %s_%s...", prefix, baselineName)
+	header := fmt.Sprintf("-- Baseline schema squashed from the migrations archived within %s.\n", filepath.ToSlash(filepath.Join(archiveDir, prefix)))
+	staged := make(map[string]string)
+	defer func() {
+		for tmp := range staged {
+			os.Remove(tmp)
+		}
+	}()
+
+	for direction, content := range map[string]string{"up": header + up, "down": header + down} {
+		path := filepath.Join(dir, fmt.Sprintf("%s_%s.%s.sql", prefix, baselineName, direction))
+		if err := ioutil.WriteFile(path+".tmp", []byte(content), 0644); err != nil {
+			return err
+		}
+		staged[path+".tmp"] = path
+	}
+
+	archive := filepath.Join(wd, archiveDir, prefix)
+	if err := os.MkdirAll(archive, 0755); err != nil {
+		return err
+	}
+
+	log.Printf("archiving squashed migrations to %s...", archive)
+	archived := make([]Migration, 0)
+	for _, migration := range migrationList {
+		if migration.Version > version {
+			continue
+		}
+
+		if err := os.Rename(migration.Path, filepath.Join(archive, filepath.Base(migration.Path))); err != nil {
+			for _, m := range archived {
+				os.Rename(filepath.Join(archive, filepath.Base(m.Path)), m.Path)
+			}
+			return err
+		}
+		archived = append(archived, migration)
+	}
+
+	for tmp, path := range staged {
+		if err := os.Rename(tmp, path); err != nil {
+			return err
+		}
+		delete(staged, tmp)
+	}
+	return nil
+}
+
+// baselineDDL dumps the statements that recreate and drop the schema of db
+func baselineDDL(driverName string, db *sql.DB, connStr string) (string, string, error) {
+	switch driverName {
+	case "sqlite3", "sqlite":
+		return sqliteDDL(db)
+	case "postgres", "pgx":
+		return postgresDDL(db, connStr)
+	default:
+		return "", "", errors.Errorf("squashing is not supported for the %s driver", driverName)
+	}
+}
+
+// sqliteDDL reads the schema statements in creation order from sqlite_master
+func sqliteDDL(db *sql.DB) (string, string, error) {
+	rows, err := db.Query(`SELECT type, name, sql FROM sqlite_master
+WHERE sql IS NOT NULL AND tbl_name <> 'schema_migrations' AND name NOT LIKE 'sqlite_%'
+ORDER BY rowid`)
+	if err != nil {
+		return "", "", errors.Wrap(err, "unable to dump the schema")
+	}
+	defer rows.Close()
+
+	var up bytes.Buffer
+	drops := make([]string, 0)
+	for rows.Next() {
+		var kind, name, stmt string
+		if err := rows.Scan(&kind, &name, &stmt); err != nil {
+			return "", "", err
+		}
+
+		fmt.Fprintf(&up, "%s;\n", stmt)
+		if kind == "table" || kind == "view" {
+			drops = append(drops, fmt.Sprintf("DROP %s IF EXISTS %s;", strings.ToUpper(kind), quoteIdentifier(drivers["sqlite3"], name)))
+		}
+	}
+	return up.String(), reverseLines(drops), rows.Err()
+}
+
+// postgresDDL dumps the schema using pg_dump, dropping the session settings
+// that would otherwise leak into the migration connection
+func postgresDDL(db *sql.DB, connStr string) (string, string, error) {
+	cmd := exec.Command("pg_dump", "--schema-only", "--no-owner", "--no-privileges", "--exclude-table=schema_migrations", connStr)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", "", errors.Errorf("unable to dump the schema using pg_dump: %s", err)
+	}
+
+	var up bytes.Buffer
+	blank := true
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "--"), strings.HasPrefix(line, `\`),
+			strings.HasPrefix(line, "SET "), strings.HasPrefix(line, "SELECT pg_catalog.set_config"):
+			continue
+		case strings.TrimSpace(line) == "":
+			if blank {
+				continue
+			}
+			blank = true
+		default:
+			blank = false
+		}
+		fmt.Fprintln(&up, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return "", "", err
+	}
+
+	rows, err := db.Query(`SELECT tablename FROM pg_tables
+WHERE schemaname = current_schema() AND tablename <> 'schema_migrations'
+ORDER BY tablename`)
+	if err != nil {
+		return "", "", errors.Wrap(err, "unable to list the tables")
+	}
+	defer rows.Close()
+
+	drops := make([]string, 0)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return "", "", err
+		}
+		drops = append(drops, fmt.Sprintf("DROP TABLE IF EXISTS %s CASCADE;", quoteIdentifier(drivers["postgres"], name)))
+	}
+	return strings.TrimSpace(up.String()) + "\n", reverseLines(drops), rows.Err()
+}
+
+// reverseLines joins lines in reverse order so dependent objects go first
+func reverseLines(lines []string) string {
+	var out bytes.Buffer
+	for i := len(lines) - 1; i >= 0; i-- {
+		fmt.Fprintln(&out, lines[i])
+	}
+	return out.String()
+}
+
+// checkBaseline refuses to migrate a database that was partially migrated
+// before the migrations were squashed into the project baseline
+func checkBaseline(m Migrator, project *Project) error {
+	if project.Baseline == 0 {
+		return nil
+	}
+
+	version, _, err := m.Version()
+	if err == migrate.ErrNilVersion {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if version < project.Baseline {
+		return errors.Errorf("database version %d predates the baseline %d; apply the archived migrations first", version, project.Baseline)
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package actions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/n3integration/conseil"
+)
+
+func TestSquashMigrations(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(a string) { archiveDir = a }(archiveDir)
+		archiveDir = defaultArchiveDir
+
+		project := stageSqliteProject(t)
+		path := filepath.Join(wd, project.Migrations)
+		ioutil.WriteFile(filepath.Join(path, "0003_email.up.sql"), []byte("ALTER TABLE users ADD COLUMN email TEXT;"), 0644)
+		ioutil.WriteFile(filepath.Join(path, "0003_email.down.sql"), []byte("ALTER TABLE users DROP COLUMN email;"), 0644)
+
+		m, db, err := openMigrator(project, "file:"+filepath.Join(wd, "squash.sqlite"))
+		if err != nil {
+			t.Fatalf("failed to open migrations: %s", err)
+		}
+		defer m.Close()
+
+		if err := squashMigrations(m, db, project, "", 9); err == nil {
+			t.Error("expected an unknown version to generate an error")
+		}
+
+		if err := squashMigrations(m, db, project, "", 2); err != nil {
+			t.Fatalf("failed to squash migrations: %s", err)
+		}
+
+		if err := squashMigrations(m, db, project, "", 2); err == nil {
+			t.Error("expected a migrated database to generate an error")
+		}
+
+		files := []string{
+			filepath.Join(path, "0002_baseline.up.sql"),
+			filepath.Join(path, "0002_baseline.down.sql"),
+			filepath.Join(path, "0003_email.up.sql"),
+			filepath.Join(wd, defaultArchiveDir, "0002", "0001_users.up.sql"),
+			filepath.Join(wd, defaultArchiveDir, "0002", "0002_roles.down.sql"),
+		}
+		for _, file := range files {
+			if !conseil.FileExists(file) {
+				t.Errorf("expected %s to exist", file)
+			}
+		}
+
+		if count := conseil.FileCount(path, ".*\\.sql"); count != 4 {
+			t.Errorf("expected 4 migrations to remain; actual %d", count)
+		}
+
+		up, _ := ioutil.ReadFile(filepath.Join(path, "0002_baseline.up.sql"))
+		for _, table := range []string{"CREATE TABLE users", "CREATE TABLE roles"} {
+			if !bytes.Contains(up, []byte(table)) {
+				t.Errorf("expected the baseline to contain %q: \n%s", table, up)
+			}
+		}
+
+		down, _ := ioutil.ReadFile(filepath.Join(path, "0002_baseline.down.sql"))
+		if !bytes.Contains(down, []byte("DROP TABLE IF EXISTS roles;\nDROP TABLE IF EXISTS users;\n")) {
+			t.Errorf("expected the baseline to drop the tables in reverse order: \n%s", down)
+		}
+
+		fresh, freshDb, err := openMigrator(project, "file:"+filepath.Join(wd, "fresh.sqlite"))
+		if err != nil {
+			t.Fatalf("failed to open migrations: %s", err)
+		}
+		defer fresh.Close()
+
+		var out bytes.Buffer
+		if err := verifyMigrations(&out, fresh, freshDb, sqliteSchema); err != nil {
+			t.Errorf("failed to verify the squashed migrations: %s", err)
+		}
+	})
+}
+
+func TestSquashQuotedTables(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(a string) { archiveDir = a }(archiveDir)
+		archiveDir = defaultArchiveDir
+
+		project := stageSqliteProject(t)
+		path := filepath.Join(wd, project.Migrations)
+		ioutil.WriteFile(filepath.Join(path, "0003_orders.up.sql"), []byte(`CREATE TABLE "order" (id INTEGER PRIMARY KEY); CREATE TABLE "Accounts" (id INTEGER PRIMARY KEY);`), 0644)
+		ioutil.WriteFile(filepath.Join(path, "0003_orders.down.sql"), []byte(`DROP TABLE "Accounts"; DROP TABLE "order";`), 0644)
+
+		m, db, err := openMigrator(project, "file:"+filepath.Join(wd, "squash.sqlite"))
+		if err != nil {
+			t.Fatalf("failed to open migrations: %s", err)
+		}
+		defer m.Close()
+
+		if err := squashMigrations(m, db, project, "", 3); err != nil {
+			t.Fatalf("failed to squash migrations: %s", err)
+		}
+
+		down, _ := ioutil.ReadFile(filepath.Join(path, "0003_baseline.down.sql"))
+		if !bytes.Contains(down, []byte(`DROP TABLE IF EXISTS "Accounts";`)) || !bytes.Contains(down, []byte(`DROP TABLE IF EXISTS "order";`)) {
+			t.Errorf("expected the baseline to quote the dropped tables: \n%s", down)
+		}
+
+		fresh, freshDb, err := openMigrator(project, "file:"+filepath.Join(wd, "fresh.sqlite"))
+		if err != nil {
+			t.Fatalf("failed to open migrations: %s", err)
+		}
+		defer fresh.Close()
+
+		var out bytes.Buffer
+		if err := verifyMigrations(&out, fresh, freshDb, sqliteSchema); err != nil {
+			t.Errorf("failed to verify the squashed migrations: %s", err)
+		}
+	})
+}
+
+func TestSquashMigrationsFailure(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(a string) { archiveDir = a }(archiveDir)
+		archiveDir = defaultArchiveDir
+
+		project := stageSqliteProject(t)
+		path := filepath.Join(wd, project.Migrations)
+		m, db, err := openMigrator(project, "file:"+filepath.Join(wd, "squash.sqlite"))
+		if err != nil {
+			t.Fatalf("failed to open migrations: %s", err)
+		}
+		defer m.Close()
+
+		// the baseline cannot be written over a directory
+		os.MkdirAll(filepath.Join(path, "0002_baseline.up.sql.tmp"), 0755)
+		if err := squashMigrations(m, db, project, "", 2); err == nil {
+			t.Fatal("expected a failure to write the baseline to generate an error")
+		}
+
+		if count := conseil.FileCount(path, ".*\\.sql$"); count != 4 {
+			t.Errorf("expected the migrations to remain in place; actual %d", count)
+		}
+
+		if conseil.FileExists(filepath.Join(wd, defaultArchiveDir)) {
+			t.Error("expected no migrations to be archived")
+		}
+	})
+}
+
+func TestCheckBaseline(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		project := stageSqliteProject(t)
+		m, _, err := openMigrator(project, dsn)
+		if err != nil {
+			t.Fatalf("failed to open migrations: %s", err)
+		}
+		defer m.Close()
+
+		project.Baseline = 2
+		if err := checkBaseline(m, project); err != nil {
+			t.Errorf("expected an empty database to be accepted: %s", err)
+		}
+
+		m.Steps(1)
+		if err := dbUp(m, project, nil); err == nil {
+			t.Error("expected a database predating the baseline to generate an error")
+		}
+
+		m.Steps(1)
+		if err := checkBaseline(m, project); err != nil {
+			t.Errorf("expected a database at the baseline to be accepted: %s", err)
+		}
+	})
+}
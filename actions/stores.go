@@ -0,0 +1,80 @@
+package actions
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+const storeMigrationFormat = "%04d"
+
+var store string
+
+// kvStore describes a supported embedded key-value store
+type kvStore struct {
+	// Template renders the generated store/store.go
+	Template string
+	// Path is the default location of the database opened by the store
+	Path string
+}
+
+var stores = map[string]kvStore{
+	"bbolt":  {Template: "templates/store/bbolt.tpl", Path: "data.db"},
+	"badger": {Template: "templates/store/badger.tpl", Path: "data"},
+}
+
+// lookupStore retrieves the descriptor for the named key-value store
+func lookupStore(name string) (kvStore, error) {
+	s, ok := stores[name]
+	if !ok {
+		return kvStore{}, errors.Errorf("%s is not a supported store", name)
+	}
+	return s, nil
+}
+
+// stageStore writes the store package backed by the selected key-value
+// store, along with its typed buckets, data migrations, and subcommands,
+// staging the initial data migration
+func stageStore(templates *template.Template) error {
+	s, err := lookupStore(store)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(wd, "store")
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+
+	log.Printf("staging %s store...", store)
+	files := map[string]string{
+		s.Template:                       "store.go",
+		"templates/store/bucket.tpl":     "bucket.go",
+		"templates/store/migrations.tpl": "migrations.go",
+		"templates/store/commands.tpl":   "commands.go",
+	}
+
+	for name, file := range files {
+		if err := writeSource(templates, name, filepath.Join(path, file), &Context{}); err != nil {
+			return err
+		}
+	}
+
+	version := fmt.Sprintf(storeMigrationFormat, 1)
+	context := &Context{Version: "1", Name: "init"}
+	return writeSource(templates, "templates/store/migration.tpl", filepath.Join(path, version+"_init.go"), context)
+}
+
+func listStores() []string {
+	storeList := make([]string, 0, len(stores))
+	for name := range stores {
+		storeList = append(storeList, name)
+	}
+	sort.Strings(storeList)
+	return storeList
+}
@@ -0,0 +1,91 @@
+package actions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/n3integration/conseil"
+)
+
+func TestListStores(t *testing.T) {
+	storeList := listStores()
+	if len(storeList) != len(stores) {
+		t.Fatalf("expected %d stores; actual %d", len(stores), len(storeList))
+	}
+	if !sort.StringsAreSorted(storeList) {
+		t.Errorf("expected stores to be sorted: %v", storeList)
+	}
+
+	if _, err := lookupStore("leveldb"); err == nil {
+		t.Error("expected an unsupported store to generate an error")
+	}
+}
+
+func TestStageStore(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(s string) { store = s }(store)
+
+		tests := []struct {
+			Store    string
+			Expected string
+		}{
+			{"bbolt", `bolt "go.etcd.io/bbolt"`},
+			{"badger", `"github.com/dgraph-io/badger/v4"`},
+		}
+
+		for _, test := range tests {
+			store = test.Store
+			if err := stageStore(templates); err != nil {
+				t.Fatalf("failed to stage the %s store: %s", test.Store, err)
+			}
+
+			for _, file := range []string{"store.go", "bucket.go", "migrations.go", "commands.go", "0001_init.go"} {
+				if !conseil.FileExists(filepath.Join(wd, "store", file)) {
+					t.Errorf("expected store/%s to be created", file)
+				}
+			}
+
+			src, _ := ioutil.ReadFile(filepath.Join(wd, "store", "store.go"))
+			if !bytes.Contains(src, []byte(test.Expected)) {
+				t.Errorf("generated %s store did not contain %s: \n%s", test.Store, test.Expected, src)
+			}
+		}
+
+		migration, _ := ioutil.ReadFile(filepath.Join(wd, "store", "0001_init.go"))
+		if !bytes.Contains(migration, []byte("Version: 1,")) {
+			t.Errorf("unexpected initial data migration: \n%s", migration)
+		}
+	})
+}
+
+func TestCreateWebAppStore(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(s, m string, b bool) { store, module, migrations = s, m, b }(store, module, migrations)
+		store, module = "bbolt", "github.com/example/app"
+		host, port = "localhost", 8080
+
+		for _, app := range listApps() {
+			for _, migrations = range []bool{false, true} {
+				framework = app
+				if err := createWebApp(templates); err != nil {
+					t.Fatalf("failed to create %s web application: %s", framework, err)
+				}
+
+				actual, _ := ioutil.ReadFile(filepath.Join(wd, "app.go"))
+				expected := [][]byte{[]byte(`"github.com/example/app/store"`), []byte("store.Command(os.Args[2:])"), []byte("onClose(store.Close)")}
+				if migrations {
+					expected = append(expected, []byte(`"github.com/example/app/sql"`))
+				}
+
+				for _, e := range expected {
+					if !bytes.Contains(actual, e) {
+						t.Errorf("generated %s application did not contain %s: \n%s", app, e, actual)
+					}
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+
+	migrate "github.com/golang-migrate/migrate/v4"
+	"github.com/jackc/pgx/v5"
+	tern "github.com/jackc/tern/v2/migrate"
+)
+
+const ternVersionTable = "schema_version"
+
+// ternMigrator drives tern migrations, which are applied over a pgx
+// connection and tracked without a dirty state
+type ternMigrator struct {
+	ctx  context.Context
+	conn *pgx.Conn
+	db   *sql.DB
+	m    *tern.Migrator
+}
+
+func openTernMigrator(project *Project, connStr string) (Migrator, *sql.DB, error) {
+	d, err := lookupDriver(project.Driver)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, connStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m, err := tern.NewMigrator(ctx, conn, ternVersionTable)
+	if err == nil {
+		err = m.LoadMigrations(os.DirFS(filepath.Join(wd, project.Migrations)))
+	}
+	if err != nil {
+		conn.Close(ctx)
+		return nil, nil, err
+	}
+
+	db, err := sql.Open(d.sqlName(), connStr)
+	if err != nil {
+		conn.Close(ctx)
+		return nil, nil, err
+	}
+	return &ternMigrator{ctx, conn, db, m}, db, nil
+}
+
+func (t *ternMigrator) Up() error {
+	return t.Migrate(uint64(len(t.m.Migrations)))
+}
+
+func (t *ternMigrator) Down() error {
+	return t.Migrate(0)
+}
+
+func (t *ternMigrator) Steps(n int) error {
+	current, err := t.m.GetCurrentVersion(t.ctx)
+	if err != nil {
+		return err
+	}
+
+	target := int(current) + n
+	if target < 0 {
+		target = 0
+	} else if target > len(t.m.Migrations) {
+		target = len(t.m.Migrations)
+	}
+	return t.Migrate(uint64(target))
+}
+
+func (t *ternMigrator) Migrate(version uint64) error {
+	current, err := t.m.GetCurrentVersion(t.ctx)
+	if err != nil {
+		return err
+	}
+
+	if uint64(current) == version {
+		return migrate.ErrNoChange
+	}
+	return t.m.MigrateTo(t.ctx, int32(version))
+}
+
+func (t *ternMigrator) Force(version int) error {
+	if version < 0 {
+		version = 0
+	}
+
+	_, err := t.conn.Exec(t.ctx, "UPDATE "+ternVersionTable+" SET version = $1", version)
+	return err
+}
+
+func (t *ternMigrator) Version() (uint64, bool, error) {
+	version, err := t.m.GetCurrentVersion(t.ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if version == 0 {
+		return 0, false, migrate.ErrNilVersion
+	}
+	return uint64(version), false, nil
+}
+
+func (t *ternMigrator) Close() error {
+	t.db.Close()
+	return t.conn.Close(t.ctx)
+}
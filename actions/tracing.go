@@ -0,0 +1,30 @@
+package actions
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+var appTracing bool
+
+// stageTracing writes the tracing package configuring OpenTelemetry, along with
+// the tracing middleware of the app framework
+func stageTracing(templates *template.Template) error {
+	path := filepath.Join(wd, "tracing")
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+
+	log.Println("staging tracing...")
+	context := &Context{App: framework, Name: getPath()}
+	if err := writeSource(templates, "templates/tracing/tracing.tpl", filepath.Join(path, "tracing.go"), context); err != nil {
+		return err
+	}
+
+	// the middleware shares the route lookup of the metrics middleware
+	context = &Context{Module: module, Metrics: appMetrics}
+	return writeSource(templates, fmt.Sprintf("templates/tracing/%s.tpl", framework), filepath.Join(wd, "tracing.go"), context)
+}
@@ -0,0 +1,128 @@
+package actions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestStageTracing(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(m string) { module = m }(module)
+		module = "github.com/example/app"
+
+		middleware := map[string]string{
+			"echo": "func routeSpans() echo.MiddlewareFunc {",
+			"gin":  "otelgin.Middleware(tracing.Service)",
+			"grpc": "grpc.StatsHandler(otelgrpc.NewServerHandler())",
+			"iris": "func routeSpans(ctx iris.Context) {",
+			"ozzo": "func routeSpans(c *routing.Context) error {",
+		}
+
+		for _, app := range listApps() {
+			framework = app
+			if err := stageTracing(templates); err != nil {
+				t.Fatalf("failed to stage the %s tracing: %s", app, err)
+			}
+
+			src, _ := ioutil.ReadFile(filepath.Join(wd, "tracing.go"))
+			if !bytes.Contains(src, []byte(middleware[app])) {
+				t.Errorf("generated %s middleware did not contain %s: \n%s", app, middleware[app], src)
+			}
+
+			pkg, _ := ioutil.ReadFile(filepath.Join(wd, "tracing", "tracing.go"))
+			for _, s := range []string{"func Setup() (func(context.Context) error, error) {", `os.Getenv("OTEL_TRACES_EXPORTER")`, "stdouttrace.New()"} {
+				if !bytes.Contains(pkg, []byte(s)) {
+					t.Errorf("generated %s tracing package did not contain %s: \n%s", app, s, pkg)
+				}
+			}
+
+			if helpers := bytes.Contains(pkg, []byte("func Route(")); helpers == (app == "grpc") {
+				t.Errorf("expected the %s tracing package to define the span helpers of routes only for HTTP apps: \n%s", app, pkg)
+			}
+		}
+	})
+}
+
+func TestStageTracingRoutes(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(m string, b bool) { module, appMetrics = m, b }(module, appMetrics)
+		module = "github.com/example/app"
+
+		for _, app := range []string{"echo", "ozzo"} {
+			framework = app
+			for _, metrics := range []bool{false, true} {
+				appMetrics = metrics
+				if err := stageTracing(templates); err != nil {
+					t.Fatalf("failed to stage the %s tracing: %s", app, err)
+				}
+
+				// the route lookup is defined by the metrics middleware when present
+				src, _ := ioutil.ReadFile(filepath.Join(wd, "tracing.go"))
+				if defined := bytes.Contains(src, []byte("func routeTemplate(")); defined == metrics {
+					t.Errorf("expected the %s middleware to define the route lookup only without metrics: \n%s", app, src)
+				}
+			}
+		}
+	})
+}
+
+func TestCreateWebAppTracing(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(m string, b bool) { module, appTracing = m, b }(module, appTracing)
+		module, appTracing = "github.com/example/app", true
+		host, port = "localhost", 8080
+
+		middleware := map[string]string{
+			"echo": "newServer(addr, traceRequests(r))",
+			"gin":  "traceRequests(),",
+			"grpc": "grpc.NewServer(serverOptions()...)",
+			"iris": "newServer(addr, traceRequests(app))",
+			"ozzo": "newServer(addr, traceRequests(r))",
+		}
+
+		for _, app := range listApps() {
+			framework = app
+			if err := createWebApp(templates); err != nil {
+				t.Fatalf("failed to create %s web application: %s", framework, err)
+			}
+
+			actual, _ := ioutil.ReadFile(filepath.Join(wd, "app.go"))
+			for _, expected := range []string{`"github.com/example/app/tracing"`, "flush, err := tracing.Setup()", "onShutdown(flush)", middleware[app]} {
+				if !bytes.Contains(actual, []byte(expected)) {
+					t.Errorf("generated %s application did not contain %s: \n%s", app, expected, actual)
+				}
+			}
+
+			if app == "grpc" {
+				src, _ := ioutil.ReadFile(filepath.Join(wd, "server.go"))
+				if !bytes.Contains(src, []byte("traceCalls(),")) {
+					t.Errorf("expected the grpc server to trace its calls: \n%s", src)
+				}
+			}
+		}
+	})
+}
+
+func TestSetupDbTracing(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d string, r, b bool) { driver, replicas, appTracing = d, r, b }(driver, replicas, appTracing)
+		driver, replicas, appTracing = "pgx", true, true
+
+		if err := setupDb(templates); err != nil {
+			t.Fatalf("failed to setup database file: %s", err)
+		}
+
+		src, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "sql.go"))
+		for _, expected := range []string{"db, err = open(primaryURL())", `otelsql.Open("pgx", dsn, otelsql.WithAttributes(semconv.DBSystemNameKey.String("postgresql")))`} {
+			if !bytes.Contains(src, []byte(expected)) {
+				t.Errorf("generated sql package did not contain %s: \n%s", expected, src)
+			}
+		}
+
+		if src, _ := ioutil.ReadFile(filepath.Join(wd, "sql", "replicas.go")); bytes.Contains(src, []byte("sql.Open(")) {
+			t.Errorf("expected the replicas to be traced: \n%s", src)
+		}
+	})
+}
@@ -0,0 +1,219 @@
+package actions
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	migrate "github.com/golang-migrate/migrate/v4"
+	"github.com/pkg/errors"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+// scratchDSN connects to an empty database used to apply the migrations
+var scratchDSN string
+
+func verifyMigrationAction(_ *cli.Context) error {
+	if wd == "" {
+		wd = "."
+	}
+
+	project, err := loadProject(wd)
+	if err != nil {
+		return err
+	}
+	project.Migrations = migrationDir
+
+	d, err := lookupDriver(project.Driver)
+	if err != nil {
+		return err
+	}
+
+	connStr := scratchDSN
+	if connStr == "" {
+		if !d.throwaway() {
+			return errors.Errorf("verifying %s migrations requires --dsn to an empty %s database", project.Driver, project.Driver)
+		}
+
+		dir, err := ioutil.TempDir("", "conseil-verify")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+		connStr = fmt.Sprintf("file:%s", filepath.Join(dir, "verify.sqlite"))
+	}
+
+	m, db, err := openMigrator(project, connStr)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := verifyMigrations(os.Stdout, m, db, d.Schema); err != nil {
+		return cli.NewExitError(err.Error(), 1)
+	}
+	return nil
+}
+
+// verifyMigrations applies each migration up, down, and up again, failing
+// when a down migration does not restore the schema found before its up
+// migration; the database must not have any migrations applied
+func verifyMigrations(w io.Writer, m Migrator, db *sql.DB, query string) error {
+	if _, _, err := m.Version(); err != migrate.ErrNilVersion {
+		if err != nil {
+			return err
+		}
+		return errors.New("refusing to verify migrations against a database with migrations applied")
+	}
+
+	initial, err := dumpSchema(db, query)
+	if err != nil {
+		return err
+	}
+
+	before := initial
+	for {
+		version, after, err := verifyStep(m, db, query, before)
+		if err == migrate.ErrNoChange {
+			break
+		} else if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "ok       %d\n", version)
+		before = after
+	}
+
+	log.Println("rolling back all migrations...")
+	if err := ignoreNoChange(m.Down()); err != nil {
+		return err
+	}
+	if err := compareSchema(db, query, initial, "rolling back all migrations"); err != nil {
+		return err
+	}
+
+	log.Println("reapplying all migrations...")
+	if err := ignoreNoChange(m.Up()); err != nil {
+		return err
+	}
+	return compareSchema(db, query, before, "reapplying all migrations")
+}
+
+// verifyStep applies, rolls back, and reapplies the next migration, returning
+// its version and the schema after it has been applied
+func verifyStep(m Migrator, db *sql.DB, query, before string) (uint64, string, error) {
+	if err := m.Steps(1); err != nil {
+		if err == migrate.ErrNoChange {
+			return 0, "", err
+		}
+		return 0, "", errors.Wrap(err, "applying the next migration")
+	}
+
+	version, _, err := m.Version()
+	if err != nil {
+		return 0, "", err
+	}
+
+	after, err := dumpSchema(db, query)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if err := m.Steps(-1); err != nil {
+		return 0, "", errors.Wrapf(err, "rolling back migration %d", version)
+	}
+	if err := compareSchema(db, query, before, fmt.Sprintf("rolling back migration %d", version)); err != nil {
+		return 0, "", err
+	}
+
+	if err := m.Steps(1); err != nil {
+		return 0, "", errors.Wrapf(err, "reapplying migration %d", version)
+	}
+	if err := compareSchema(db, query, after, fmt.Sprintf("reapplying migration %d", version)); err != nil {
+		return 0, "", err
+	}
+	return version, after, nil
+}
+
+// compareSchema fails when the current schema differs from the expected dump
+func compareSchema(db *sql.DB, query, expected, step string) error {
+	actual, err := dumpSchema(db, query)
+	if err != nil {
+		return err
+	}
+
+	if actual != expected {
+		return errors.Errorf("schema mismatch after %s:\n%s", step, diffSchema(expected, actual))
+	}
+	return nil
+}
+
+// dumpSchema describes the database schema as one line per row of query
+func dumpSchema(db *sql.DB, query string) (string, error) {
+	rows, err := db.Query(query)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to dump the schema")
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return "", err
+	}
+
+	var dump strings.Builder
+	values := make([]sql.NullString, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return "", err
+		}
+
+		fields := make([]string, len(values))
+		for i, v := range values {
+			fields[i] = strings.Join(strings.Fields(v.String), " ")
+		}
+		dump.WriteString(strings.Join(fields, " | "))
+		dump.WriteString("\n")
+	}
+	return dump.String(), rows.Err()
+}
+
+// diffSchema lists the lines missing from (-) and added to (+) a schema dump
+func diffSchema(expected, actual string) string {
+	var diff strings.Builder
+	for _, line := range missingLines(expected, actual) {
+		fmt.Fprintf(&diff, "- %s\n", line)
+	}
+	for _, line := range missingLines(actual, expected) {
+		fmt.Fprintf(&diff, "+ %s\n", line)
+	}
+	return strings.TrimSuffix(diff.String(), "\n")
+}
+
+// missingLines lists the lines of a that do not appear in b
+func missingLines(a, b string) []string {
+	seen := make(map[string]int)
+	for _, line := range strings.Split(b, "\n") {
+		seen[line]++
+	}
+
+	missing := make([]string, 0)
+	for _, line := range strings.Split(a, "\n") {
+		if seen[line] > 0 {
+			seen[line]--
+			continue
+		}
+		missing = append(missing, line)
+	}
+	return missing
+}
@@ -0,0 +1,100 @@
+package actions
+
+import (
+	"bytes"
+	"database/sql"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVerifyMigrations(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		project := stageSqliteProject(t)
+		m, db, err := openMigrator(project, dsn)
+		if err != nil {
+			t.Fatalf("failed to open migrations: %s", err)
+		}
+		defer m.Close()
+
+		var out bytes.Buffer
+		if err := verifyMigrations(&out, m, db, sqliteSchema); err != nil {
+			t.Fatalf("failed to verify migrations: %s", err)
+		}
+
+		if out.String() != "ok       1\nok       2\n" {
+			t.Errorf("unexpected verification output: \n%s", out.String())
+		}
+
+		if err := verifyMigrations(&out, m, db, sqliteSchema); err == nil {
+			t.Error("expected a migrated database to generate an error")
+		}
+	})
+}
+
+func TestVerifyIrreversibleMigration(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		project := stageSqliteProject(t)
+		path := filepath.Join(wd, project.Migrations)
+		ioutil.WriteFile(filepath.Join(path, "0003_email.up.sql"), []byte("ALTER TABLE users ADD COLUMN email TEXT;"), 0644)
+		ioutil.WriteFile(filepath.Join(path, "0003_email.down.sql"), []byte("SELECT 1;"), 0644)
+
+		m, db, err := openMigrator(project, dsn)
+		if err != nil {
+			t.Fatalf("failed to open migrations: %s", err)
+		}
+		defer m.Close()
+
+		var out bytes.Buffer
+		err = verifyMigrations(&out, m, db, sqliteSchema)
+		if err == nil {
+			t.Fatal("expected an irreversible migration to generate an error")
+		}
+
+		if !strings.Contains(err.Error(), "rolling back migration 3") || !strings.Contains(err.Error(), "+ table | users | users | CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT NOT NULL, email TEXT)") {
+			t.Errorf("expected the schema difference to be reported: %s", err)
+		}
+	})
+}
+
+func TestDumpSchema(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		db, err := sql.Open("sqlite3", "file:"+filepath.Join(wd, "dump.sqlite"))
+		if err != nil {
+			t.Fatalf("failed to open database: %s", err)
+		}
+		defer db.Close()
+
+		db.Exec("CREATE TABLE schema_migrations (version INTEGER)")
+		db.Exec("CREATE TABLE users (\n  id INTEGER PRIMARY KEY\n)")
+		db.Exec("CREATE INDEX users_id ON users(id)")
+
+		dump, err := dumpSchema(db, sqliteSchema)
+		if err != nil {
+			t.Fatalf("failed to dump schema: %s", err)
+		}
+
+		expected := "index | users_id | users | CREATE INDEX users_id ON users(id)\ntable | users | users | CREATE TABLE users ( id INTEGER PRIMARY KEY )\n"
+		if dump != expected {
+			t.Errorf("unexpected schema dump: \n%s", dump)
+		}
+	})
+}
+
+func TestVerifyRequiresDSN(t *testing.T) {
+	stageTest(t, func(t *testing.T) {
+		defer func(d string) { migrationDir, scratchDSN = d, "" }(migrationDir)
+		migrationDir, scratchDSN = defaultMigrationDir, ""
+
+		project := &Project{Driver: "mysql", Migrations: defaultMigrationDir}
+		if err := project.save(wd); err != nil {
+			t.Fatalf("failed to save project: %s", err)
+		}
+
+		err := verifyMigrationAction(nil)
+		if err == nil || !strings.Contains(err.Error(), "requires --dsn") {
+			t.Errorf("expected verifying mysql migrations without a dsn to generate an error; actual %v", err)
+		}
+	})
+}
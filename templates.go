@@ -5,11 +5,71 @@
 // templates/app/grpc.tpl
 // templates/app/iris.tpl
 // templates/app/ozzo.tpl
+// templates/config/config.tpl
 // templates/gitignore.tpl
+// templates/logging/echo.tpl
+// templates/logging/gin.tpl
+// templates/logging/grpc.tpl
+// templates/logging/iris.tpl
+// templates/logging/logging.tpl
+// templates/logging/ozzo.tpl
+// templates/metrics/echo.tpl
+// templates/metrics/gin.tpl
+// templates/metrics/grpc.tpl
+// templates/metrics/iris.tpl
+// templates/metrics/metrics.tpl
+// templates/metrics/ozzo.tpl
+// templates/resource/echo.tpl
+// templates/resource/gin.tpl
+// templates/resource/handlers_test.tpl
+// templates/resource/iris.tpl
+// templates/resource/ozzo.tpl
+// templates/resource/stdlib.tpl
+// templates/server/grpc.tpl
+// templates/server/http.tpl
 // templates/sql/1.down.tpl
 // templates/sql/1.up.tpl
+// templates/sql/cockroachdb/1.down.tpl
+// templates/sql/cockroachdb/1.up.tpl
+// templates/sql/db.tpl
+// templates/sql/ent/generate.tpl
+// templates/sql/ent/schema.tpl
+// templates/sql/goose/migration.go.tpl
+// templates/sql/goose/migrations.tpl
+// templates/sql/goose/package.tpl
+// templates/sql/migration.down.tpl
+// templates/sql/migration.up.tpl
 // templates/sql/migrations.tpl
+// templates/sql/migrations_test.tpl
+// templates/sql/models.tpl
+// templates/sql/mysql/1.down.tpl
+// templates/sql/mysql/1.up.tpl
+// templates/sql/replicas.tpl
+// templates/sql/repository.tpl
+// templates/sql/schema.tpl
+// templates/sql/seed.tpl
+// templates/sql/seeds.tpl
 // templates/sql/sql.tpl
+// templates/sql/sqlc/example.tpl
+// templates/sql/sqlc/generate.tpl
+// templates/sql/sqlc/queries.tpl
+// templates/sql/sqlc/sqlc.tpl
+// templates/sql/sqlserver/1.down.tpl
+// templates/sql/sqlserver/1.up.tpl
+// templates/sql/store.tpl
+// templates/sql/tern/migrations.tpl
+// templates/store/badger.tpl
+// templates/store/bbolt.tpl
+// templates/store/bucket.tpl
+// templates/store/commands.tpl
+// templates/store/migration.tpl
+// templates/store/migrations.tpl
+// templates/tracing/echo.tpl
+// templates/tracing/gin.tpl
+// templates/tracing/grpc.tpl
+// templates/tracing/iris.tpl
+// templates/tracing/ozzo.tpl
+// templates/tracing/tracing.tpl
 // DO NOT EDIT!
 
 package conseil
@@ -77,7 +137,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesAppEchoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x51\xc1\x6a\xdc\x30\x10\xbd\xeb\x2b\x06\x9d\x6c\xba\x48\x77\x43\x0e\x65\x49\x5b\x9a\xb0\x1b\x76\xd3\x53\xe9\x41\x91\xa7\xb6\x88\xad\x31\xa3\x71\x5c\x58\xfc\xef\x45\xab\x4d\x7d\x69\xeb\x8b\x25\xde\x3c\xbd\x37\xef\x4d\xce\xbf\xba\x0e\x61\x74\x21\x2a\x15\xc6\x89\x58\xa0\x52\x00\x00\x3a\xa2\xd8\x5e\x64\xd2\xaa\xdc\xbb\x20\xfd\xfc\x62\x3c\x8d\x76\x70\x2f\x49\x9c\x7f\xb5\xe8\x7b\xd2\xff\x87\xed\x18\xda\x76\xc0\xc5\x31\x6a\x55\x2b\xf5\xe6\x18\x5c\xdb\x32\xdc\x81\xbe\x5c\xc0\x7c\xa1\x24\xb0\xae\x4d\x3e\x3f\x65\xf9\x75\xd5\x4a\xfd\x9c\xa3\xbf\xba\xaa\x6a\xb8\x5c\x05\xac\x85\x3d\xa3\x13\x84\x88\x0b\x30\xcd\x82\x7c\x05\x18\x9a\x3b\xc8\x4a\xe6\x80\x4b\x55\xab\xf7\xe9\x33\xca\x3c\x81\xa7\x71\xa4\x08\x9b\x89\xc2\x31\xdf\x12\x96\x3d\xf3\xb7\xa1\xe6\x91\xba\x0e\xb9\xaa\x77\x7f\x03\x4f\xe8\xe9\x6d\x43\x37\xad\x13\x76\x21\x09\x32\xf4\xe8\x06\xe9\x01\x63\x3b\x51\x88\x72\x13\xfb\x7c\xff\x5c\x69\x5b\x30\xbd\xbb\x0d\x6d\xec\x03\x2d\x30\x64\x7e\x0c\xb1\x03\x8a\x0d\xe4\xdc\x1b\x6b\xff\x91\xcf\x3b\xef\xe3\x34\x0d\xc1\x3b\x09\x14\x21\x89\x63\xc1\xd6\xc0\x13\x63\x4a\xb0\x7f\x3e\x3d\x7e\xd8\x83\x10\xa4\x7e\x16\x68\x69\x89\xe6\x66\xa6\x6c\x68\x3e\x39\x71\x43\xc5\xe6\x9c\x89\x55\x6e\xa4\xae\xd5\xaa\x94\xb5\x70\xef\x7b\x82\xde\xc5\x76\x40\x2e\x4d\x14\xc7\x95\x2f\x39\xef\x29\x0a\xfe\x92\x1a\x90\x99\xf8\xd6\x0f\xa3\xcc\x1c\xc1\x9b\xaf\xe7\xe3\xa1\xca\x0b\xe4\xa7\x65\x4e\xc7\x87\x1d\x8c\x6e\xfa\x9e\x84\x43\xec\x7e\x94\xdf\xe5\x4f\xbc\x3a\x5d\xa7\x74\x03\xfa\xf8\xa0\x4b\xb0\x6b\xad\xd6\xdf\x01\x00\x00\xff\xff\xe2\xb7\xb1\x6d\x9b\x02\x00\x00")
+var _templatesAppEchoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x4d\x6f\xdb\x38\x13\xbe\xfb\x57\xcc\xab\x43\x21\xbd\xab\x50\xed\x1e\xbd\xc8\x02\x41\x9a\xb6\xd8\x3a\x1f\xb0\xd3\xdd\x43\x11\x14\xb4\x34\x96\x85\x48\xa4\x4a\x52\x4e\x17\x86\xfe\xfb\x62\x48\x4a\x96\x1d\x39\x49\x5b\xec\x02\x06\x2c\x71\x38\x5f\x0f\x9f\x19\x0d\x6b\x9e\xde\xf3\x1c\xa1\xe2\x85\x98\x4c\x8a\xaa\x96\xca\x40\x38\x01\x00\x08\x4a\x99\x07\xee\x49\xa0\x49\xd6\xc6\xd4\xc1\x64\xbb\x3d\x81\x62\x05\x52\x01\xbb\x2c\x72\xc5\x4d\x21\x85\x06\xb6\x30\x52\x21\xb0\x73\x29\x56\x45\x0e\x6c\x26\xf3\xbc\x10\x39\xb4\xad\xd3\x97\xda\x69\xa2\xc8\x68\xcd\x2d\xe6\x85\x59\x37\x4b\x96\xca\x2a\x29\xf9\x52\x1b\x9e\xde\x27\x98\xae\x65\xf0\xb4\x38\xa9\x8a\x2c\x2b\xf1\x81\x2b\xfc\xde\x70\xd8\x25\x1a\x55\xa4\x1a\xd8\xad\xe2\xa9\x0f\x70\xbb\xa5\x84\xba\xbd\x5d\xc4\xdb\x2d\xb0\x4b\x99\x35\x25\x42\xdb\x26\xa9\x15\xee\xe5\xe0\x3d\x3f\x4a\x75\x5f\xb1\x74\xd2\x51\xcd\x2e\x98\x71\xcd\xca\x49\xc7\x35\x77\xa9\x8e\x2b\xeb\xaf\xe5\xa8\xa2\x3b\xa6\x23\x3a\x24\x1b\xd5\x1a\x80\x35\xa2\x67\x9c\xf4\x91\xa6\x7f\x8c\x3a\x33\x42\x9a\x21\xca\x93\x0d\x57\xc0\xb3\x4c\xc1\xa9\x8b\xe4\x83\xd4\x06\xda\x76\x4a\xcf\x37\xc4\xc2\xb6\xed\xcf\x97\x9d\x65\x55\x21\xfc\xaa\x53\xf5\xf8\x9c\x1d\xb1\x30\x54\x38\x16\xda\x64\xd5\x88\xd4\x32\x3f\x8c\x60\xdb\xfb\xda\x67\x42\x92\xc0\x4c\xf2\x0c\xcc\x1a\x41\xa3\x31\x85\xc8\x35\xac\x94\xac\xec\x4a\x86\x2b\xde\x94\x46\xc7\xe0\x28\x02\xab\xa2\xc4\x18\x50\x6c\x0a\x25\x45\x85\xc2\xc4\xc0\x45\x06\xab\x92\xe7\xda\xa2\x9e\xae\xf2\x18\x50\x29\x98\x9e\x7a\x1d\x46\xf6\x43\xa9\xd9\x99\xca\xf5\xe7\x37\xd3\xbb\xc8\x6e\x2c\x56\x76\xdb\xff\x4e\x41\x14\x25\x6c\xed\x1a\xfd\x4a\x99\xb3\x77\xdc\xf0\x32\x44\xa5\xdc\x56\x5f\x51\x49\x02\x37\xaa\x10\x66\x2f\xd6\x18\x14\x66\x3c\xa5\x67\xd0\x98\x2a\xa4\x60\x91\xe5\x0c\x58\xc2\xeb\x1a\x4e\x4e\x6a\xd2\x39\x71\xb1\x74\x9e\xd3\x55\xce\xac\x2d\x0f\xc6\xce\xbd\x0f\x6b\x7a\xba\xdb\x43\xb1\x2f\x4c\x26\x1b\x13\xfd\x36\x1e\xf3\x91\xb8\xe9\xd7\xf6\x4f\x0a\x4d\xa3\xc4\x41\x42\x67\x75\x5d\xfe\x6d\x13\x72\x01\x36\x0a\xb3\x3e\x37\xbb\x57\xaf\x1b\x93\xc9\x07\x71\x5b\x54\x28\x1b\x03\x2e\xb0\xc5\xfe\xea\x13\xa5\xa3\xbf\x96\xec\xd3\x7c\xe6\xf5\xde\x72\xc3\x97\x5c\xe3\xa7\xf9\xec\xd9\x02\xb2\x15\xc3\x6e\xb8\x59\x77\x4e\x69\x81\xde\x8f\x10\x6e\xa4\x67\x3c\x26\xdd\x76\xdb\x6d\xf7\x10\xcc\x64\x0e\x5c\xc3\x1f\x8b\xeb\x2b\x6a\x74\x06\xbf\x19\xe0\xe6\x10\x93\x12\x37\x58\x8e\x98\xdb\x3f\x33\xdf\x8f\xd8\x02\x4d\x53\x87\x04\xd4\x4c\xe6\xef\xa4\xaa\xb8\x89\xc1\xbf\xce\xc8\xd2\xe1\x49\x92\x61\x2c\x35\x3e\x67\x52\x6a\xf6\x1e\x0d\x8a\x4d\x68\x4b\xf2\x8a\x57\xa4\xf2\x65\x76\xfd\xfe\xcb\xbb\xeb\xf9\xe5\xd9\x6d\x10\xc5\xf0\xc4\xa6\xd9\xc5\x9f\x17\xb3\x20\x1a\x75\xbf\x43\xe5\x68\x1d\x3c\xdb\x2c\xfd\xaa\x54\x3d\x46\xfb\xa7\x31\xf4\x92\x24\xf0\xb6\xd0\x35\x37\xe9\x1a\xaa\xce\x0a\xe8\x66\x99\xca\xaa\xe2\x22\xdb\x2f\x24\xb7\x03\xa1\xa9\x8f\x9f\x42\x89\xc2\x82\x4e\x95\x1e\xc1\xef\xf0\x1a\x5e\xbd\x82\x6e\xe1\xf3\xeb\x3b\x38\x3d\x85\xc0\x1b\x0a\x06\xf5\xb3\x43\x9b\xd8\xea\x3e\xbc\xd8\x5b\xb2\x3d\xe3\xf9\xf3\x22\xe7\xbe\xcb\x90\xef\x37\xe4\xbb\xef\x3a\xdf\xe9\xba\xd3\xfb\x75\x7a\xf7\x82\xa3\xfa\xf1\xf2\xbf\xae\x51\x58\xa6\x67\xbe\x2e\x63\x48\x4b\xa9\xa9\x78\x0a\x03\x52\xf4\xd5\x3f\x79\x1c\x2b\xe9\x86\x87\xd1\x3d\x11\x8e\x3b\x74\x29\xce\x4b\xa9\x31\x24\xa0\xed\x53\x34\xcc\xe8\x51\x1f\x78\x82\x4f\x8f\x98\x77\x8c\x5b\xb6\x8f\x1c\xe5\x95\x93\x2e\x79\x7a\xdf\xd4\x16\x06\xb6\xe4\xf7\x3f\x49\x31\x6b\xf3\xc8\x29\x93\x88\x9d\x3b\x86\xff\x0b\x14\x7b\xb1\xeb\xff\x9c\x62\x36\xb2\x97\xf2\x8b\xf6\xfe\x2c\xc3\xac\x8d\xe3\x1c\xdb\x9b\x51\x5f\xc4\xb2\x21\x2d\x87\x00\x25\x09\x90\x31\xb4\x69\x2a\xfc\xda\xa0\xb6\x53\xc0\x37\x9a\xf6\xc9\x05\xad\xeb\x9a\x0b\x3d\xfc\xa2\x2c\xdd\x77\xf7\xfa\xf6\x62\xf6\xe5\xff\xb0\xe1\xaa\xe0\xcb\x12\xdd\x14\xb3\x2a\x1b\xbd\xee\xe7\x18\x3f\x02\xfa\x4f\xc0\x0f\x8c\x2f\x24\x90\xa2\xfb\x66\x87\xd6\xfa\x18\x26\x2f\x9d\xf3\xf7\xa0\x3b\x40\xe2\x5c\x21\xb5\x69\x81\x0f\xa0\x64\x63\x50\xd9\x18\x6c\x1e\x74\xfb\x60\x57\xf8\x10\x46\x3d\x3b\x6c\x4a\x40\x85\x29\x05\xec\x6e\x1e\x56\xac\xd8\x27\x8d\x61\x9f\x99\xbf\x48\x74\x41\xb4\xad\x47\x9a\x16\x50\x85\x11\x9d\x89\x2b\x97\x9d\x1d\x36\x14\xda\x30\xe3\x2e\xd7\xfe\xb2\xe2\x43\xa7\x9f\xc2\x54\xaa\xcc\x0b\xc2\x28\x7e\x86\x36\xbd\x1a\xe5\xb9\xa0\xf3\x3d\xd0\xe9\x36\x0c\x02\x9a\x63\x2a\x37\x14\x6e\x6c\xbd\xee\x90\x98\x63\x5e\x68\x83\x0a\xd6\xc8\x4b\xb3\x26\x54\x6b\x59\x08\xe3\xa1\x78\x7f\x71\x1b\x06\x89\x93\x05\xb1\xdf\xd4\x0f\xff\x5c\x64\xbb\x84\x42\x7b\x15\xe8\x27\xf4\xa8\xbf\x12\x0e\xbd\xf8\xf9\x7e\xdc\x8d\x17\x06\xb1\x3b\xb2\xbf\x14\xaf\x3f\x70\x91\x95\xa8\x42\x2f\x62\xdd\x7b\x14\xed\x11\xa9\x87\x69\x78\x41\xe8\xbd\x2f\x50\x6d\x5c\x95\x78\x33\xd4\x02\xe8\x95\xd3\x6e\xa0\x72\xf1\xbd\x99\x6e\xc3\xd3\x24\x79\xea\xda\xd1\x45\x69\x8d\x5b\x03\x7d\xc1\x78\x09\xb3\xfe\xc2\xc3\x1b\x28\x35\x5d\x8f\x15\x5d\x6f\xf6\x98\xb3\x5b\xee\x19\xf3\xf3\xf5\x66\x83\xeb\x47\xe6\x7d\xbc\x3c\x32\x57\xf2\x01\x4a\x3a\x19\x41\xdc\x96\x62\x7a\x0c\x81\x0e\x52\xaf\x47\xf3\x7b\x91\xfa\xc9\xc9\x70\x65\x30\x63\x70\xa3\x50\x6b\x38\xbf\x9d\xcf\x7e\x39\x07\x23\xed\x47\x1c\xa8\xf2\x99\x8d\x4c\xab\x0d\x55\xa3\xc0\x07\x8b\x8f\x1a\x05\xe8\x10\x19\x3e\x84\x24\xee\x8a\x71\x57\x0c\xd4\xa3\x70\xee\x7b\x5f\xa8\x06\x9a\x47\x90\xa4\x2e\x4f\xee\x43\xad\x36\xdf\xd5\xe3\xdb\xc9\x24\x49\xe0\x22\x5d\x4b\x58\x3b\x12\xba\x8b\xa6\x2b\x89\x30\x75\x9c\x3d\x97\x82\x26\xf9\x88\x0c\x4b\xe5\x6d\xba\x2b\x10\xa4\x8c\x86\xfd\x90\x10\x66\x0b\xc3\x4d\xa3\xaf\x3f\xc6\x50\xf1\xfa\xb3\x36\xaa\x10\xf9\x9d\xfb\xdb\xc5\x11\x68\xbb\x2b\x98\x42\x70\xfd\x31\x88\x27\x00\x00\x6d\x34\x69\xff\x19\x00\x08\xfd\xe5\x9d\xde\x11\x00\x00")
 
 func templatesAppEchoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -92,12 +152,12 @@ func templatesAppEchoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/app/echo.tpl", size: 667, mode: os.FileMode(420), modTime: time.Unix(1546197284, 0)}
+	info := bindataFileInfo{name: "templates/app/echo.tpl", size: 4574, mode: os.FileMode(420), modTime: time.Unix(1792421927, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesAppGinTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x91\x41\x6b\xeb\x30\x10\x84\xef\xfa\x15\x83\x4e\xf6\x7b\x79\x56\x78\x47\x43\x0e\xc5\x2d\x09\x6d\x49\x42\x9a\x3f\xa0\xda\x1b\x5b\xd4\x59\x19\x69\xdd\x14\x82\xff\x7b\xb1\x9d\xd0\x53\x6f\xcb\x8e\xbe\x61\x34\xdb\xd9\xf2\xc3\xd6\x84\xb3\x75\xac\x94\x3b\x77\x3e\x08\x12\x05\x00\xba\x76\xd2\xf4\xef\x59\xe9\xcf\xa6\x76\xfc\xaf\xf6\xec\xca\x71\xd2\x2a\x55\xea\xd3\x06\xd8\xaa\x0a\x58\x41\x5f\xaf\xc8\x36\x3e\x0a\x86\x21\x1f\xe7\xfd\x68\x32\x0c\x5a\xa9\x53\xcf\xe5\xe4\x9d\xa4\xb8\x4e\xae\xc6\xa0\x08\x64\x85\xc0\x74\x41\xf0\xbd\x50\x98\x84\x80\x7c\x85\xda\x71\xf6\x48\x27\xdb\xb7\x92\xa4\xea\x0e\x1c\xa8\x76\x51\x28\xa0\x21\xdb\x4a\x03\xe2\xaa\xf3\x8e\x65\xe6\xb2\xf5\xd3\x31\xd1\x66\xd6\xf4\xe2\xf6\xe8\x87\xde\xfa\x0b\xda\x91\x67\xc7\x35\x3c\xe7\x68\x44\xba\xdc\x98\x5f\x62\xdf\xb9\x87\xae\x6b\x5d\x69\xc5\x79\x46\x14\x1b\x84\xaa\x0c\xfb\x40\x31\xa2\x38\x1e\x5e\xff\x16\x10\x8f\xd8\xf4\x82\xca\x5f\x38\xbb\x85\x39\xf4\x9c\x8c\xbd\xa4\x6a\x50\xca\x18\xac\x1d\xa3\xb1\x5c\xb5\x14\xe6\x36\xe6\x78\x49\x89\x3f\xe3\x67\x0b\xcf\x42\x5f\x72\x6f\xa7\xcc\x9e\xdf\x76\xdb\xe4\xff\x72\xb9\x98\xba\xd8\xcc\xeb\xe9\x1c\x51\xac\xf4\x51\xe7\xd0\xbb\x17\xbd\x98\xf6\x43\xaa\x86\xef\x00\x00\x00\xff\xff\x04\x8c\x36\xa6\xc2\x01\x00\x00")
+var _templatesAppGinTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x4d\x6f\xdb\x38\x13\xbe\xfb\x57\xcc\xeb\x43\x21\xf5\x75\xa8\xb4\x47\x2f\xb2\x40\xe0\xa6\x09\x76\x9d\x0f\x38\xe9\xee\xa1\x28\x0a\x5a\x1a\xcb\x44\x25\x52\x25\x29\xa7\x85\xe1\xff\xbe\x18\x92\x92\xbf\xa4\x24\x6d\xb1\x8b\xe4\x20\x73\x38\x33\x0f\x1f\x3e\x33\x24\x2b\x9e\x7e\xe1\x39\x42\xc9\x85\x1c\x0c\x44\x59\x29\x6d\x21\x1a\x00\x00\x0c\x0b\x95\x0f\x07\xeb\xf5\x09\x88\x05\x28\x0d\xec\x5a\xe4\x9a\x5b\xa1\xa4\x01\x76\x6f\x95\x46\x60\x13\x25\x17\x22\x07\x36\x55\x79\x2e\x64\x0e\x9b\x8d\x77\x55\xc6\x7b\xa2\xcc\x68\xcc\x0f\xe6\xc2\x2e\xeb\x39\x4b\x55\x99\xe4\x42\x9e\xe4\x4a\x8a\x94\xbe\x7e\x34\x09\xbb\x46\xab\x45\x6a\x80\x3d\x68\x9e\x86\xb4\xeb\x35\xc1\x6c\xe6\x36\x38\xd6\x6b\x60\xd7\x2a\xab\x0b\x84\xcd\x26\x49\x9d\x71\x0f\x59\xc8\x7c\xb4\x80\x7d\xc7\xc2\x5b\x3b\x3d\x1b\x30\xdd\x9e\xa5\xb7\x76\x7b\x6e\x97\xda\xed\x6c\xbe\x16\x9d\x8e\x9e\xfc\x1e\x1f\xb2\x75\x7a\xed\x90\xd5\xe1\x67\xbd\xf5\xc8\x33\x7c\xc6\x4d\x18\xa9\xec\x2e\xcb\x83\x15\xd7\xc0\xb3\x4c\xc3\x99\x47\x72\xa5\x8c\x85\xcd\x66\x4c\xdf\x77\x24\xa6\xcd\xa6\xdd\x5f\x76\x9e\x95\x42\x86\x51\xef\x1a\xf8\x39\xef\x89\xb0\xeb\xd0\x07\x6d\xb0\xa8\x65\xea\x04\x1c\xc5\xb0\x6e\x73\xed\x2b\x21\x49\x60\xaa\x78\x06\x76\x89\x60\xd0\x5a\x21\x73\x03\x0b\xad\x4a\x37\x92\xe1\x82\xd7\x85\x35\x23\xf0\x12\x81\x85\x28\x70\x04\x28\x57\x42\x2b\x59\xa2\xb4\x23\xe0\x32\x83\x45\xc1\x73\xe3\x58\x4f\x17\xf9\x08\x50\x6b\x18\x9f\x05\x1f\x46\xf1\x23\x65\xd8\xb9\xce\xcd\xc7\x37\xe3\x4f\xb1\x9b\x28\x16\x6e\xda\xff\xce\x40\x8a\x02\xd6\x6e\x8c\xfe\x0b\x95\xb3\xf7\xdc\xf2\x22\x42\xad\xfd\xd4\x50\x27\x49\x02\x77\x5a\x48\xbb\x87\x75\x04\x1a\x33\x9e\xd2\x37\x18\x4c\x35\x12\x58\x64\x39\x03\x96\xf0\xaa\x82\x93\x93\x8a\x7c\x4e\x3c\x96\x26\x73\xba\xc8\x99\x8b\x15\xc8\xd8\xa6\x0f\xb0\xc6\x67\xdb\x39\x84\xfd\xde\x66\xaa\xb6\xf1\x6f\xdd\x98\x7b\x70\xd3\xff\xa6\xfd\xd2\x68\x6b\x2d\x0f\x16\x74\x5e\x55\xc5\x77\xb7\x20\x0f\xb0\xd6\x98\xb5\x6b\x73\x73\xcd\xb2\xb6\x99\x7a\x94\x0f\xa2\x44\x55\x5b\xf0\xc0\xee\xf7\x47\x9f\x28\x1d\xf3\xb5\x60\x1f\x66\xd3\xe0\xf7\x8e\x5b\x3e\xe7\x06\x3f\xcc\xa6\xcf\x16\x90\xab\x18\x76\xc7\xed\xb2\x49\x4a\x03\xf4\xbb\x47\x70\x1d\x3d\xe3\x58\x74\xeb\x75\x33\x3d\x50\x30\x55\x39\x70\x03\x7f\xdc\xdf\xde\x50\xa3\xb3\xf8\xcd\x02\xb7\x87\x9c\x14\xb8\xc2\xa2\x23\xdc\xfe\x9e\x85\x7e\xc4\xee\xd1\xd6\x55\x44\x44\x4d\x55\xfe\x5e\xe9\x92\xdb\x11\x84\x9f\x53\x8a\x74\xb8\x93\x14\x18\x0b\x83\xcf\x85\x54\x86\x5d\xa2\x45\xb9\x8a\x5c\x49\xde\xf0\x92\x5c\x3e\x4f\x6f\x2f\x3f\xbf\xbf\x9d\x5d\x9f\x3f\x0c\xe3\x11\x3c\x31\x69\x7a\xf1\xd7\xc5\x74\x18\x77\xa6\xdf\xb2\xd2\x5b\x07\xcf\x36\xcb\x30\xaa\x74\xcb\xd1\xfe\x6e\xec\x66\x49\x12\x78\x27\x4c\xc5\x6d\xba\x84\xb2\x89\x02\xa6\x9e\xa7\xaa\x2c\xb9\xcc\xf6\x0b\xc9\xcf\x40\xa8\xab\xfe\x5d\x28\x50\x3a\xd2\xa9\xd2\x63\xf8\x1d\x4e\xe1\xd5\x2b\x68\x06\x3e\x9e\x7e\x82\xb3\x33\x18\x86\x40\xc3\x9d\xfa\xd9\xb2\x4d\x6a\xf5\xc7\x29\xb6\x91\x5c\xcf\x78\x7e\xbf\x28\x79\xe8\x32\x94\xfb\x0d\xe5\x6e\xbb\xce\x0f\xa6\x6e\xfc\xde\x8e\x3f\xbd\x60\xab\x7e\xbe\xfc\x6f\x2b\x94\x4e\xe9\x59\xa8\xcb\x11\xa4\x85\x32\x54\x3c\xc2\x82\x92\x6d\xf5\x0f\x8e\xb1\x92\x6f\x74\x88\xee\x09\x38\x7e\xd3\x95\x9c\x14\xca\x60\x44\x44\xbb\xaf\x78\x77\x45\x47\x7d\xe0\x09\x3d\x1d\x29\xaf\x4f\x5b\xae\x8f\xf4\xea\xca\x5b\xe7\x3c\xfd\x52\x57\x8e\x06\x36\xe7\x5f\x7e\x51\x62\x2e\x66\xcf\x2e\x93\x89\x4d\xbc\xc2\xff\x05\x89\xbd\x38\xf5\x7f\x2e\x31\x87\xec\xa5\xfa\xa2\xb9\xbf\xaa\x30\x17\xa3\x5f\x63\x7b\x77\xd4\x17\xa9\x6c\x57\x96\xbb\x04\x25\x09\x50\x30\x74\xcb\xd4\xf8\xb5\x46\xe3\x6e\x01\xdf\xe8\xd2\x4e\x29\x68\xdc\x54\x5c\x9a\xdd\x13\x65\xee\xcf\xdd\xdb\x87\x8b\xe9\xe7\xd7\xb0\xe2\x5a\xf0\x79\x81\xfe\x16\xb3\x28\x6a\xb3\x6c\xef\x31\xe1\x0a\x18\x8e\x80\x9f\xb8\xbe\x90\x41\xc9\xe6\xcc\x8e\x5c\xf4\x2e\x4e\x5e\x7a\xcf\xdf\xa3\xee\x80\x89\x89\x46\x6a\xd3\x12\x1f\x41\xab\xda\xa2\x6e\x82\xb7\xee\x61\xae\x5b\x5a\x2e\x24\xbb\xc1\xc7\x28\x3e\x92\x7b\x6b\x7e\xe7\xef\x80\x51\x1f\xe0\x27\x5f\x1f\x0d\x2a\x47\x1d\x50\x03\x50\x12\x4a\x91\x65\x05\x3e\x72\x8d\xce\xac\xd9\x07\x83\x51\x1f\x4c\xfa\x23\x94\x33\x4c\xd5\x0a\xf5\xf7\x28\x1e\xb5\xe3\x61\xaf\x09\x01\x6a\x32\x1c\x23\x6c\x51\xed\x84\xd3\x98\x2a\x9d\x05\x43\x8f\xdb\xce\x1a\x1a\x37\x92\x01\xce\x82\xbc\x0e\xdc\xc8\xbe\x47\x50\xb3\xf0\x19\xe6\xc2\x58\xd4\xb0\x44\x5e\xd8\x25\xf1\x57\x29\x21\x6d\x58\xf9\xe5\xc5\x43\x34\x4c\xbc\x6d\x38\x0a\x93\xda\x37\x05\x97\xd9\x76\x01\x91\x7b\x61\xb4\x17\xff\xb8\x33\x4b\x78\x36\x74\xa7\x09\xc6\xe1\xc8\xed\xeb\xdf\x9a\x57\x57\x51\x18\x63\x57\x5c\x66\x05\x91\x18\xef\x2f\xa3\xe1\x63\xf7\xc1\xd1\xa6\xbd\x47\xbd\xf2\x55\x17\xc2\x50\x4b\xa1\x9f\x9c\x66\x03\x95\x5f\xe8\xf5\x4b\x6b\xab\x71\x92\x3c\xf5\x8c\x69\xe0\xb9\xe0\x2e\x40\x5b\x80\xc1\xc2\x5c\xbe\xe8\xf0\x45\x4b\x4d\x3c\x90\x44\xcf\xa5\x28\xa6\xee\xe0\x1b\x77\x70\xa4\xe1\xb6\x50\x7e\xbd\x7e\x1d\xb8\xf6\x0a\xde\xb9\xed\x37\xea\x11\x0a\xda\x78\x49\x22\x52\x72\xdc\xc7\x40\x43\x69\xf0\xa3\xf7\x80\x48\xc3\x4d\xcc\x72\x6d\x31\x63\x70\xa7\xd1\x18\x98\x3c\xcc\xa6\xff\x9f\x80\x55\xee\x52\x00\xd4\x49\x98\x43\x66\xf4\x8a\x6a\x55\xe2\xa3\xe3\x47\x77\x12\x74\xc8\x0c\xdf\xa5\x64\x04\x7a\x8f\x15\x3a\x01\x28\x54\x64\xf4\xea\x87\xfa\xff\x66\x30\x48\x12\xb8\x14\x12\x96\x5e\x4f\xfe\x0d\xea\x65\x1d\xa5\xf0\x9a\x84\x37\x51\x92\x2e\xf9\x71\x08\x96\x32\xba\xfa\x47\x6f\x4f\x4f\xbd\x2e\xaf\xb6\x39\x86\xc6\x72\x5b\x9b\xe1\x18\x86\xb7\x7f\x0e\x47\x03\x00\x80\x4d\x3c\xd8\xfc\x33\x00\x23\x64\x15\xb1\x9d\x11\x00\x00")
 
 func templatesAppGinTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -112,12 +172,12 @@ func templatesAppGinTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/app/gin.tpl", size: 450, mode: os.FileMode(420), modTime: time.Unix(1531309893, 0)}
+	info := bindataFileInfo{name: "templates/app/gin.tpl", size: 4509, mode: os.FileMode(420), modTime: time.Unix(1792421927, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesAppGrpcTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x90\x41\x8b\xdb\x30\x10\x85\xef\xfa\x15\x53\x1d\x8a\x4d\xb3\xd2\xdd\x90\x43\x09\x94\x52\x96\x65\xd9\xf6\x90\x5b\x51\xbc\x13\x45\x54\xab\x11\xa3\x71\x7c\x30\xfe\xef\x45\x72\xd2\xe6\xe4\x67\xcd\x7b\x9f\x46\xcf\x5a\x4f\x83\xc7\x84\xec\x04\x21\x33\x09\x8d\xdb\xc7\x72\x1e\x4d\x53\xf0\xf4\xe4\xe9\x37\x4d\xb2\xcf\x71\xf2\x21\x95\xbd\xe7\x3c\x0e\x46\x65\x37\xfe\x71\x1e\xe1\xc3\x85\xa4\x54\xf8\xc8\xc4\x02\x9d\x02\x00\xd0\x91\xbc\xde\x54\x42\xd1\x6a\x93\x9e\xc8\x47\x34\x9e\xa2\x4b\xde\x10\x7b\x5b\x49\x5a\xf5\x4a\x9d\xa7\x34\x36\x50\xd7\xc3\xd2\xdc\xd6\xc2\x81\xb1\xae\x95\x70\x86\x82\x7c\x45\x6e\x83\xc2\x57\x18\xf6\x50\xa3\xe6\x05\xe7\x9f\x6d\xd2\xf5\xea\x9e\x7a\x43\x1f\x8a\x20\x6f\xef\x38\x4d\xe7\x16\x0e\x23\xc2\x1c\xe4\xf2\x48\xb2\x16\xf2\xc9\xdc\xfd\xc7\xe3\xf1\xc6\x2a\x7c\xdd\xc1\xe7\x7c\x32\xdb\xff\xb2\xfe\x87\xbf\xd0\x0c\xb1\xda\x53\x48\x1e\x28\x0d\x70\x11\xc9\x83\xb5\xcb\x02\xe6\x3b\x15\x81\x75\x1d\xaa\x7e\xad\x65\xac\x6b\xcb\xc5\x50\x76\x80\xcc\x75\xed\x84\x62\x9e\x1b\xa0\xd3\x32\x66\xbd\x6b\x27\x3f\x28\xa4\x9a\xae\xa9\x4e\x3f\xb0\xf4\x0e\xf4\x03\x4e\xf7\x7d\x23\x86\x73\xe3\x7d\xda\x43\x0a\xf1\x56\x58\xbb\x89\xbc\xf9\xe6\xc4\xc5\x0e\x99\x37\xeb\xfa\x6f\xf7\xaf\x39\xc7\x30\x3a\x09\x94\xa0\x88\x63\xc1\x77\x03\xaf\x8c\xa5\xc0\xe1\xd7\xdb\xf3\x97\x03\x08\x41\xb9\x4c\x02\xef\x34\x27\x73\x2f\x7b\x6b\xa1\x8b\xa1\xf4\x6a\x55\x7f\x03\x00\x00\xff\xff\x2c\x01\xdc\x89\x32\x02\x00\x00")
+var _templatesAppGrpcTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\xdd\x6f\xdb\x36\x10\x7f\xf7\x5f\x71\xf3\x43\x21\x6d\x0e\xd5\xee\xd1\x83\x07\x04\xe9\xc7\x50\x38\x1f\x48\xd2\xa1\x40\x51\x04\xb4\x74\xa6\x89\xd2\xa4\x4a\x52\x4e\x07\xc1\xff\xfb\x70\xa4\xa4\x58\x8e\x9c\xa4\x09\x36\xc0\x80\x25\xde\xf7\xdd\xef\x8e\xa7\x2c\x13\x66\x2a\x50\xa3\xe5\x1e\xa1\xb4\xc6\x9b\x3c\xfe\x65\xb6\xcc\x59\x78\x82\xa3\x23\x61\x6e\x4c\xe5\x67\xa5\xaa\x84\xd4\x6e\x26\x6c\x99\x4f\xd9\xa8\xe4\xf9\x37\x2e\x10\xd6\x5c\xea\xd1\x48\xae\x4b\x63\x3d\x24\x23\x00\x80\xb1\x32\x62\x3c\xaa\xeb\x23\x90\x4b\xd0\xc6\x03\x3b\x31\x7a\x29\x05\x6c\xb7\x91\xae\xd1\x47\x3a\xea\x82\x0e\x1b\x56\x63\x81\x9d\x4a\x61\xb9\x97\x46\x3b\x60\x57\xde\x58\xec\x84\xd9\xdc\x08\x21\xf5\x9d\x16\xe3\x7a\x4a\xe2\xa1\x30\x46\x28\x64\xc2\x28\xae\x05\x33\x56\x64\xe4\xef\xf8\x27\x4d\xb0\x53\xf4\x56\xe6\x0e\xd8\xb5\xe5\x79\x63\xb4\xae\x29\x9e\xfd\x58\xea\x1a\xd8\xa9\x29\x2a\x85\xb0\xdd\x66\x79\x20\x0e\x05\x77\xcf\xfd\xbe\xa0\x8a\xd4\x41\xc9\xd6\x99\x61\xc9\x75\xa4\x0e\x4b\xde\x85\x3a\x2c\xec\xbe\xab\x41\xc1\x98\xfa\x03\x32\x44\x1b\x94\xda\x49\xd6\x80\x9c\x8f\xd4\x7b\x92\xcd\x63\x3a\x1a\x2d\x2b\x9d\x07\x40\x25\x29\xd4\x9d\xd6\x7e\xc2\xb3\x0c\xe6\x86\x17\xe0\x57\x08\x0e\xbd\x97\x5a\x38\x58\x5a\xb3\x0e\x27\x05\x2e\x79\xa5\xbc\x9b\x40\xac\x04\x2c\xa5\xc2\x09\xa0\xde\x48\x6b\xf4\x1a\xb5\x9f\x00\xd7\x05\x2c\x15\x17\x2e\x04\x97\x2f\xc5\x04\xd0\x5a\x98\xce\x1a\x19\x46\xfa\x13\xe3\xd8\xb1\x15\xee\xcb\x9b\xe9\xd7\x34\x30\xca\x65\x60\xfb\x65\x06\x5a\x2a\xa8\xc3\x19\xfd\x94\x11\xec\x3d\xf7\x5c\x25\x68\x6d\x64\x6d\xc0\x98\x65\x70\x61\xa5\xf6\x3d\x5f\x27\x60\xb1\xe0\x39\x3d\x83\xc3\xdc\x22\x39\x8b\x4c\x30\x60\x19\x2f\x4b\x38\x3a\x2a\x49\xe6\x28\xfa\xd2\x5a\xce\x97\x82\x05\x5d\x4d\x32\xee\xcc\x37\x6e\x4d\x67\x77\x3c\xe4\xfb\x95\x2f\x4c\xe5\xd3\x3f\x86\x7d\x3e\xe0\x37\xfd\xb6\xdd\x93\x45\x5f\x59\xbd\x17\xd0\x71\x59\xaa\x7f\x42\x40\xd1\xc1\xca\x62\xd1\xc5\x16\x78\xdd\xaa\xf2\x85\xb9\xd5\xd7\x72\x8d\xa6\xf2\x10\x1d\xbb\xea\x9f\x3e\x80\x50\xf7\x5d\xb1\x4f\x97\xf3\x46\xee\x2d\xf7\x7c\xc1\x1d\x7e\xba\x9c\x3f\x8a\xd3\x00\x4c\x76\xc1\xfd\xaa\x35\x4a\x07\xf4\x7e\x00\x72\x03\xad\x79\x1f\x74\x75\xdd\xb2\x37\x29\x98\x1b\x01\xdc\xc1\xc7\xab\xf3\x33\x9a\x27\x1e\x7f\x78\xe0\x7e\x3f\x27\x0a\x37\xa8\x06\xd4\xf5\x6b\xd6\xb4\x3d\xbb\x42\x5f\x95\x09\x25\x6a\x6e\xc4\x7b\x63\xd7\xdc\x4f\xa0\x79\x9d\x93\xa6\xfd\x4a\x92\x62\x54\x0e\x1f\x53\x69\x1c\xfb\x80\x1e\xf5\x26\x09\xdd\x78\xc6\xd7\x24\x72\x33\x3f\xff\x70\xf3\xfe\xfc\xf2\xf4\xf8\x7a\x9c\x4e\xe0\x01\xa6\xf9\xbb\xbf\xdf\xcd\xc7\xe9\xa0\xf9\xbb\xac\x1c\xec\x83\x47\x67\x52\x73\x6a\x6c\x97\xa3\x7e\x35\x76\xad\x64\x19\xbc\x95\xae\xe4\x3e\x5f\xc1\xba\xd5\x02\xae\x5a\xe4\x66\xbd\xe6\xba\xe8\x37\x52\xe4\x40\xa8\xca\xc3\x55\x50\xa8\x43\xd2\xa9\xd3\x53\xf8\x13\x5e\xc3\xab\x57\xd0\x1e\x7c\x79\xfd\x15\x66\x33\x18\x37\x8a\xc6\x3b\xfd\x73\x97\x6d\x42\x6b\xbc\xb3\xb0\xd3\x14\x66\xc6\xe3\xf5\x22\xe3\xcd\x94\x21\xdb\x6f\xc8\x76\x37\x75\x7e\xd2\x74\x2b\xf7\xfb\xf4\xeb\x13\x4a\xf5\xfc\xf6\x3f\x2f\x51\x07\xa4\x17\x4d\x5f\x4e\x20\x57\xc6\x51\xf3\x48\x0f\x46\x77\xdd\x3f\xba\xef\x2b\xc9\x26\xfb\xde\x3d\xe0\x4e\x2c\xba\xd1\x27\xca\x38\x4c\x28\xd1\xe1\x29\xdd\x8d\xe8\xde\x1c\x78\x00\x4f\xf7\x90\x77\x08\x5b\x61\x8e\x1c\xc4\x55\xa4\x2e\x78\xfe\xad\x2a\x43\x1a\xd8\x82\x7f\x7b\x21\xc4\x82\xce\x03\x55\x26\x12\x3b\x89\x08\xff\x0f\x20\xf6\x64\xd3\xff\x3b\xc4\x82\x67\x4f\xc5\x17\xf1\xbe\x14\x61\x41\xc7\x61\x8c\xf5\x56\xc1\x27\xa1\x6c\x17\x96\xbb\x09\xca\x32\x20\x65\x18\xc2\xb4\xf8\xbd\x42\x17\xb6\x80\x1f\xb4\x44\x93\x09\x3a\x77\x25\xd7\x6e\xf7\x46\x59\xc4\x7b\xf7\xfc\xfa\xdd\xfc\xe6\x57\xd8\x70\x2b\xf9\x42\x61\xdc\x62\x96\xaa\x72\xab\x6e\x8f\x69\x36\xad\xe6\x0a\x78\xc6\xfa\x42\x04\xa3\xdb\x3b\x3b\x09\xda\xd3\xe7\x6f\xec\x7b\x5b\x74\x3f\x13\x27\x16\x69\x4c\x6b\xbc\x05\x87\x76\x83\x36\x10\x9c\xdd\x10\xfa\x69\x79\x67\x67\x78\x7b\x15\x28\x49\x64\x38\x2f\x43\x13\x27\x29\x63\x2c\xed\x70\x73\x89\x42\x3a\x8f\x36\x7e\xc1\x2c\xaa\x65\x50\x27\x73\x84\x5b\xe9\x57\xbb\xba\xb3\x0c\xca\x05\x6b\xf9\x3f\x7f\xfe\xdc\x6a\xb7\x9b\x09\xbc\x2a\x17\x2c\xbe\xd7\xdb\x74\xd4\x06\xca\x8e\x8b\xb5\xd4\x17\xf4\x8d\xd3\x7e\x6a\x64\x19\x04\xbe\x50\x94\x66\x09\x27\x84\xd2\x2b\x27\x6e\xa0\x6a\x36\xa3\x63\xe5\x7d\x39\xcd\x32\xba\x82\xff\x32\x8e\x94\x4c\xeb\xba\xaf\xb5\x5d\xe4\x43\x3c\x41\x41\x57\xcf\x86\x12\xfd\x4a\xf6\xbf\x43\x68\x26\x34\x9f\x08\xc7\x45\x61\x93\x94\x52\x1c\xe7\x80\x46\xcf\x3e\x1a\xa9\xc9\x26\x39\x9f\x8c\x77\x3c\x18\x4f\x60\xbc\xef\xc4\x38\xed\xea\xf3\x72\xd8\x84\x20\xba\xcd\xaf\x87\x9f\x36\x83\x67\xe6\x16\x14\x95\x4d\x13\xec\x8d\x9e\x1e\xca\x54\xe3\x5f\x2b\x47\x6b\xa8\xcc\x9b\x05\xc0\x73\xeb\xb1\x60\x70\x61\xd1\x39\x38\xb9\xbe\x9c\xff\x76\x02\xde\x84\xbb\x08\x08\xc0\x6c\x37\x14\x9a\x16\x21\x8f\xa1\xdc\x43\xc9\x7c\x56\x16\x07\x12\xf8\x33\x83\x68\x3b\xfa\x77\x00\xd7\x33\x09\x91\x8e\x0f\x00\x00")
 
 func templatesAppGrpcTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -132,12 +192,12 @@ func templatesAppGrpcTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/app/grpc.tpl", size: 562, mode: os.FileMode(420), modTime: time.Unix(1546197262, 0)}
+	info := bindataFileInfo{name: "templates/app/grpc.tpl", size: 3982, mode: os.FileMode(420), modTime: time.Unix(1792421927, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesAppIrisTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x51\xcb\x8e\xa3\x30\x10\xbc\xfb\x2b\x4a\x3e\x19\x6d\x84\xef\x48\x7b\x88\x38\x6c\xf6\x95\x44\xd9\xfd\x81\x1e\xec\x01\x2b\xc4\xb6\xec\x66\x88\x14\xf1\xef\x23\x20\xcc\x9c\xe6\x66\x55\x75\x55\x97\xab\x23\x35\x57\x6a\x2d\x6e\xe4\xbc\x10\xee\x16\x43\x62\x28\x01\x00\xb2\x75\xdc\x0d\x2f\x65\x13\x6e\xfa\x4a\x4c\x89\xb2\x76\xc9\x65\x29\x0a\x21\xde\x28\x81\x8c\x49\xf8\x8e\x19\x2b\xf7\xc6\x24\x25\x1f\x0f\x94\x87\x90\x19\xd3\x54\xcd\xef\xf3\xec\x36\x4d\xb2\x10\xe2\x75\xf0\xcd\xb2\x45\x15\x78\x2c\xfe\x5a\xa3\x4e\x96\xd8\xc2\xdb\x11\x29\x0c\x6c\xd3\x42\x50\x8c\xa8\x9e\xbe\x47\x3b\xaa\x42\x6c\xf3\x17\xdb\xba\xcc\x36\xa1\xb3\xd4\x73\x07\xeb\x4d\x0c\xce\xf3\x26\x2b\x7f\x58\x56\x52\xaf\xac\xdc\x3d\xc7\x3e\xf5\xc7\x30\xa2\x9f\x1d\xbc\xf3\x2d\x82\xaf\xd0\x31\xc7\x4a\xeb\x2f\x82\x6f\xba\x7d\x8c\xbd\x6b\x88\x5d\xf0\xc8\x4c\x89\xad\x29\x71\x4e\x36\x67\xd4\xff\x2f\x7f\xbe\xd5\xe0\x80\xdc\x0d\x0c\x13\x46\x5f\x7e\xc4\xb9\x0c\x5e\xcd\x2d\x15\x62\x12\x42\x6b\xfc\x4c\x2e\xe3\x40\xde\xf4\x36\xad\x8d\xac\x09\x55\xc3\xf7\xf5\xbf\x75\xf0\x6c\xef\xbc\x75\xd4\xf0\xbd\xfc\xf5\xef\x74\x54\x0b\xf9\x97\xe2\x0a\x2f\xe7\xc9\x4c\x3c\x64\x59\x41\x9e\x7e\xcb\xdd\x82\x4f\xf3\xa2\xf7\x00\x00\x00\xff\xff\xa7\x03\xfb\x72\xd3\x01\x00\x00")
+var _templatesAppIrisTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x4b\x8f\xdb\x38\x12\xbe\xfb\x57\xd4\xfa\x10\x48\xbb\x6e\x2a\xd9\xa3\x17\x5e\xa0\xc7\x49\x27\x33\xe3\x7e\xa0\xdd\x99\x4b\x10\x04\xb4\x54\x96\x89\x96\x48\x85\xa4\xdc\x3d\x30\xfc\xdf\x07\x45\x52\xb2\x6c\xcb\xfd\x48\x30\x03\xf8\x20\xb3\x58\x55\x1f\xbf\x7a\xb0\x58\xf1\xf4\x9e\xe7\x08\x25\x17\x72\x30\x10\x65\xa5\xb4\x85\x68\x00\x00\x30\x2c\x54\x3e\x1c\x6c\x36\x67\x20\x96\xa0\x34\xb0\x4b\x91\x6b\x6e\x85\x92\x06\xd8\xdc\x2a\x8d\xc0\xa6\x4a\x2e\x45\x0e\x6c\xa6\xf2\x5c\xc8\x1c\xb6\x5b\xaf\xaa\x8c\xd7\x44\x99\xd1\x9a\x5f\xcc\x85\x5d\xd5\x0b\x96\xaa\x32\xb9\xe7\x96\x6b\x6e\x12\xa1\x85\x79\xad\x0f\x76\x89\x56\x8b\xd4\x00\xbb\xd3\x3c\x0d\x5e\x37\x1b\xb2\xd0\xec\x6d\x60\x6c\x36\xc0\x2e\x55\x56\x17\x08\xdb\x6d\x92\x3a\xe1\x1e\xb0\xe0\xf9\x08\xff\xbe\x62\xe1\xa5\xbd\x9a\x0d\x98\x7e\xcd\xd2\x4b\xfb\x35\x77\x47\xed\x57\x36\xdf\x8b\x5e\x45\xcf\xcb\x09\x1d\x92\xf5\x6a\x75\xc8\xea\xd1\xb3\x5e\x7a\xa4\x19\x3e\xe3\xc6\x8c\x54\xb6\xcb\xf2\x60\xcd\x35\xf0\x2c\xd3\x30\xf1\x48\x3e\x29\x63\x61\xbb\x1d\xd3\xf7\x0d\xe5\xd2\x76\xdb\xc6\x97\x9d\x67\xa5\x90\x61\xd5\xab\x06\x7e\xce\x4f\x58\xe8\x2a\x9c\x82\x36\x58\xd6\x32\x75\xf9\x1b\xc5\xb0\x69\x7d\xed\x67\x42\x92\xc0\x4c\xf1\x0c\xec\x0a\xc1\xa0\xb5\x42\xe6\x06\x96\x5a\x95\x6e\x25\xc3\x25\xaf\x0b\x6b\x46\xe0\x53\x04\x96\xa2\xc0\x11\xa0\x5c\x0b\xad\x64\x89\xd2\x8e\x80\xcb\x0c\x96\x05\xcf\x8d\x63\x3d\x5d\xe6\x23\x40\xad\x61\x3c\x09\x3a\x8c\xec\x47\xca\xb0\x73\x9d\x9b\x2f\xef\xc6\x5f\x63\xb7\x51\x2c\xdd\xb6\x7f\x4d\x40\x8a\x02\x36\x6e\x8d\x7e\x85\xca\xd9\x05\xb7\xbc\x88\x50\x6b\xbf\x35\x94\x49\x92\xc0\x8d\x16\xd2\xee\x61\x1d\x81\xc6\x8c\xa7\xf4\x0d\x06\x53\x8d\x04\x16\x59\xce\x80\x25\xbc\xaa\xe0\xec\xac\x22\x9d\x33\x8f\xa5\xf1\x9c\x2e\x73\xe6\x6c\x05\x32\x76\xee\x03\xac\xf1\x64\xb7\x87\xb0\xcf\x6d\xa6\x6a\x1b\xff\xaf\x1f\xf3\x09\xdc\xf4\xdb\xb6\x5f\x1a\x6d\xad\xe5\xc1\x81\xce\xab\xaa\xf8\xd3\x1d\xc8\x03\xac\x35\x66\xed\xd9\xdc\x5e\xb3\xaa\x6d\xa6\x1e\xe4\x9d\x28\x51\xd5\x16\x3c\xb0\xf9\xfe\xea\x13\xa5\x63\xbe\x17\xec\xf3\xed\x2c\xe8\xbd\xe7\x96\x2f\xb8\xc1\xcf\xb7\xb3\x67\x0b\xc8\x55\x0c\xbb\xe1\x76\xd5\x38\xa5\x05\xfa\x7f\x22\xe1\x7a\x7a\xc6\x71\xd2\x6d\x36\xcd\xf6\x40\xc1\x4c\xe5\xc0\x0d\xfc\x36\xbf\xbe\xa2\x66\x6a\xf1\xd1\x02\xb7\x87\x9c\x14\xb8\xc6\xa2\xc7\xdc\x7e\xcc\x42\x3f\x62\x73\xb4\x75\x15\x11\x51\x33\x95\x5f\x28\x5d\x72\x3b\x82\xf0\x77\x46\x96\x0e\x23\x49\x86\xb1\x30\xf8\x9c\x49\x65\xd8\x47\xb4\x28\xd7\x91\x2b\xc9\x2b\x5e\x92\xca\xb7\xd9\xf5\xc7\x6f\x17\xd7\xb7\x97\xe7\x77\xc3\x78\x04\x4f\x6c\x9a\x7d\xf8\xe3\xc3\x6c\x18\xf7\xba\xdf\xb1\x72\xb2\x0e\x9e\x6d\x96\x61\x55\xe9\x96\xa3\xfd\x68\x74\xbd\x24\x09\xbc\x17\xa6\xe2\x36\x5d\x41\xd9\x58\x01\x53\x2f\x52\x55\x96\x5c\x66\xfb\x85\xe4\x77\x20\xd4\xd5\xe9\x28\x14\x28\x1d\xe9\x54\xe9\x31\xfc\x1f\xde\xc2\x9b\x37\xd0\x2c\x7c\x79\xfb\x15\x26\x13\x18\x06\x43\xc3\x4e\xfd\xec\xd8\xa6\x6c\xf5\x37\x1d\xb6\x96\x5c\xcf\x78\x3e\x5e\xe4\x3c\x74\x19\xf2\xfd\x8e\x7c\xb7\x5d\xe7\x95\xae\x1b\xbd\xff\x8e\xbf\xbe\x20\x54\x3f\x5e\xfe\xd7\x15\x4a\x97\xe9\x59\xa8\xcb\x11\xa4\x85\x32\x54\x3c\xc2\x82\x92\x6d\xf5\x0f\x8e\xb1\x92\x6e\x74\x88\xee\x09\x38\x3e\xe8\x4a\x4e\x0b\x65\x30\x22\xa2\xdd\x57\xdc\x3d\xd1\x51\x1f\x78\x22\x9f\x8e\x32\xef\x54\x6e\xb9\x3e\x72\x32\xaf\xbc\x74\xc1\xd3\xfb\xba\x72\x34\xb0\x05\xbf\xff\xc9\x14\x73\x36\x4f\x44\x99\x44\x6c\xea\x33\xfc\x6f\x48\xb1\x17\xbb\xfe\xc7\x53\xcc\x21\x7b\x69\x7e\xd1\xde\x9f\xcd\x30\x67\xe3\x74\x8e\xed\xcd\xa8\x2f\xca\xb2\x6e\x5a\x76\x09\x4a\x12\x20\x63\xe8\x8e\xa9\xf1\x7b\x8d\xc6\x4d\x01\x8f\x34\xb3\x93\x0b\x5a\x37\x15\x97\xa6\x7b\xa3\x2c\xfc\xbd\x7b\x7d\xf7\x61\xf6\xed\xdf\xb0\xe6\x5a\xf0\x45\x81\x7e\x8a\x59\x16\xb5\x59\xb5\x73\x4c\x18\x01\xc3\x15\xf0\x03\xe3\x0b\x09\x94\x6c\xee\xec\xc8\x59\xef\xe3\xe4\xa5\x73\xfe\x1e\x75\x07\x4c\x4c\x35\x52\x9b\x96\xf8\x00\x5a\xd5\x16\xb5\xc3\x40\x1d\x7c\x3c\x01\x7a\x55\xb0\x2b\x7c\x88\xda\xb1\x55\xe9\x8e\xdd\xbe\xf7\x43\x63\xd7\x1d\x1e\xa8\x84\x95\x84\x52\x64\x59\x81\x0f\x5c\x63\x63\xa7\x35\x12\x90\xf0\xaa\x62\x9f\x0d\x46\x21\x1c\x24\x45\x1d\xbf\xe0\x9d\xb0\x53\x4c\x95\xce\x82\xb0\x57\xb1\x03\x72\x4f\x91\x4e\x3d\xa7\x68\x1f\x69\x85\xcf\xe6\x48\xb7\x98\x0b\x63\x51\xc3\x0a\x79\x61\x57\x44\x64\xa5\x84\xb4\x2d\x8e\x8f\x68\xa3\x61\xe2\xa5\xc3\x51\xd8\xd6\x52\xc7\x65\xb6\x43\x1f\xb9\xf9\xbf\x1d\xcb\xe3\x5e\x3f\x61\xa8\x3f\xe5\x28\x88\x87\x23\x1f\xa7\x0b\xad\xca\xb9\xcd\xa2\xb0\xcc\x3e\x71\x99\x15\xa8\xa3\x38\x8e\xfb\x4e\xf3\x4b\x2d\x0a\x3f\xc7\xfb\xb8\xc3\x52\x69\x30\xa8\xd7\x42\xe6\x07\xe5\x4d\x2e\xdd\xf6\xd7\x55\x77\xcb\x7c\xf7\xf5\xd1\xfa\x9f\xa3\x5e\xfb\x12\x0c\x80\xa9\xbf\xd0\x5f\x4e\xbb\x81\x6a\x31\x34\xfe\x95\xb5\xd5\x38\x49\x9e\x7a\xd3\x34\x5c\x38\xe3\xce\x40\x5b\x8d\x41\xc2\x9c\xbf\xe8\xf0\x79\x4b\x1d\x3d\xc4\x84\xde\x4e\x51\x4c\xad\xc2\x77\xf1\xa0\x48\xcb\x6d\xd5\xfc\x7c\x31\x3b\x70\xed\x3c\xde\x1b\x99\x2b\xf5\x00\x05\x65\x9a\xa4\x74\x55\x72\x7c\x8a\x81\x86\xd2\xa0\x47\x8f\x03\x91\x86\xb1\xcc\x72\x6d\x31\x63\x70\xa3\xd1\x18\x98\xde\xdd\xce\xfe\x33\x05\xab\xdc\x84\x00\xd4\x56\x98\x43\x66\xf4\x9a\x22\x2c\xf1\xc1\xf1\xa3\x7b\x09\x3a\x64\x86\x77\x29\x19\xc1\x66\x73\x50\x5f\xd4\x00\xf1\x36\x34\xd6\x88\x57\x55\x57\xb7\xaa\xfa\xd9\xa4\x6b\x84\x20\x44\x46\xaf\x5f\x95\x66\xdb\xc1\x20\x49\xe0\x57\x2d\x0c\x84\x94\xf7\x2f\x59\x5f\x7e\x51\x6a\x1f\x7d\x7d\x4c\x95\xa4\xc7\x42\x1c\xec\xa5\xf6\x91\xd1\x23\x22\x72\xc2\x4b\x5e\xed\xdc\x0c\x8d\xe5\xb6\x36\xc3\x31\x0c\xaf\x7f\x1f\x8e\x06\x00\x00\xdb\x78\xb0\x1d\xfc\x35\x00\xb7\xc8\xdb\x47\xe5\x11\x00\x00")
 
 func templatesAppIrisTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -152,12 +212,12 @@ func templatesAppIrisTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/app/iris.tpl", size: 467, mode: os.FileMode(420), modTime: time.Unix(1546197247, 0)}
+	info := bindataFileInfo{name: "templates/app/iris.tpl", size: 4581, mode: os.FileMode(420), modTime: time.Unix(1792421927, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesAppOzzoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x51\xb1\x6e\xdb\x30\x10\xdd\xf9\x15\x07\x4e\x52\xeb\x90\xbb\x81\x0c\x81\x87\x06\xad\x61\x1b\x71\x8a\x0e\x45\x07\x56\xba\x50\x44\x25\x9e\x70\x3c\xc5\xad\x0d\xfd\x7b\x41\x49\x8e\xa7\xa2\xd1\x20\x51\xbc\xf7\xee\xee\xbd\xd7\xbb\xea\x97\xf3\x08\x9d\x0b\x51\xa9\xd0\xf5\xc4\x02\x85\x02\x00\xd0\x2d\x79\x3d\x9f\x22\x8a\x6d\x44\x7a\xad\xe6\x7f\x1f\xa4\x19\x7e\x9a\x8a\x3a\xeb\xe9\x8e\xce\x67\xb2\xf9\x75\xc7\x34\x48\x88\x57\xd6\x7f\x50\xd6\x55\x15\xa6\xf4\x4e\x70\x45\x51\x30\x8a\x56\xa5\x52\xaf\x8e\xc1\xd5\x35\xc3\x3d\xe8\xcb\x05\xcc\x23\x25\x81\x71\x5c\xe7\xf3\x21\x0b\x18\x47\xad\xd4\xcb\x10\xab\x49\x57\x51\xc2\x65\x1a\x62\x2d\x6c\x18\x9d\x20\x44\x3c\x41\xee\x8c\x3c\x15\x18\xd6\xf7\xb0\x4c\x32\x3b\x3c\x15\xa5\xba\x12\x8e\x28\x43\x0f\x15\x75\x1d\x45\xe8\x42\x5d\xb7\x78\x72\x8c\x33\xcd\x7c\x4d\x38\x9b\x95\x9f\x59\x8f\xd9\x92\xf7\xc8\x45\x4b\xde\x1c\x38\x44\x79\x29\x57\x6f\x90\x45\x85\x79\xfe\xd3\xe3\x0e\x3d\x49\x70\x42\x5c\x5c\xaf\x3f\x1f\xf7\xbb\x05\x7d\xdb\xe0\x09\x7d\x48\x82\x0c\x0d\xba\x56\x1a\xc0\x58\xf7\x14\xa2\x2c\x2b\x7c\x42\x29\xb4\x9d\x6b\x7a\xb5\x80\x6e\xec\x1d\x9d\xa0\xcd\xfc\x18\xa2\x07\x8a\x6b\xc8\x41\xae\xad\xfd\x87\x71\x57\xde\x43\xdf\xb7\xa1\x72\x12\x28\x42\x12\xc7\x82\xb5\x81\x03\x63\x4a\xb0\x79\x7e\xda\x7e\xdc\x80\x10\xa4\x66\x10\xa8\xe9\x14\xcd\x44\xcb\x9d\xcd\xa3\x8b\x75\x8b\x85\xb6\x7a\x05\x5c\xde\xee\xb7\xd3\x12\x0f\xb1\x3e\x22\xbf\x62\x91\xf3\x5b\x41\x0c\x6d\xa9\x46\xa5\xac\x85\xfd\xf9\x4c\xd0\x4c\x64\x9e\xb3\x9b\xa5\x14\x15\x7c\xb8\x46\xb3\xc9\x3e\xfd\x96\x12\x90\x99\x78\x49\x95\x51\x06\x8e\x50\x99\x6f\x1c\x04\x8b\xce\xf5\xdf\x93\x70\x88\xfe\xc7\xfc\xb9\xbc\xb9\xaf\x93\x38\x19\x92\x5e\x83\xde\x7f\xd1\xb3\xcf\x63\x9e\xff\x37\x00\x00\xff\xff\xf3\xe7\xa8\x4a\x06\x03\x00\x00")
+var _templatesAppOzzoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x5d\x6f\xdb\xb8\x12\x7d\xf7\xaf\x98\xeb\x87\x42\xea\x55\xa8\xf6\x3e\xfa\x22\x0b\x04\xe9\x17\x76\x9d\x38\x88\xdd\xdd\x87\x22\x28\x68\x69\x2c\x0b\x95\x48\x95\xa4\x9c\x36\x86\xfe\xfb\x62\x48\x4a\xb6\x6c\x39\x49\x5b\xec\x02\x46\x22\x91\x9c\x99\xc3\xc3\x33\x23\x4e\xc5\x93\x2f\x3c\x43\x28\x79\x2e\x46\xa3\xbc\xac\xa4\x32\x10\x8c\x00\x00\xc6\x85\xcc\xc6\xa3\xed\xf6\x0c\xf2\x15\x48\x05\xec\x2a\xcf\x14\x37\xb9\x14\x1a\xd8\xdc\x48\x85\xc0\x2e\xa5\x58\xe5\x19\xb0\xa9\xcc\xb2\x5c\x64\xd0\x34\xce\x54\x6a\x67\x89\x22\xa5\x31\x37\x98\xe5\x66\x5d\x2f\x59\x22\xcb\x38\x93\x67\xf2\xe1\x41\xc6\xf4\xe7\x4c\xc9\xda\xe4\x62\x17\x4b\x48\x73\xec\xf1\x09\xe3\x98\x27\x09\xea\x7e\xd4\x67\xd9\x25\x52\x18\x14\xe6\x47\x37\xca\xae\xd0\xa8\x3c\xd1\xc0\x16\x8a\x27\x1e\xe8\x76\x4b\xf0\xdb\xb5\x2d\x82\xed\x16\xd8\x95\x4c\xeb\x02\xa1\x69\xe2\xc4\x4e\xf6\x70\xfa\xc8\x47\x5b\xee\x1b\x16\x6e\x76\xd0\xb2\x05\x33\x6c\x59\xba\xd9\x61\xcb\xdd\x56\x87\x8d\xf5\xd7\x62\xd0\xd0\x09\xe0\x84\x0d\xcd\x0d\x5a\xed\x91\x35\x60\x67\xdc\xec\x91\xa5\x7f\x0c\x5b\x37\x56\x20\x3b\x96\x47\x1b\xae\x80\xa7\xa9\x82\x73\x87\xe4\x83\xd4\x06\x9a\x66\x42\xcf\x37\x24\xe8\xa6\xe9\xce\x97\x5d\xa4\x65\x2e\xfc\xa8\x33\xf5\xfc\x5c\x9c\xf0\xb0\x6f\x70\x0a\xda\x68\x55\x8b\xc4\x26\x51\x10\xc2\xb6\x8b\xd5\x57\x42\x1c\xc3\x54\xf2\x14\xcc\x1a\x41\xa3\x21\xf9\x69\x58\x29\x59\xda\x91\x14\x57\xbc\x2e\x8c\x8e\xc0\x49\x04\x56\x79\x81\x11\xa0\xd8\xe4\x4a\x8a\x12\x85\x89\x80\x8b\x14\x56\x05\xcf\xb4\x65\x3d\x59\x65\x11\xa0\x52\x30\x39\xf7\x36\x8c\xfc\x07\x52\xb3\x0b\x95\xe9\x4f\xaf\x27\x77\xa1\x5d\x98\xaf\xec\xb2\xff\x9c\x83\xc8\x0b\xd8\xda\x31\xfa\x15\x32\x63\xef\xb8\xe1\x45\x80\x4a\xb9\xa5\x3e\x57\xe3\x18\x6e\x54\x2e\x4c\x0f\x6b\x04\x0a\x53\x9e\xd0\x33\x68\x4c\x14\x12\x58\x64\x19\x03\x16\xf3\xaa\x82\xb3\xb3\x8a\x6c\xce\x1c\x96\x36\x72\xb2\xca\x98\xf5\xe5\xc9\xd8\x85\xf7\xb0\x26\xe7\xbb\x35\x84\x7d\x6e\x52\x59\x9b\xf0\xff\xc3\x98\x4f\xe0\xa6\x5f\xd3\x3d\x29\x34\xb5\x12\x07\x1b\xba\xa8\xaa\xe2\xbb\xdd\x90\x03\x58\x2b\x4c\xbb\xbd\xd9\xb5\x7a\x5d\x9b\x54\xde\x8b\x45\x5e\xa2\xac\x0d\x38\x60\xf3\xfe\xe8\x23\xa9\xa3\xbf\x16\xec\xe3\xed\xd4\xdb\xbd\xe1\x86\x2f\xb9\xc6\x8f\xb7\xd3\x27\x13\xc8\x66\x0c\xbb\xe1\x66\xdd\x06\xa5\x01\x7a\x3f\x21\xb8\x81\x9a\x71\x2c\xba\xed\xb6\x5d\xee\x29\x98\xca\x0c\xb8\x86\xdf\xe7\xb3\x6b\x2a\x74\x06\xbf\x19\xe0\xe6\x90\x93\x02\x37\x58\x0c\xb8\xeb\x9f\x99\xaf\x47\x6c\x8e\xa6\xae\x02\x22\x6a\x2a\xb3\x77\x52\x95\xdc\x44\xe0\x5f\xa7\xe4\xe9\xf0\x24\xc9\x31\x16\x1a\x9f\x72\x29\x35\x7b\x8f\x06\xc5\x26\xb0\x29\x79\xcd\x4b\x32\xf9\x3c\x9d\xbd\xff\xfc\x6e\x76\x7b\x75\xb1\x18\x87\x11\x3c\xb2\x68\xfa\xf6\xcf\xb7\xd3\x71\x38\x18\x7e\xc7\xca\xc9\x3c\x78\xb2\x58\xfa\x51\xa9\x3a\x8e\xfa\xa7\xb1\x1f\x25\x8e\xe1\x4d\xae\x2b\x6e\x92\x35\x94\xad\x17\xd0\xf5\x32\x91\x65\xc9\x45\xda\x4f\x24\xb7\x02\xa1\xae\x4e\x9f\x42\x81\xc2\x92\x4e\x99\x1e\xc2\x6f\xf0\x0a\x5e\xbc\x80\x76\xe0\xd3\xab\x3b\x38\x3f\x87\xb1\x77\x34\xde\xcb\x9f\x1d\xdb\xa4\x56\xf7\x49\xc7\xce\x93\xad\x19\x4f\x9f\x17\x05\xf7\x55\x86\x62\xbf\xa6\xd8\x5d\xd5\xf9\xc1\xd0\xad\xdd\xff\x26\x77\xcf\x38\xaa\x9f\x4f\xff\x59\x85\xc2\x2a\x3d\xf5\x79\x19\x41\x52\x48\x4d\xc9\x93\x1b\x90\xa2\xcb\xfe\xd1\x31\x56\xb2\x0d\x0e\xd1\x3d\x02\xc7\x1d\xba\x14\x97\x85\xd4\x18\x10\xd1\xf6\x29\xdc\xdf\xd1\x51\x1d\x78\x44\x4f\x47\xca\x3b\xa5\x2d\x5b\x47\x4e\xea\xca\xcd\x2e\x79\xf2\xa5\xae\x2c\x0d\x6c\xc9\xbf\xfc\xa2\xc4\xac\xcf\x13\xa7\x4c\x53\xec\xd2\x29\xfc\x1f\x90\xd8\xb3\x43\xff\xeb\x12\xb3\xc8\x9e\xab\x2f\x5a\xfb\xab\x0a\xb3\x3e\x4e\x6b\xac\x77\x47\x7d\x96\xca\xf6\x65\xb9\x4f\x50\x1c\x03\x39\x43\xbb\x4d\x85\x5f\x6b\xd4\xf6\x16\xf0\x8d\x1a\x07\x0a\x41\xe3\xba\xe2\x42\xef\x7f\x51\x96\xee\xbb\x3b\x5b\xbc\x9d\x7e\x7e\x09\x1b\xae\x72\xbe\x2c\xd0\xdd\x62\x56\x45\xad\xd7\xdd\x3d\xc6\x5f\x01\xfd\x27\xe0\x27\xae\x2f\x34\x21\x45\xfb\xcd\x0e\xac\xf7\x21\x4e\x9e\x7b\xcf\xef\x51\x77\xc0\xc4\xa5\x42\x2a\xd3\x02\xef\x81\xda\x10\x54\x16\x83\xdd\x87\x6f\x2f\xd8\x35\xde\x07\x61\x27\x10\xbb\x2b\xa0\xdc\x94\x02\xca\x3c\x4d\x0b\xbc\xe7\x0a\xed\xb4\x62\x1f\x35\x06\xdd\xe6\x7c\x2f\xd1\xe2\x68\x1a\x4f\x36\x0d\xa0\xa2\x43\x71\xf9\xe2\xfa\x1e\x8b\x17\x55\x40\x94\xd8\xcb\xd6\x2a\xec\xd0\x46\xed\x96\xbb\x9e\xc5\xef\x80\x7e\x0a\x13\xa9\x52\x3f\x11\x3d\xa1\x9d\xce\x88\x36\x3b\xa7\x43\xee\x59\xb4\xd3\xbe\xa5\x62\x8b\xef\x15\x5e\x63\x26\x4d\xce\x8d\x54\x41\x3b\x4c\xb7\x8f\x30\xb2\x08\x76\xcc\xdc\x62\x96\x6b\x83\x0a\xd6\xc8\x0b\xb3\x26\xe8\x95\xcc\x85\xf1\xd4\xbc\x47\x13\x8c\x63\x37\x37\x8e\xfc\xa2\xae\x1f\xe0\x22\xdd\x6d\x2e\xb0\xdd\x41\x77\x69\x0f\xbb\xfe\x73\x3f\x8a\xbf\xf2\x0f\x87\xf1\x93\xe3\xa8\x3b\xc5\x0f\x8b\xc5\xcd\x07\x2e\xd2\x02\x55\xe0\x67\x59\xfb\x1e\x86\x3d\x79\x75\xbc\xed\xb7\x0d\x1d\x80\x39\xaa\x8d\xcb\x1d\xef\x86\x0a\x03\xbd\x72\x5a\x0d\x94\x44\xbe\x62\xaf\x8d\xa9\x26\x71\xfc\x58\x33\xd2\x02\xb5\xce\xad\x83\x2e\x8d\xfc\x0c\xb3\xf1\x82\xc3\xbe\x94\x4a\xb1\xa7\x8b\x9a\x9e\x20\xdc\xc9\xc9\x1b\xd2\x70\x27\xa0\x5f\xcf\x42\x0b\xae\xbb\x48\xf7\xf9\xf2\xcc\x5c\xcb\x7b\x28\xe8\x70\x04\xc9\x5d\x8a\xc9\x29\x06\x5a\x4a\xbd\x1d\xdd\xea\xf3\xc4\xdf\xa7\x0c\x57\x06\x53\x06\x37\x0a\xb5\x86\xcb\xc5\xed\xf4\xbf\x97\x60\xa4\xfd\xb4\x03\xd5\x03\x66\x91\x69\xb5\xa1\x1c\x15\x78\x6f\xf9\x51\x83\x04\x1d\x32\xc3\xf7\x29\x89\xda\xfc\xdc\x65\x07\x55\x2e\xbc\xf5\x15\x31\x50\x7b\x96\x27\x98\xa4\xda\x4f\xe1\x03\xad\x36\x3f\x54\xf9\x9b\xd1\x28\x8e\x61\xf6\xf0\x20\x61\xed\x44\xe8\xda\x4f\x97\x15\x41\x02\x2f\x5b\xdd\x5e\x52\xca\x7d\x33\x21\x39\x97\xca\xfb\x75\xcd\x11\x24\xec\x2f\x95\x1b\x0c\x4a\x5e\x7d\xd2\x46\xe5\x22\xbb\x73\xff\x76\xd1\xc7\xda\x70\x53\xeb\xf1\x04\xc6\xb3\x3f\xc6\xd1\x08\x00\xa0\x09\x47\xcd\xe8\xef\x01\x00\x08\x6d\x46\x9c\x36\x12\x00\x00")
 
 func templatesAppOzzoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -172,107 +232,1307 @@ func templatesAppOzzoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/app/ozzo.tpl", size: 774, mode: os.FileMode(420), modTime: time.Unix(1531309893, 0)}
+	info := bindataFileInfo{name: "templates/app/ozzo.tpl", size: 4662, mode: os.FileMode(420), modTime: time.Unix(1792421927, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesConfigConfigTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\xdd\x6f\xdb\xc8\x11\x7f\x26\xff\x8a\x39\x02\x09\xc8\x84\xa2\x2e\x38\xa4\x0f\xce\xa9\x68\x2e\x71\xae\xd7\x73\x3e\x90\xd8\x57\x14\x41\xe0\xac\xc9\x21\xb5\x35\xb9\xab\xdb\x5d\x4a\x36\x14\xff\xef\xc5\xec\x07\x45\x4a\x72\x72\xd7\xa2\x2f\x36\xb9\xdc\x9d\xef\xf9\xcd\xec\x68\xc5\xca\x6b\xd6\x20\x94\x52\xd4\xbc\x89\x63\xde\xad\xa4\x32\x90\xc6\x51\x52\xb7\xac\x49\xe8\x7f\x67\xe8\x1f\x97\x49\xbc\xdd\xce\x80\xd7\x50\x9c\xc9\xa6\xe1\xa2\x81\xbb\xbb\x38\x4a\x5a\xd9\xcc\x75\x2b\x1b\xf7\x19\x45\xe5\x96\x05\xda\x63\x02\xcd\xbc\x57\x2d\x3d\x4a\x4d\x7f\x57\xcc\x2c\xe7\x35\x6f\x91\x1e\x68\x41\x61\xdd\x62\x69\x37\x6b\xa3\x4a\x29\xd6\xfe\x91\x8b\xc6\x9e\x30\xbc\xc3\x24\x8e\xa3\xa4\xe1\x66\xd9\x5f\x15\xa5\xec\xe6\x3f\xf5\x4a\x98\x0f\xbd\x5e\xf2\xb9\x91\x9d\x25\xdf\xc8\xd5\x75\x53\x70\x31\xbf\x65\x5d\x5b\xac\x7f\x48\xe2\x2c\x8e\xe7\x73\x78\xa7\xb0\xe6\x37\xa0\x0d\x53\x46\x83\x59\x22\x08\xd6\xa1\x06\x59\xdb\x17\x14\x6b\xae\xa4\xe8\x50\x18\x58\x33\xc5\xd9\x55\x8b\x1a\x14\xb2\x0a\xae\x6e\xe1\x4c\xb2\x2a\x2e\xa5\xd0\x26\xd0\x59\x40\xb2\xdd\x42\xf1\x86\x75\x08\x77\x77\x97\x89\xe5\xf1\xc2\x9a\x0f\x96\xb2\xad\x1c\x0b\x8d\xc6\x90\xfc\x81\x0b\x5b\xad\x5a\x5e\x32\xc3\xa5\x88\xcd\xed\x0a\xc3\x09\x6d\x54\x5f\x1a\xd8\xc6\xd1\xdf\xa5\x36\xe0\xb4\x86\xcf\xa4\xc2\x49\xb2\x94\xda\x24\x60\xe4\xf0\xfc\x39\x8e\xde\x91\x7b\xb8\x30\x00\x10\xb6\x91\xc7\xc2\x36\xfb\xfc\x79\x70\xd4\xf3\xaa\xe3\xc2\x1e\x21\x9f\xcc\xe7\xf0\x1a\x8d\xe2\xa5\xb6\x4b\xdc\x89\xca\x68\x0f\xd0\x41\xd0\xa8\xd6\xe4\x57\x5a\xee\xdc\xce\x38\x9a\x1c\x11\x26\x70\xf5\xdf\x2f\xc7\xdc\x27\x6b\x9f\x27\xf1\x30\x9f\xc3\x87\x65\x6f\x2a\xb9\x11\xe7\xbc\x43\xd9\x1b\xb8\x92\xbd\xa8\x34\x54\x8a\x71\x11\xb8\x72\x31\xab\x5b\xde\x2c\x0d\x28\xfc\xbd\x47\x6d\x34\x48\x01\xda\x9f\x8c\xa3\x7d\x1a\x14\x1b\xc5\xcb\x5e\x59\xcb\x06\xd1\xc2\xf6\x4b\xfa\x2a\xfb\x41\xbc\x83\xf5\x9d\xa1\x5e\xf3\xc6\xd1\xd0\x36\x7a\x5f\x32\xc3\xae\x98\xc6\x8b\xf7\x67\x7b\x3e\xa9\xfc\x97\x4b\x0a\x6a\x4f\x77\xba\xa6\xb1\x54\x68\x4e\x12\xa3\x7a\x9c\x1a\x21\x30\xfb\x60\xa4\xa2\xe0\x89\x23\xfb\xf4\x8e\x99\xe5\x1e\x17\x4d\xeb\x97\x36\x43\x82\xec\xbb\x95\xa3\x34\xc7\x29\x39\x9f\xc3\x99\x6c\x5e\x49\xd5\xb1\xc1\xcb\xb5\x7b\xf3\xe1\xd8\xca\x46\xe7\x80\xdc\x2c\x51\xc1\xbf\xb5\x14\x20\x15\x18\xbc\x31\x71\xb4\x3b\x39\x15\xa9\x95\xcd\xa5\x23\x12\xd4\x1e\xad\x7c\x0e\x4c\xcf\x70\x8d\x6d\xe0\xd9\x71\xc1\xbb\xbe\x83\xd6\x2e\x8e\x58\x5b\x2e\x6e\xeb\x21\x13\xbb\x7b\xcc\xc3\x2d\x4c\xb4\xb6\xec\xde\x29\x2e\x8c\xcf\x23\xae\x61\xb3\x44\xab\xce\x6c\xb6\xa2\x0f\x33\x07\x69\xb0\x61\x1a\x56\x4c\x6b\xac\xe2\x68\x7c\xe2\x4a\xca\x36\xb0\x9d\x05\x76\x33\xaf\xc9\x73\xd5\xe8\x51\x36\x33\xd5\xf4\x04\x10\x1a\x6a\xd9\xb6\x72\x13\xa2\x95\x20\x92\xec\x58\x34\x05\x30\xd0\xfd\x55\x29\xbb\x8e\x89\x2a\x8e\x2c\x81\x8f\x9f\xa6\xda\x4d\xd8\xdc\x59\xe0\x90\x2b\x17\x74\x15\xea\x52\xf1\x2b\xdc\x43\x8f\x56\xb2\x0a\x2b\xa8\x95\xec\x0e\xc0\x8a\x89\xca\x09\x50\xc0\x29\x2b\x97\x44\xed\x1a\x6f\x3d\xb8\x31\xfb\x29\xb7\x9b\xb8\xd1\xd0\xaf\x56\xa8\xa0\x64\x1a\x81\x5c\x96\xc3\x86\x9b\x25\x54\x4c\x2f\x2d\xd8\xad\x5a\x56\x22\x01\x1e\x51\xe9\x45\x85\x4a\x97\x52\x11\x1d\x51\x8d\x74\x76\x10\x98\x07\x1e\xe2\x28\x78\xc6\x6b\xa6\x06\xbd\x16\xf0\xf1\xd3\x0e\xe3\x7e\xc5\x5b\x00\xef\xf2\x38\xba\xd0\x54\x79\xfc\xdb\xdd\x36\x8e\xb6\x0e\xe5\x72\x48\xf8\x0a\x58\x55\x29\xd4\x1a\x8c\x84\x2b\x2e\xaa\xe4\x2e\xa7\x0d\x16\x59\x72\x48\x5a\x59\xb2\xd6\x81\xd6\x68\xc3\x71\xd8\xdb\x06\x5c\x9a\x85\xd3\x5f\x87\xbc\x40\xc9\xc7\x5a\xb4\x1d\x00\x65\x16\x80\x23\x87\xa4\x42\x56\xb5\x5c\x58\x7b\xfe\x19\x0c\xf3\xe1\xf2\xe4\xe9\xc0\xe7\x08\xfe\x6c\x07\x4c\x99\x11\xce\xe4\x90\x94\x52\x08\x2c\xc9\xa8\xde\x62\x21\x95\xc3\xc6\x3d\xa9\x0f\xb1\x66\xeb\x30\x64\x66\x51\xc5\x9b\xd0\xd2\xf3\x84\xb0\xbb\xc2\x8a\xa2\xcd\x6e\xbb\x87\xdc\x18\x66\xb6\x54\xfa\x67\x1e\x01\x72\x48\xfe\x20\xc4\x78\x4f\xd2\x59\x97\xd9\x39\x24\xf7\x22\x85\xb7\x56\x85\x57\x7d\x93\x03\x17\xb5\xcc\x61\xc3\x94\xc8\x09\xb0\x50\x29\xa9\xf6\x04\x75\x69\xf5\x12\x6b\xd6\xb7\xe4\x00\xd3\x2b\xb1\x97\x54\xbd\xc6\x8a\xd0\x42\x80\x90\x02\x81\xa9\xd0\xfe\xf4\x0a\xab\xb8\xee\x45\x19\xce\xa7\x19\x3c\xf2\x70\xb1\x8d\x23\x47\x0c\x1e\xba\x95\x6d\x1c\xd9\x9a\x7d\xe2\xfa\x01\x7a\x84\xbb\xbb\x24\x8f\x23\x5b\xa3\x4f\x80\x56\x7d\x0c\xde\x17\x99\xe3\xda\xea\x0e\x8c\xbf\x4f\xf4\x8a\xf6\x4b\xdf\x09\x3c\x79\x0a\x8f\x80\x22\xb2\xf8\x80\xa5\x14\xd5\xbd\xd1\x34\x2e\x67\x5e\xdc\x17\x52\x08\x27\xee\x88\xc7\x61\xcc\xec\x0a\x94\x3f\xb7\x2b\x58\xf7\x1d\x1e\x47\xc8\xae\x98\x9c\x40\x42\x65\xc6\x9a\x27\x60\xff\x09\x24\xe4\xd0\x29\x99\x28\xb8\x90\x3a\x2f\xdb\x86\xed\x79\x8f\xb0\x90\x22\x01\xb8\x28\x15\x32\x4d\xbc\xa4\xaa\x50\x51\xe0\xad\x14\x96\x58\xa1\x28\x31\xa7\x53\x44\xa7\x72\xae\xd4\x76\x01\xfe\xf5\xfc\xf5\x19\x85\xce\xf9\xdb\xd7\x67\x40\x5d\xa8\xc5\x32\x42\x3e\x98\x85\x8a\x41\x71\xba\x44\x18\x77\x79\x2f\xde\xbe\x79\xf5\xcb\xcf\x44\xee\x18\xe2\xe5\xfb\xd8\xec\x70\x77\xa8\x10\x16\x6c\xb9\x00\xa6\x1a\xed\xe2\x8b\x94\x4b\xd9\xb8\x46\x64\x90\xfa\x50\xcb\x5d\x5c\x67\x04\x98\xb5\x86\x93\x85\x85\xf2\xe2\x0d\x6e\x5e\xb5\xac\xf9\x80\x26\x0d\xdd\x73\xf1\x13\xd3\x98\x4a\x5d\x50\xb5\xf9\xf8\xfd\xa7\x2c\x77\x5b\x5f\x48\x61\xb8\xe8\xf1\xad\x38\xb5\x94\xe2\x88\x4e\x58\x4a\xba\xf8\x60\xd9\xa5\x04\x28\x35\x6f\x92\x1c\xa4\x2e\x7e\x46\x83\x62\x9d\x3a\x78\x7f\x9c\x38\x6d\x93\x2c\x87\xe4\xd0\x5e\xb2\x9e\xf8\x23\xc9\xe2\x68\x35\x2a\xab\x8e\xc9\x4f\x52\xb6\x69\x32\xae\xc3\x49\x0e\x35\x6b\x35\xe6\xe0\x96\x27\x44\x72\x50\x58\xb1\x92\x9e\x7d\xfb\xa4\x9d\x09\xf1\x86\x1b\xe2\x40\x18\x7b\x99\x83\x24\x1d\x14\x13\x0d\x0e\x05\x86\xd2\x70\xa7\x95\x2c\x7e\xc5\xdb\x1c\x12\x52\xab\xb0\xf5\x25\x8b\xa3\xbb\x38\x8e\x78\x4d\x56\xf5\xd2\xbd\x63\x4a\xa3\x35\x7f\xf6\xcc\x2e\x7f\xb7\x00\xc1\x5b\x32\x78\x48\x72\xc1\x5b\xeb\x07\x77\xba\xa4\x83\x03\x26\x58\x6a\x8f\xac\x49\xbf\x5b\x40\x92\xd8\x73\x3b\x06\x65\x41\x51\x9b\xda\x0d\x87\xf4\x0f\x19\x44\x36\xe8\xbf\xa5\x23\xaf\x61\xcd\xda\x1e\x73\x90\xd7\xb4\x43\xea\xe2\x4c\xca\xeb\x7e\x75\x3a\x38\x0e\x1e\xfb\xd2\xa0\x8b\x73\x79\x41\x05\x3f\x0d\xef\xef\x5d\x85\x7f\xde\xb6\x83\x89\x66\x54\x08\x2f\x93\x2c\xcb\x9e\x11\x49\xe2\x31\xb2\x52\x59\x68\x34\x61\xaf\x65\x7c\x44\x95\x23\xba\x44\x77\x3b\x85\xa8\x0f\x20\x72\x36\x9c\x29\x96\x8b\xdf\xb8\xe6\x26\xa5\x0c\x48\x6b\x78\x64\x63\x95\x62\x3a\x1b\x5b\x70\xe1\x18\x3c\x7c\x08\xb5\x4b\x3f\xb2\xb1\x8f\xa1\xbd\xd5\x49\x7c\x59\x1a\x11\xf1\x0b\xd2\xbb\x9d\x39\xd4\xc5\x6f\xa4\x40\x88\x91\x2c\xf3\x22\x66\x43\x58\x8c\x94\x3a\x50\x89\x34\x29\x8b\x51\xe7\x98\x43\x69\x13\x0e\x16\xf0\x68\x14\xf9\x39\xc5\x3d\xad\xa7\xd9\x50\x2a\x4a\xda\xfb\x1b\x6b\x79\xc5\x0c\xa6\x99\x47\x36\x0a\x0f\xa8\xb0\x94\xd5\x7e\xbb\x27\x6b\x9b\x63\x39\x6c\x96\xbc\x5c\xda\xca\xe4\x0b\xe8\x24\x0f\x09\xca\x2b\x90\x82\xf0\x88\x9a\x3b\xbc\x31\x28\x34\x5d\x2d\xc9\xb4\x90\x96\xa1\x6a\x65\x16\x40\x2d\x5e\xf8\xd0\xc8\x48\x5f\xa9\x48\x55\x6a\x1c\xf2\xe0\x6f\x49\x31\xc2\xaa\x57\xbc\x45\xbb\xfd\x6b\xb6\x19\xcc\xa2\x37\xdc\x94\x4b\x18\xe0\xe8\xf4\xc6\x61\x93\xf5\xa7\xed\x35\x93\x82\x3a\x5f\x8a\xb4\xe2\xb6\x6b\x93\x93\x38\x78\x88\x96\x8b\x0b\xd1\x31\xa5\x97\xac\x4d\x9d\x2c\x65\x36\x1c\x33\x72\xb2\xdd\xc8\x7b\xb6\x7b\x74\x3f\xd9\x49\x57\x77\xa6\xb0\xb0\x57\xa7\x49\x2f\x74\xbf\xa2\x36\x0f\x2b\x5f\xe2\xad\xb0\xf0\x40\x3f\x03\xbc\x59\x61\x69\xb0\x02\x2b\x62\x0e\x24\xa0\xed\x2a\x0a\x62\x96\xe4\xd6\x13\x53\xf4\x38\x34\xc5\x98\x19\x17\x6b\xf2\xf4\x1e\xa3\x13\x78\xb0\xf1\xc4\xac\xb1\x2d\xc5\x70\x5c\xf0\xd6\xc7\x84\x46\x03\x4c\x6b\xde\x4c\x9b\x15\x5f\x9d\xa8\xaf\xa7\xba\x67\xbd\xed\xdb\x3f\xea\xb6\x0e\xfd\x4d\x71\x7f\x3d\xe4\xec\xa1\xd3\xbd\xcb\x88\xe0\xe0\x23\xdb\x74\x93\x05\x4b\xd7\xc5\x2c\x1c\xd2\x84\xcf\x64\x3f\xeb\x0a\x7a\x18\x02\xc6\x8f\x6a\x8a\xe7\x46\xf2\xd4\xee\xcf\xe2\xe8\x88\xa1\xbe\x66\x29\x22\x08\x0f\x7e\x4f\x02\xc2\xb8\xbc\x8c\xca\x82\xfa\x21\x58\xd8\xf6\xfe\x9e\xce\xc9\x89\x36\xe9\xeb\xff\x2f\x22\x7a\x0e\xf7\x8b\x3a\x9e\x8e\x8c\x24\x0e\xdd\x8c\x93\xf3\xe0\xfe\x40\xb2\xfa\xe7\x41\x5c\x7a\x77\xa5\x29\xcc\x33\xfe\x5b\xa1\x03\x3b\xf0\xec\x8e\x0a\xbe\x3f\x49\x59\x84\xdd\xf7\x75\x91\x4e\x95\xc9\xbd\x84\xd4\x28\x8b\xf1\xb0\x24\xc4\xce\xc8\x06\x87\x5d\xa5\x37\xca\xee\x36\xe2\xe8\xec\x7a\xcb\xaf\x50\x19\xb7\x97\x8e\xce\xe8\x12\xe2\xe8\xec\x26\x18\x81\xce\x68\xa7\xbd\x61\x78\x86\xc3\x10\xe2\x08\xbf\xa3\x59\x1a\xa0\x9c\x6e\xcc\x32\x4c\x13\x6b\xae\xb4\x81\xc1\xf6\x2e\x71\x0f\x33\x73\x57\x06\x76\xd9\xc8\x6b\xf0\xc1\xfe\x23\x3c\x81\x2f\x5f\xc2\xdb\x5f\xe1\x2f\x4f\x9f\xfe\xf0\xf4\x5b\x48\x43\xd1\x06\x0f\xaa\x11\x96\x3d\x99\xd9\x83\x49\xee\x29\x59\xb4\x39\x9a\x41\x9e\xf9\x38\x7c\x07\x19\xc6\x8b\x41\x94\x83\x0f\x8b\x45\x90\x76\x1b\xff\x89\x14\x3a\x22\x2d\x48\x5b\xe2\xcc\x92\x09\x8b\x7d\xfe\x96\x3e\x61\x37\x28\xe2\xdd\xe3\xc5\xdf\x0f\xe2\x1f\x17\xf0\xfd\xb7\xac\x76\x98\x1d\xe3\x6a\xc0\x60\x25\x35\x37\x7c\x8d\x50\xf9\x34\x4c\xf2\x43\x4e\x13\xc3\x4e\x13\xc5\x8b\x36\xc9\x8a\xa1\x4d\x3c\x22\xd7\xf8\x12\x0f\xbd\xb2\xd3\x33\x1a\x1c\x70\x85\x55\xb2\xaf\xf7\x41\x32\x05\x43\xec\x72\xe7\x5b\xbc\xec\xf5\x1e\xe8\xfe\xf0\x87\x38\x8d\x12\xce\xf3\xda\xe5\x17\xb5\x66\xf6\x5a\x47\x8d\xd9\xfe\x07\x1a\x27\x26\xdf\x72\x46\x2b\x1b\xf0\x73\x83\x07\xbf\x8f\xdc\x30\x19\x18\xe4\x63\xda\xbe\x24\x53\x73\x69\x73\x19\xe8\xb7\x87\xe2\x8c\x1e\x87\x42\x7d\xb2\x70\x33\xc7\x5d\xcb\x70\x8e\x37\x26\xfd\xf8\xe9\xea\xd6\x60\xba\x4b\x7c\xea\x7d\xf7\x70\xf5\x1b\xb2\x5a\xb2\x53\x51\xbf\x36\x9b\xf0\x92\x5b\xe9\xf6\x0d\x7c\x08\x2f\xcf\xab\x4a\x85\xd9\xe9\x30\xfe\xb2\xcd\x80\x5a\xa3\x82\x96\x6b\xea\xf3\xe0\x58\x9f\x47\x47\xd3\x2c\xf4\x06\xbb\x61\x85\x40\x53\xfc\x43\x72\x41\x85\x9d\x92\x35\x75\x35\x3e\x1f\x8a\xe3\x2f\x46\xb2\xd4\x83\x45\x16\xef\xdc\x3e\x05\x8b\xdd\x2f\x07\xf7\xc9\x18\xb2\x9c\x7a\x56\xfa\x19\x01\xab\xa3\x72\x8e\xa8\xfc\x2f\xe2\x7a\x32\x13\xa9\xbd\x59\xe3\x30\x19\x86\x8d\xe2\x66\xbf\xc3\x36\x12\x36\xc0\xb4\x9d\x03\x8c\x6f\x9d\xa4\x42\xcd\xd1\xfe\x7c\xc3\x9a\x06\x2b\x60\x9a\x28\xb9\xdb\xe8\xa1\x1e\x96\x43\xba\x01\x2e\x8b\x7f\x12\x1b\x35\x42\x75\x47\x15\x2b\xba\xa6\x3d\x2a\xe3\x68\x4d\x0f\xfe\x57\x2e\x77\x15\x79\x5b\xa7\x0f\xc3\xae\xac\x38\x6d\xb1\x4b\xfd\x25\x97\xd3\xde\xef\x9f\x01\x87\x1f\x61\x5d\xbc\xe9\xbb\x57\x24\x54\x9a\x3d\x03\xfe\xf8\xf1\x70\x11\x2c\xce\x6f\x57\x98\x66\x85\xfb\xc8\xb3\xe2\x9c\x35\x74\x89\x4f\x13\x27\x6f\x92\x59\x18\xb0\xbf\x40\x50\x6a\xae\x77\x3b\xc3\x25\x08\xbe\x1b\x70\x22\x1a\x7f\x46\xe3\x77\x38\xf9\xd2\x23\x47\x87\x0b\xd4\xe0\x36\xea\xa0\x69\x40\x71\x2a\xe8\x52\xa3\xd2\x4d\x56\xb8\xc7\x91\x9a\x3e\xc6\xdd\x3b\x74\x4c\x5f\x3b\xd7\xd0\x6c\x7e\x23\x55\x45\xe3\x1b\x06\xa3\x31\xe7\xc5\xfb\xb3\x1c\xae\x11\x57\xe4\x9f\x8b\xf7\x67\x6e\x8c\x22\x7b\x43\x7e\x29\x15\x8d\x79\x0c\x67\x6d\x98\xbf\xdb\x7e\x9b\x50\xcd\x8f\x0e\x88\x43\xf0\xec\x66\x29\xdd\xe4\x82\x89\x5b\x5f\x6d\xc6\x8e\xf5\xaa\xba\x25\x1f\x94\xe3\xe0\xec\x87\x1e\xad\x57\xad\x6b\xd1\xfc\xe6\x6c\xe8\xa8\x43\x37\x3d\x82\x93\x2f\x5f\xa0\x2f\x3e\x94\x4b\xec\xd0\xa3\xf2\x97\x2f\x9e\xaa\xb6\x33\x1a\xc6\x85\x1e\xae\xe8\xe7\xf2\x4c\x6e\xe8\xca\xee\x08\xd3\x9c\xc4\x5b\x26\xc9\x46\xd7\x9b\xe4\xfd\xe9\xcb\xe7\x2f\xce\x4f\x5f\x26\x9e\x61\x5f\x5c\x68\x0c\x3c\x47\x1b\xfb\xe2\xbd\x37\x7d\x1a\xae\x55\x87\xbc\x2d\xaf\x1c\x92\xbf\xdd\xcb\x63\x87\x53\x1a\x4b\x85\x26\xbe\xfb\xcf\x00\x29\xbe\xf7\x2f\x33\x1e\x00\x00")
+
+func templatesConfigConfigTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesConfigConfigTpl,
+		"templates/config/config.tpl",
+	)
+}
+
+func templatesConfigConfigTpl() (*asset, error) {
+	bytes, err := templatesConfigConfigTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/config/config.tpl", size: 7731, mode: os.FileMode(420), modTime: time.Unix(1792421895, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
 var _templatesGitignoreTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\x8c\x41\x6a\xc3\x30\x10\x45\xf7\x73\x8a\x0f\xd9\x99\x54\x39\x43\x4b\xbb\x28\x14\xba\xe9\x01\x22\x5b\x63\x79\x40\xd5\x08\x69\x94\xd4\x84\xf4\xec\x45\x34\x9b\xcf\x83\xff\x78\x07\xbc\x48\xf6\x55\xb8\x61\xd5\x8a\x52\x35\x56\xff\xdd\xe0\x73\x40\x49\x3d\x4a\x6e\x34\x39\xfe\xe1\xff\xfd\xa5\xc9\x85\x94\x68\x72\x4d\x07\xee\x49\x66\xa2\x03\xbe\xb8\x19\xe6\x51\xda\x8f\x98\xbb\xa4\x80\xab\xd8\x86\x73\x54\xd8\xf8\x9e\x96\x33\x4d\x6e\xe0\xd0\x3f\xbb\x95\x6e\xd0\x15\xb6\x31\xa2\x62\xd1\x0b\x57\x1f\x19\xa6\x9a\x8e\x68\x85\x17\x59\x65\xf1\x29\xed\xb8\x6e\x9c\xd1\x1b\x3f\x92\x1f\x62\xfc\xfe\xfa\x46\x93\xd3\x6e\x44\x4e\x02\x7b\x3a\x5d\x38\x07\xad\x74\xba\xdd\xe0\x9e\x4b\xc1\xfd\xfe\x17\x00\x00\xff\xff\x3a\xf0\xfe\x77\xda\x00\x00\x00")
 
-func templatesGitignoreTplBytes() ([]byte, error) {
+func templatesGitignoreTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesGitignoreTpl,
+		"templates/gitignore.tpl",
+	)
+}
+
+func templatesGitignoreTpl() (*asset, error) {
+	bytes, err := templatesGitignoreTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/gitignore.tpl", size: 218, mode: os.FileMode(420), modTime: time.Unix(1531284723, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesLoggingEchoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x41\x8b\xdb\x30\x10\x85\xcf\xd6\xaf\x98\xe6\x24\x81\x51\xee\x85\x3d\x75\xdb\xee\xc2\xa6\x94\x0d\xa5\x67\x45\x9e\xc8\x62\x1d\x29\x19\x8f\x49\x4a\xf0\x7f\x2f\x23\xc5\x25\xcb\xd2\x4b\x08\x33\xdf\x7b\xf3\x3c\xa3\xa3\xf3\x6f\x2e\x20\x1c\x5c\x4c\x4a\xc5\xc3\x31\x13\x83\x56\xcd\x8a\xe3\x01\x57\x4a\x35\xab\x10\xb9\x9f\x76\xd6\xe7\xc3\x7a\x70\xbb\x91\x9d\x7f\x5b\xa3\xef\x73\x69\x5e\xaf\x60\x37\xb9\x9b\x06\x84\x79\x5e\x0f\x39\x84\x98\xc2\x4a\x19\xa5\xd6\x6b\x20\x3c\x4d\x38\xf2\x4b\x0e\x01\x09\x86\x1c\x46\x40\xe7\xfb\xa5\xde\x82\x77\x44\x7f\x62\x0a\xe0\xa4\x2b\x10\xbb\x10\xb0\x83\x73\xe4\x1e\xb8\xc7\x05\x15\xb7\xe7\xc7\x52\x8e\xe9\xbe\x01\x3e\x27\xc6\x0b\xc3\x3e\x53\xa9\xf7\x2e\x75\x03\xd2\xa8\xf6\x53\xf2\x0b\x55\x13\x68\x03\x92\xdb\x6e\x62\xd7\x0d\x78\x76\x84\xdf\x84\xb9\xaa\x86\x90\x27\x4a\x20\x12\x9d\xc4\xad\x70\x4f\xd5\x4a\x20\xf3\xa1\x02\x57\xd5\xbc\xd3\xf9\x8a\x7c\xa9\x79\x0c\x20\x51\xa6\x42\x35\x23\x3b\x62\xf8\xfc\x00\xb2\x53\xfb\x23\x9f\xb5\x91\x32\xe1\x49\x8a\xde\xbe\xd6\x94\xb5\x1a\x3b\x29\xde\x36\xb9\xb4\x9e\x1f\x35\xe1\xc9\x3e\xa1\xeb\x90\xec\x77\x64\xfd\x01\xa8\x3d\x53\x3c\xc4\x72\x3c\xe6\x34\xa2\x36\x37\x91\x36\x76\xfb\x7f\x59\x0b\xb1\x33\xaa\x48\xf9\x72\x3f\xff\x77\xe4\xfe\x1f\x5b\x32\xdc\x3e\x50\x9b\xaa\x11\x89\x38\xdf\xa0\x82\x88\x68\xc1\x3c\x5f\x4c\x75\x2e\x0f\x42\x52\xdd\x9d\xb7\x2e\x69\x87\xfb\x4c\xb8\xcc\x84\xc8\x23\x8c\xec\x78\x1a\x45\x86\x44\x12\x48\xce\xa2\x7d\x99\x17\xf7\xb2\x5c\xf8\xf4\x00\x29\x0e\x75\xc3\x8d\xb7\x5f\xc5\x4a\x23\x51\x61\x66\xf9\x59\x3e\x62\xc9\xe6\xf9\xd2\xca\x93\xb0\x1b\xe4\x3e\x77\xf5\xff\xaf\xd7\x17\xfb\xd3\x71\xdf\xc2\xbb\xad\x6d\x4b\x80\xb6\x9e\x6c\x1b\x93\x47\x5d\xce\x68\x5a\x58\x66\xdc\xae\x9f\xe2\xa0\x9a\x66\x56\xcd\xac\xe6\xbf\x03\x00\x2f\x61\x11\xe2\x4e\x03\x00\x00")
+
+func templatesLoggingEchoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesLoggingEchoTpl,
+		"templates/logging/echo.tpl",
+	)
+}
+
+func templatesLoggingEchoTpl() (*asset, error) {
+	bytes, err := templatesLoggingEchoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/logging/echo.tpl", size: 846, mode: os.FileMode(420), modTime: time.Unix(1792418641, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesLoggingGinTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x41\x6f\x13\x31\x10\x85\xcf\xf6\xaf\x18\x72\xb2\x51\x70\xee\xa0\x9c\x28\xd0\x4a\x69\x85\x5a\xa1\x9e\x8d\x77\xe2\x1d\xb1\xb1\xcb\xec\x2c\x0d\x8a\xf2\xdf\xd1\x78\xd9\x12\x81\xc4\x21\x52\xf6\xbd\xef\x79\xe6\xd9\x4f\x31\x7d\x8b\x19\xe1\x10\xa9\x58\x4b\x87\xa7\xca\x02\xce\x9a\x95\xd0\x01\x57\xd6\x9a\x55\x26\xe9\xa7\xaf\x21\xd5\xc3\x26\x53\x79\x93\x6b\xa1\xa4\xff\x9a\x79\x3a\x41\xb8\xad\xdd\x34\x20\x9c\xcf\x9b\xa1\xe6\x4c\x25\xaf\xac\xb7\x76\xb3\x01\xc6\xef\x13\x8e\xb2\xab\x39\x23\xc3\x50\xf3\x08\x18\x53\xbf\xe8\x6b\x48\x91\xf9\x27\x95\x0c\x51\x5d\x85\x24\xe6\x8c\x1d\x3c\x93\xf4\x20\x3d\x2e\xa8\x9e\x76\x73\xd5\x64\x2a\x97\x06\xa4\x5a\x04\x8f\x02\xfb\xca\x4d\xef\x63\xe9\x06\xe4\xd1\xee\xa7\x92\x16\x6a\xde\xc0\x79\xc8\x54\xc2\xf5\x4c\x7c\x54\xff\x64\x0d\xa3\x4c\x5c\x40\x71\x97\xe0\xb5\x12\xef\xe7\x33\x3d\x9c\xac\x31\xa3\x44\x16\x78\xbb\x05\xbd\x90\x70\x57\x9f\x9d\xb7\xc6\x50\xa7\xd2\xef\xbe\xe1\x7e\x1e\x73\x73\xe5\x52\xf8\x84\x72\x8d\xb1\x43\x76\xff\xb8\xb3\xee\x35\x9f\xc2\xff\xa1\x35\x50\xe7\xad\x82\x72\xbc\x9c\xf4\x48\xd2\xbf\xa0\x2e\x2d\xb1\x65\x65\xe7\xe7\xa0\x31\x2f\x16\x6c\xe1\x0f\xa6\xf1\x05\x4d\x72\x9c\xc1\x3b\xfd\x6a\xc3\x7e\x44\x06\xe4\xf6\xab\xac\x2d\xf7\x30\xc4\xb1\xb5\x4f\xe1\x03\x73\xe5\x31\xec\xe2\x28\xce\xbf\x9b\x8d\x57\x5b\x28\x34\xb4\x7b\x32\x1a\xdc\x36\x59\x51\x6b\xcc\xd9\x1a\xf3\x57\x3d\x97\xe4\xb8\xbe\xd8\xe7\x16\xa5\xaf\xdd\xa5\xf2\xe5\x7e\x17\x3e\x47\xe9\x55\x7b\x64\x12\xe4\xf0\x20\x51\xa6\x51\xab\xb5\x27\x78\xa0\x92\xd0\xb5\x67\xf1\x6b\x40\x66\x6f\xcd\xd9\x9e\x7f\x0d\x00\xa6\xd7\x21\x79\xc9\x02\x00\x00")
+
+func templatesLoggingGinTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesLoggingGinTpl,
+		"templates/logging/gin.tpl",
+	)
+}
+
+func templatesLoggingGinTpl() (*asset, error) {
+	bytes, err := templatesLoggingGinTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/logging/gin.tpl", size: 713, mode: os.FileMode(420), modTime: time.Unix(1792418641, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesLoggingGrpcTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x55\x4d\x8f\xe3\x36\x0c\x3d\x5b\xbf\x82\xf5\xa1\xb0\x17\x86\xb3\xe7\x2c\xa6\x40\x31\xd3\xed\x06\x9d\x6d\x8b\x0e\x06\x7b\x58\xec\x41\xb5\x18\x45\x18\x45\x4a\x29\x39\x99\x20\xc8\x7f\x2f\x28\xd9\x89\x27\xf3\xd1\x16\x3d\xec\x66\x44\x91\xd4\x23\xdf\x23\xbd\x91\xdd\x83\xd4\x08\x6b\x69\x9c\x10\x66\xbd\xf1\x14\xa1\x12\x45\xd9\x79\x17\xf1\x31\x96\xa2\x28\xad\xd7\xb3\x60\xbd\xe6\xbf\xa3\x59\x63\x29\x44\x51\x6a\xef\xb5\xc5\x56\x7b\x2b\x9d\x6e\x3d\xe9\x99\xa6\x4d\x57\xbe\x7a\x33\xeb\xbc\xc2\xf0\xc6\xfd\x1a\xa3\x54\x32\xca\x37\x5c\x42\x94\xb1\x0f\xe9\xf9\xc3\x01\xda\xcf\x5e\xf5\x16\xe1\x78\x9c\x59\xaf\xb5\x71\xba\x14\xb5\x10\xb3\x19\xf4\x4e\xd2\xfe\xd6\x6b\x8d\x04\xd6\xeb\x00\x28\xbb\x55\xb6\x42\x27\xad\x6d\xa0\x93\x44\x7b\xe3\x34\x48\x76\x60\xbf\x28\xb5\x46\x05\x3b\x13\x57\x10\x57\x08\x84\x7f\xf5\x18\x22\xa7\x5b\xdc\x24\xb3\x71\xe9\x82\x13\xc0\xd0\x1d\x58\x7a\x4a\xc6\x80\xb4\x35\x1d\x06\xb1\xec\x5d\x37\x7d\xbf\xea\xe2\xe3\xe8\xdd\x5e\xe7\xdf\x86\x93\x83\x74\xfb\x06\x8c\x5b\x7a\x78\xc7\xc5\xb5\xf7\x1c\x74\x87\xb4\x45\x5a\xb8\xa5\x6f\x60\x25\x9d\xb2\x48\x70\xbe\xfd\x94\x2d\x35\x54\x29\x18\x89\x3c\xd5\x70\x10\x45\x88\x92\x22\xcc\xaf\x80\xe9\x69\x7f\xf5\xbb\xaa\x16\x05\xbf\x7c\x95\x90\xff\x91\x6b\x39\x23\xaa\x45\x41\x18\x36\x0d\x20\x11\x87\x0d\x4f\xf1\x55\x02\x57\x8b\xc2\x7a\x7d\x2d\xad\xcd\x26\x86\xd9\x7e\xec\xad\xfd\x8c\x71\xe5\x55\x8a\x6b\xf2\x63\x77\xc6\x75\x58\x25\x00\x75\x4a\x1b\x7b\x72\x70\xca\x2e\x8e\x89\x91\x10\x09\xe5\xfa\x19\x25\xd9\xcc\x3c\xfc\x4b\x5a\x38\xd7\xc0\xcc\x05\x2d\x39\xd5\x3f\x10\x33\x85\x51\x05\xda\x66\x12\x42\xc8\x3d\xce\xcd\xbf\x4b\x3e\x4f\xa8\xc9\xa6\x57\xb9\xc9\xd7\x27\x72\x12\x2d\x6f\xb2\x32\x7f\x89\x96\x10\x46\x7d\x54\xdc\xc8\x0b\x66\x02\x6d\x1b\xf8\x3e\x69\x55\xe5\xf7\x0e\x53\xb8\x73\x08\xa1\x81\x2e\x3e\xce\xf9\xbf\xe3\xff\xe6\xef\xcc\xdc\xf4\xc9\x34\x36\x06\xc3\x74\x40\x46\xa2\xa6\x13\x32\x70\xe0\x97\x20\x87\x96\x8b\xb8\xdf\xe0\xd3\x5c\x21\x52\xdf\x45\xee\xd3\xb3\xe6\x67\xed\x5e\x4c\x0d\x2b\x29\x91\x58\x05\x78\x37\xcd\x54\xc3\xa9\x6f\x97\x31\x70\x38\x55\x14\xda\x2e\x3e\x0e\x35\x3d\x6b\x3e\x64\xa7\x00\x12\x3a\xbf\xd9\x83\x5f\x72\x1b\xff\xb3\x1c\xfd\x92\x8d\x60\x5c\xe7\xd7\x1c\x36\xae\xb4\x06\x76\x2b\xd3\xad\xc0\x04\xd0\xe8\x90\x64\x64\x49\xaf\xd0\xc1\xda\x84\x60\x9c\xce\x6b\xe3\xb9\x26\x5e\x68\xc3\x8b\x35\x6e\x25\x81\x51\xdc\x6b\x4e\x56\x98\x25\xac\x55\x03\xfe\x81\x67\x7b\x04\xd1\x7e\x24\xbf\x5e\x0c\xd0\x86\x58\x56\x47\xfd\x81\x1d\x0f\xa2\xe0\x30\xa3\x42\x8a\x51\xed\xcf\x18\xab\x61\xad\xb6\x03\xa8\xc5\xcd\x27\x94\x0a\xa9\xfe\x00\x16\x5d\x65\x54\xa8\xe1\x07\x78\xcf\x00\x8a\xc2\x28\xb8\x02\xa3\xc2\xd7\xf7\xdf\x44\x51\x1c\x45\x71\x14\x22\x1b\x9f\x65\xa9\x8c\xaa\x4f\xac\xc7\x9c\x93\x91\x34\x67\xac\xbf\x4b\x43\xe1\xb5\xf7\x1b\x30\x6a\xa2\xd5\xd1\xeb\xcb\xb9\x7d\x8b\x9b\x41\xf7\xaa\x3e\xcb\x98\xc7\x81\x7f\x99\x65\xde\xd7\xa8\xd2\xc7\xe0\x44\xe6\xc8\x71\x26\xbf\x01\x19\x13\x9b\x79\x9c\x2d\x6e\xd1\xf2\x56\x61\xca\x53\x34\xf1\x10\x79\x0a\x20\x9d\x4a\x8e\x3b\x49\xee\xec\x07\x3e\xae\x90\x60\x29\x8d\xed\x69\xdc\x3f\x93\xa1\xbc\xa4\x31\x15\xbf\xf2\x23\x89\x69\x79\xf2\x3f\x4f\x0d\x58\x19\xd1\x75\xfb\xbc\x47\x6e\x7a\x92\xd1\x78\x97\x16\x3f\x7f\x54\x99\xb0\xfc\x65\x6c\xaf\xbd\xc2\x0a\x89\x78\xfe\x13\x10\xbe\xb2\x5e\xb7\xb7\x7c\xe2\xd5\x25\x8a\xb0\x33\xb1\x5b\x41\x8a\xe4\x0c\x32\x60\x3a\x84\xf6\xb7\x5f\xe6\x4f\xce\xf7\xee\xc1\xf9\x9d\x6b\x86\xe3\xc2\x45\x24\x27\xed\x78\xbe\x77\x72\x2b\x8d\x95\x7f\x5a\x1c\x4d\x37\x32\xca\x5b\x1f\xc2\xd9\xc5\xac\x37\x16\xd7\xe8\x22\xaa\xb9\x28\x06\x54\x53\x50\x3f\x71\x89\xa2\x50\xb8\x94\xbd\x8d\x2f\xfb\x7c\x91\xe4\xb2\xa0\x64\x8c\x94\x14\xfa\xf5\x5b\xba\xfe\x31\x46\x62\xf9\xa5\xc3\x5d\x92\x7f\x55\xe6\x46\x96\x63\x47\xeb\xe6\xd2\x81\xc1\x95\x19\xe3\x68\xab\xcf\x5e\x63\x83\xab\x72\xe8\x7b\x79\x62\x80\x9d\x8e\x82\x07\x85\xd9\xf9\xee\x0a\x9c\xb1\x4c\xc3\x80\xeb\x0a\xe4\x66\x83\x4e\x55\xe9\xd8\xc0\x93\x47\x13\x97\x65\xe2\xb5\x4d\x45\x57\x35\x8b\xf8\x98\x56\x75\x92\x39\x0f\xe8\x74\x30\xdb\x5b\xaf\xb9\xc0\x90\xc5\x9c\xfa\xd2\x40\xc9\xa2\x2d\x59\x9e\x91\x42\xdb\xb6\xb5\x38\xfe\x3d\x00\xca\x1e\x91\x63\xc5\x09\x00\x00")
+
+func templatesLoggingGrpcTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesLoggingGrpcTpl,
+		"templates/logging/grpc.tpl",
+	)
+}
+
+func templatesLoggingGrpcTpl() (*asset, error) {
+	bytes, err := templatesLoggingGrpcTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/logging/grpc.tpl", size: 2501, mode: os.FileMode(420), modTime: time.Unix(1792418641, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesLoggingIrisTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\xc1\xca\xdb\x30\x10\x84\xcf\xd6\x53\x2c\x3e\x59\x60\xec\x7b\xa1\xa7\x04\xd2\x40\x13\x4a\x72\xe8\x59\x95\x36\x92\x88\x2d\x25\xd2\x9a\xa4\x84\xbc\x7b\x59\x3b\x2a\x69\x7f\xf8\x6f\xd2\xec\xb7\x33\x23\x5d\x94\x3e\x2b\x8b\x30\x2a\x1f\x84\xf0\xe3\x25\x26\x82\x46\x54\x35\xf9\x11\x6b\x21\xaa\xda\x7a\x72\xd3\xaf\x4e\xc7\xb1\x3f\x2b\x52\x49\xe5\xde\x27\x9f\xe7\xd9\xe3\x01\xdd\x2e\x9a\x69\x40\x78\x3e\xfb\x21\x5a\xeb\x83\xad\x85\x14\xa2\xef\x21\xe1\x75\xc2\x4c\xdf\xa3\xb5\x98\x60\x88\x36\x03\x2a\xed\x8a\xde\x82\x56\x29\xfd\xf6\xc1\x82\xe2\x29\x43\xa4\xac\x45\x03\x37\x4f\x0e\xc8\x61\x41\xd9\x6d\xbb\x9e\x65\x1f\xde\x07\xa0\x63\x20\xbc\x13\x9c\x62\x9a\x75\xa7\x82\x19\x30\x65\x71\x9a\x82\x2e\xd4\xd2\xa0\xd1\x74\x07\x6e\xde\xad\x96\x25\x09\x0f\x51\x65\x52\x89\xe0\xcb\x57\xe0\xf7\x76\xfb\x78\x6b\xa4\xa8\xbc\x61\xe5\xf5\x9c\xee\xb0\xb8\x6c\xd7\xec\xd0\x6d\x90\xbe\xa1\x32\x98\x9a\x0f\xf3\x45\x97\x52\x54\x0c\x7e\x4e\xb5\xe0\x8d\x14\xa2\x4a\x78\x5d\xd1\xfd\x3d\xee\xa7\x27\xf7\x17\x9e\x23\x5f\xb7\x46\x96\xe6\x8d\x5c\xd6\xe7\x9c\x03\x66\xa4\x82\xfc\x8b\xb3\x55\x59\x59\x82\x4a\xb9\x3d\x4b\x9c\xff\x5f\xbd\x17\xd6\x02\x43\x3b\x24\x17\x0d\x87\xf1\xed\x87\x22\x57\xce\x1b\xa4\x23\x29\x9a\xf2\x2a\x1a\x64\x71\xfe\xbd\xa3\x0f\x1a\x9b\xf9\x47\x65\x0b\xc1\x0f\x52\x3c\xff\x0c\x00\xfa\x23\xf4\x4e\x60\x02\x00\x00")
+
+func templatesLoggingIrisTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesLoggingIrisTpl,
+		"templates/logging/iris.tpl",
+	)
+}
+
+func templatesLoggingIrisTpl() (*asset, error) {
+	bytes, err := templatesLoggingIrisTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/logging/iris.tpl", size: 608, mode: os.FileMode(420), modTime: time.Unix(1792418641, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesLoggingLoggingTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x56\x6f\x6f\xdc\xb6\x0f\x7e\x6d\x7d\x0a\x56\xc0\xaf\xb0\x7f\x70\x9d\x76\xd8\x86\x21\xdd\x0d\x18\x96\x0e\xcd\x5a\xb4\x40\x93\xad\x03\x8a\xa2\xd0\xd9\xb4\xad\xc5\x27\xb9\x12\x2f\x77\x87\xe0\xbe\xfb\x40\x49\xbe\x7f\x49\xd6\x37\xc1\xc9\xa2\xc8\x87\x0f\xc9\x87\x19\x55\x7d\xa3\x3a\x84\xc1\x76\x9d\x36\x9d\x10\x7a\x31\x5a\x47\x90\x8b\x4c\xd6\xd6\x10\xae\x49\xf2\x4f\xb7\x19\xc9\x9e\x39\x65\x1a\x3e\xa2\xa9\x6d\xa3\x4d\x77\xd6\xe3\x9a\xcf\xed\x22\x58\x0d\xb6\x3b\xf3\x83\xed\xf8\xb7\xf5\xfc\x97\xf4\x02\xa5\x28\x84\x38\x3b\x83\x0f\xf8\x75\x89\x9e\x2e\x2f\x5e\xa3\x6a\xd0\x41\xad\x9c\xd3\xe8\x81\x7a\x84\xcb\x0b\xb0\x2d\x28\x70\xd1\xa6\x84\x55\xaf\xeb\x1e\xb4\x87\x0e\x0d\x3a\x45\xd8\xc0\xaa\x47\xc3\xc6\xec\xab\x1e\x34\x1a\x82\xc6\xa2\x07\x63\x09\x3c\x9a\x06\xac\x41\x51\x5b\xe3\xe9\x5e\xa8\x19\xc8\xbf\x9f\xa5\x8f\xcf\x2e\x2f\x64\xc0\xb3\x50\xeb\x9d\x1d\xcc\xed\xd2\x34\x11\xcb\x80\xa6\xa3\xfe\x08\x0f\xe3\xf3\x1c\x70\xbe\x01\x95\x82\xa7\x50\x47\x5e\x66\xf0\xe2\xbb\x9f\x84\xa0\xcd\x88\x90\xd8\x7b\x83\x1b\xf0\xe4\x96\x35\xdd\x6d\x43\xd8\x2b\xa4\xe5\x08\xda\x78\x52\xc3\x10\x23\x36\xd8\xaa\xe5\x40\xa1\x08\xe8\x4a\x58\x39\x4d\xda\x74\x40\x16\x3c\x35\xe8\x1c\x28\x0f\x7f\x5c\xbd\x7f\x07\xd6\x01\x97\x04\x14\xb1\xab\x01\x6f\x71\x28\x01\xab\xae\x82\x06\xe7\xcb\xae\x04\x6d\x5a\x5b\xc2\x4a\x39\x53\xb2\x31\x3a\x67\x5d\x05\xd7\x3d\x42\x6b\xdd\x42\xd1\x14\xcb\xb3\xf3\xe0\x52\x99\x86\x7d\x31\x8e\xe0\x8f\x2f\xd8\x4b\xe4\x1b\x17\x23\x6d\x44\xbb\x34\x75\x04\x9e\x47\x37\x65\xb2\xf5\xe4\xb4\xe9\x8a\x18\x07\xee\x44\x76\xab\x1c\x0c\xc0\x4d\x50\xbd\x65\x0b\x91\xe9\x36\xd9\x3e\x99\x81\x94\x6c\xc3\x9f\x38\xa9\xf3\x19\x0c\xd5\x9f\x66\xa1\x9c\xef\xd5\x70\x8d\x6b\xca\x3f\x7d\x9e\x6f\x08\xf3\xf0\xa0\x28\x5e\xb2\x5f\x78\x32\x03\xa3\x87\xf0\x30\x73\x48\x4b\x67\xa0\x5d\x50\xf5\x8a\x43\xb6\xb9\xd4\xe6\x56\x0d\xba\x61\xf2\x52\xa0\xff\x7d\x7d\x09\xb8\x1e\xb1\xe6\xae\xf9\x0f\x5e\x64\xca\xa2\x10\x59\xb6\x15\xd9\x56\x88\xcc\x8e\xe4\xe1\x7c\x06\x4f\x43\x06\xaf\x95\x69\x06\x74\xef\x47\xd2\xd6\xf8\xbb\x90\xd0\x39\x0c\xdb\x98\x66\x1f\x6f\xe1\xd0\x54\x64\x7e\xa5\xa9\xee\x27\xb6\xef\x44\x56\x2b\x8f\x20\x65\x09\xf2\x1f\x6f\x8d\x3c\x17\x59\x36\xbd\x9c\xc5\xb7\xef\x70\xc5\x95\x48\x2e\x72\xeb\xab\xab\x50\xf5\x12\x18\x4e\x31\xb9\xe0\xc2\x3f\xf2\x9c\xb9\x7b\xfc\x79\x2a\xf9\xb9\xf8\x26\x7f\x09\xf5\x11\x81\x8c\x7a\xea\x3b\x59\xa6\xc4\x8a\x48\x57\x80\x7f\x85\x74\x11\x23\xe4\x13\x9e\x3c\x41\x2c\x0a\x31\x85\x34\x7a\x10\x71\x00\xde\xe1\xea\xb7\x38\x1b\x10\xef\x3c\xcf\x94\x1d\x37\x3c\x72\x35\xad\x83\x30\x6c\xb8\xff\xe3\x3c\xc4\xee\xdb\xbf\xca\x83\x4d\xfc\x5d\xa5\x6f\x65\xb2\x85\xff\x07\x08\x6f\xc3\xa1\x38\xb5\x82\xbb\x1d\x9c\xe9\xe6\xa3\xa6\xfe\x2f\x35\x2c\x91\xbd\x96\xd3\x83\x37\xb8\xb9\xdb\x4e\x3e\x8b\x84\xfb\x77\x67\x17\xa7\xc0\xc3\xd4\x84\x60\x09\x7c\x09\xad\x1a\x06\x06\x3f\x57\xf5\x0d\xcf\xd2\xfd\x01\x8f\x09\x1d\xb8\x7b\x28\xa3\xe2\x28\x15\x46\xae\xdb\x9d\x40\xd8\x1b\x6e\xd2\x9a\xd6\x55\xc2\x7e\x00\xbb\xa8\xf2\x23\x12\x5e\x82\xbd\xe1\xe7\x53\xe6\x09\x43\xb6\xdd\x71\x11\xac\xa7\x12\x4e\xd9\x32\x31\x7b\x61\xfb\x46\xa1\xee\xe7\x08\xa4\xba\x8e\x45\x5b\x53\x3f\xc9\xcb\x5e\x4c\x23\x03\x47\x21\x1e\xae\xaa\x6e\x76\x12\xf3\x78\x2d\x8f\x5b\xa3\x3c\xc9\xa7\xe2\x30\xb9\x4c\xc1\xbf\xe8\x46\xb2\xdb\x62\xca\xf3\x7e\x8e\x69\x1f\x9d\xea\x7d\xd0\x53\x05\x06\x57\xc0\x6b\xd0\x2e\x78\x29\x3c\xb4\x93\x74\xb3\x5f\x49\x0a\xa2\x38\xf1\x6a\x0a\x39\xef\xf3\x3d\x48\x2d\xa6\x98\x6a\xac\x1b\x56\x3c\x29\xe1\xe9\x53\x18\xd0\xe4\xba\x29\xe0\xe7\xd9\xf1\x9e\x39\x28\xa7\x6e\xe2\x28\xce\xb9\x23\x16\xea\x06\x93\x86\x96\xf0\xe2\xc7\x22\x38\xfc\x52\x4e\x6a\xcb\xb8\xab\x0f\xa8\x9a\x7c\x7e\x5f\x5b\x93\x3f\xb9\x34\x37\xc6\xae\x8c\x3c\xec\x90\x1e\xd7\xd5\x2b\xde\xf9\x78\x6d\xaf\x02\xd8\x7c\x7e\x42\x20\xf7\x26\xf7\x87\x47\x77\x8b\xcd\xae\xd6\x2b\x4d\xfd\x43\x53\xa2\x28\x7c\x0d\x3a\x1c\x55\x98\x7d\xb5\xd6\x45\x07\x49\xa1\x3d\x28\xd3\x04\x43\xde\x68\x49\xde\xd9\x28\x71\x1d\x8d\x8e\x98\x7d\xb8\x8f\x16\x48\xbd\x6d\x4a\x18\x15\xf5\x89\xf6\x12\x3c\x29\x5a\x7a\xd0\x5c\xdb\x41\x11\x9a\x7a\x03\xfc\x0f\x4b\x75\xb1\x74\x8a\x25\x3f\x12\x17\x82\x14\x5c\x9d\x18\xff\x3c\xe9\x6e\x58\x07\x97\xa6\xb5\x3b\xd1\x9f\xd4\x3e\x39\xfe\x65\x06\x3f\x3c\x7f\xce\xb2\x1b\x1f\x1e\xbe\x0b\xdb\xeb\x9e\xf9\xf7\x8f\x99\x7f\x54\xce\x70\x41\x44\xa6\x88\x5c\x58\x51\x9f\x3e\x87\xeb\x5f\x89\x1c\x97\x2f\x1c\x52\x6d\x64\x4c\x57\x4e\x79\x17\xe5\xa9\x01\xf3\x20\x23\x1d\xfb\xcb\x4b\x43\xb9\x8c\xd8\xe5\xc4\xce\xfe\x76\x22\x25\x97\x89\x2b\xb9\x63\x8d\x8d\xb6\x62\x5a\xeb\x07\x3d\x15\xc1\xce\x40\x8d\x23\x9a\x26\x0f\xc7\x12\x8e\x90\x4c\x9b\x18\x9d\x8b\x2b\x29\x2f\x78\x6b\x6c\x45\x76\x22\x90\x05\x4b\x21\x67\xeb\xb9\xc4\x69\x75\x97\x30\x0d\xb6\xe4\x9e\x22\xe7\xab\xaa\x2a\xc4\xf6\xdf\x01\x00\x3a\xa4\x89\x4b\xd7\x0a\x00\x00")
+
+func templatesLoggingLoggingTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesLoggingLoggingTpl,
+		"templates/logging/logging.tpl",
+	)
+}
+
+func templatesLoggingLoggingTpl() (*asset, error) {
+	bytes, err := templatesLoggingLoggingTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/logging/logging.tpl", size: 2775, mode: os.FileMode(420), modTime: time.Unix(1792418685, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesLoggingOzzoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x52\x4d\x6f\xdb\x30\x0c\x3d\x4b\xbf\x82\xcb\x61\xb0\x07\x57\xbe\x77\xc8\xa9\x2d\xd6\x62\x69\x57\x34\x1d\x7a\x56\x65\x46\x16\xea\x48\x19\x4d\x2f\x59\x83\xfc\xf7\x81\xfe\x58\x3d\x6c\xc3\x2e\x41\x44\xbe\xf7\xc8\xe7\xc7\x9d\x75\x2f\xd6\x23\x6c\x6d\x88\x5a\x87\xed\x2e\x11\x43\xa6\xd5\x22\x22\x97\x35\xf3\x6e\xa1\xd5\x82\xc3\x16\x17\x5a\xab\x85\x0f\x5c\x77\xcf\xc6\xa5\x6d\xe9\xd3\x59\x7a\x7d\x4d\xa5\xfc\x9c\x51\xea\x38\x44\xbf\xf8\x3f\xa4\xb4\xce\x61\xdb\xf6\x6a\xc7\x23\x98\xdb\x54\x75\x0d\xc2\xe9\x54\x36\xc9\xfb\x5e\x23\xd7\xba\x2c\x81\xf0\x5b\x87\x2d\xaf\x92\xf7\x48\xd0\x24\xdf\x02\x5a\x57\x4f\xf5\x02\x9c\x25\xfa\x11\xa2\x07\x2b\x5d\x01\xb1\xf5\x1e\x2b\xd8\x07\xae\x81\x6b\x9c\xa0\xa2\x76\x73\xd9\x97\x43\x9c\x37\xc0\xa5\xc8\x78\x60\xd8\x24\xea\xeb\xb5\x8d\x55\x83\xd4\xea\x4d\x17\xdd\x84\x1a\x36\xc8\x1c\x7c\x18\x2d\x98\x8b\x81\x96\x03\x12\x25\x82\xa3\x56\x2d\x5b\x62\x38\x5f\x82\x7c\x29\x73\x97\xf6\x59\xae\x55\xa8\xa4\x32\xda\x32\x0f\x83\xda\xcd\x65\xe6\xa6\xff\xe6\x1a\x6d\x85\x64\x3e\x21\x67\x7f\xc0\x86\x5e\x9e\x6b\x25\xf8\x76\x97\x62\x8b\x23\x21\xcb\xcd\xfa\xdf\x94\x02\x42\x95\x6b\xad\x1c\x1f\xe6\xf3\x9f\x02\xd7\x7f\xdb\x61\x34\x93\xe5\x03\x4f\xfd\xea\xc0\x12\xde\x50\xc2\x9e\x90\x8e\x0f\xa2\x4f\x7b\x91\x7f\x3f\xe4\x69\x56\xc9\x4f\x5b\x3e\x51\x60\xa4\xe3\xef\xcf\x73\x78\xb3\x51\xc0\x9a\x2d\x77\xed\x39\xc8\x81\x99\xe1\xf1\xe5\xf3\x69\x6e\x15\x96\x40\x7b\xad\x55\x7f\x09\x52\x9a\xe5\x3a\x7c\xf6\x67\xdc\x24\xc2\xc9\x1f\x04\x6e\xa1\xed\x95\xb4\x42\x22\xd9\xcd\x99\x3b\xd9\x57\xa2\xd8\x48\x56\xf0\x6e\x09\x31\x34\x12\x98\x24\xc6\x5d\x2b\xa8\xd9\x0e\x37\x91\x91\xa2\x6d\xd6\x48\xdf\x91\xae\x64\x8c\x56\x42\x16\xcc\x15\x51\x01\xe9\x45\x28\x48\x64\xb2\xe9\x1a\xae\x1f\x1f\xef\x7b\x68\xfe\x51\xda\x22\x3e\xa9\x2f\x27\xe2\xa8\x7f\x91\x2a\x94\xcb\x50\x27\xad\x94\xb4\x4c\x4f\xcc\x68\x5f\xc8\x7e\xe3\x2b\x2f\x46\x23\xb9\x16\xe0\x14\xe0\x18\x45\xe6\xf8\x50\xcc\x92\xb9\x45\xae\x53\x35\xaf\x7c\x7d\x58\x99\x7b\xcb\x75\x01\xb4\x1f\x07\x17\xc3\x61\xae\x43\x74\x98\xf5\xc7\x9a\xf7\x13\x73\xad\x08\xb9\xa3\x08\x31\x34\xfa\xf4\x73\x00\x4a\x3f\x0f\x77\x0d\x04\x00\x00")
+
+func templatesLoggingOzzoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesLoggingOzzoTpl,
+		"templates/logging/ozzo.tpl",
+	)
+}
+
+func templatesLoggingOzzoTpl() (*asset, error) {
+	bytes, err := templatesLoggingOzzoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/logging/ozzo.tpl", size: 1037, mode: os.FileMode(420), modTime: time.Unix(1792418641, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesMetricsEchoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6f\xdb\x3c\x0c\x86\xcf\xd1\xaf\xe0\x97\x93\xfd\x21\xb3\xef\x1b\x72\x2a\x32\x74\x87\x00\x45\xdb\xfb\xa0\x4a\x74\x2c\xd4\xa6\x3c\x8a\x5e\x3b\x04\xfe\xef\x03\x25\xbb\x43\xb6\x4b\xe0\x88\x0f\x5f\x92\x2f\x39\x59\xf7\x6a\x2f\x08\xa3\x0d\x64\x4c\x18\xa7\xc8\x02\x95\xd9\xed\x09\xa5\xed\x45\xa6\xbd\x31\xbb\xfd\x25\x48\x3f\xbf\x34\x2e\x8e\xed\x60\x5f\x92\x58\xf7\xda\xa2\xeb\x63\x0e\x5e\xaf\xd0\x9c\xa3\x9f\x07\x84\x65\x69\x47\x14\x0e\x2e\xed\x4d\x6d\x4c\xdb\x02\xa3\x8b\xec\xcf\xe5\x71\xfd\x97\x40\x7a\x04\x17\x67\x92\x03\xf8\x99\xad\x84\x48\x07\xb0\xe4\x21\xd0\xa7\x6e\x08\x97\x5e\x80\xf1\xc7\x8c\x49\x12\xc4\x0e\xd0\xba\x3e\x8b\xc5\x59\x10\x04\xc7\x69\xb0\x82\xa6\x9b\xc9\xdd\x16\xa8\x6a\xd0\xb6\x9a\x73\xf0\x7e\xc0\x37\xcb\xf8\x55\x99\xab\xd9\x31\xca\xcc\x04\x9a\x52\x11\xbe\x4b\xe1\xee\x2d\xf9\x01\x59\xa1\xfa\x9f\x17\xb8\x9a\xdd\x4d\x9e\x2b\xc8\x5d\x24\xc1\x77\xa9\x01\x99\x23\x67\x6a\xe7\x23\x21\x7c\x3e\xc2\x3a\x7d\xf3\x24\x96\xe5\xb1\x8c\x50\xd5\x4a\x20\xb3\x02\x5a\xbb\x72\xb5\xd1\xa7\xb6\xcd\x46\x14\x99\x90\x80\x31\x4d\x91\x3c\x7a\x90\x08\x91\x1c\x42\xa9\x8e\x1e\x3a\x8e\x63\x86\xc7\x8f\xc9\x54\x21\x89\x95\x39\xa9\xae\x6b\x1e\x73\x76\xc2\xaa\xd6\xea\x32\x27\x05\x42\xa7\x5d\xc2\x7f\x47\xa0\x30\x94\x56\xb7\xa4\x23\xe8\x7a\x57\xf6\x1b\x09\x32\xd9\xe1\x09\xf9\x27\xf2\x49\x3b\xca\x6c\xe8\x32\x75\x62\x3e\x40\x7c\xd5\x42\xc8\xdc\x54\xff\x67\x23\xee\x9f\x9f\x1f\x32\x5a\x7f\xd1\x60\x51\xbf\x95\x3f\x31\x37\x77\xd1\x63\x0e\x2d\x66\xfb\x51\xbb\x2a\xd7\x7c\x18\xd4\x9c\x51\xfa\xe8\x0f\x65\xc3\xcf\xeb\x82\x2b\x57\x1f\xa0\xc8\x65\x0b\xd7\x5d\x20\xb3\xc9\x3a\x8b\x59\xcc\xc7\x59\x6c\x49\xab\x67\xe5\xc6\x26\x2b\xbd\x1e\x90\x7e\x67\x69\x18\xad\xb8\x3e\xd0\x05\xec\x76\x61\x07\x88\x0c\x96\x00\xc7\x49\x7e\xa9\x5c\x12\x56\xe0\xad\x47\xca\x22\x2b\x57\xc4\x82\x24\x1c\x3a\xed\x8a\x7c\x82\x40\xd0\x95\xec\x99\xb2\xbe\xdf\x54\xd7\xe3\xbc\x1d\xe7\xaf\xfb\x59\x0b\x5d\xcd\x4e\x45\xbe\xaf\xd3\xab\xc9\x6c\xe9\x82\xe0\x9a\x93\xeb\x63\x55\x37\x8f\x2a\x93\xaa\x3a\x2f\x30\x74\x85\x6b\x1e\xb4\x9f\xa3\xae\x5e\xbf\xd6\xe8\x76\xb0\x7f\x90\xcd\xab\x2d\xb2\xdf\x9b\xe5\xf7\x00\xfd\x40\x3b\xa4\xf6\x03\x00\x00")
+
+func templatesMetricsEchoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMetricsEchoTpl,
+		"templates/metrics/echo.tpl",
+	)
+}
+
+func templatesMetricsEchoTpl() (*asset, error) {
+	bytes, err := templatesMetricsEchoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/metrics/echo.tpl", size: 1014, mode: os.FileMode(420), modTime: time.Unix(1792419666, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesMetricsGinTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\x31\x6b\xc3\x30\x10\x85\x67\xdd\xaf\x38\x3c\x49\x25\x91\xf7\x42\xa7\x42\xe8\x92\x52\xda\xa1\xb3\x2a\x5d\x6c\x51\xfb\x94\x9e\x4f\x10\x30\xfe\xef\x45\x8d\x97\x6e\xef\xdd\xfb\x78\xef\xae\x21\x7e\x87\x81\x70\x0e\x99\x01\xf2\x7c\x2d\xa2\x68\xc1\x74\x43\xd6\xb1\x7e\xf9\x58\xe6\x7e\xc8\x7c\x1c\x0a\xe7\xd8\x54\x07\x60\xba\x75\x45\x7f\x2e\xa9\x4e\x84\xdb\xd6\xcf\xa4\x92\xe3\xd2\x81\x03\xe8\x7b\x14\x8a\x45\xd2\xf9\x7e\xdc\xdd\x82\x3a\x12\xc6\x52\x59\x0f\x98\xaa\x04\xcd\x85\x0f\x18\x38\x61\xe6\xe3\x65\xca\xc3\xa8\x28\xf4\x53\x69\xd1\x05\xcb\x05\x29\xc4\xf1\xaf\xac\x54\x25\x54\x9a\xaf\x53\x50\x82\x4b\xe5\xf8\x7f\xc0\x3a\x1c\x32\xfb\x97\xc0\x69\x22\x39\xb5\x7c\x05\x23\xa4\x55\x18\x1b\x6e\x23\x3e\x34\xe2\xb9\xb0\xd2\x4d\x1d\xae\x60\x4c\x2a\x4c\xf8\xf8\x84\xfb\xeb\xfe\x43\x83\xe8\xfb\x7d\xdf\x3a\x30\x26\xfa\x57\xba\xdd\x65\x63\x6d\xf4\x7b\xea\xcf\xa4\x63\x49\x07\x8c\xfe\x54\xa7\xe9\x2d\xe8\x68\x5d\x73\x9f\x92\x95\xa4\x35\x69\x5d\xac\x73\x60\x36\xd8\x7e\x07\x00\xd2\xfd\x87\x86\x5f\x01\x00\x00")
+
+func templatesMetricsGinTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMetricsGinTpl,
+		"templates/metrics/gin.tpl",
+	)
+}
+
+func templatesMetricsGinTpl() (*asset, error) {
+	bytes, err := templatesMetricsGinTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/metrics/gin.tpl", size: 351, mode: os.FileMode(420), modTime: time.Unix(1792419393, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesMetricsGrpcTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x90\xc1\x8a\xdb\x30\x10\x86\xcf\xd1\x53\x0c\x3e\xd9\xc5\x95\xef\x85\x9e\x02\xa5\x3d\xe4\x14\xfa\x00\x42\x1e\xcb\xa2\xf2\x4c\x3a\x1a\x87\x5d\x42\xde\xbd\x58\x0a\xbb\xb8\xb0\xec\x21\x27\xc1\x8c\xe6\xe3\xff\xbf\x8b\xf3\x7f\x5c\x40\x58\x5c\x24\x63\xe2\x72\x61\x51\x68\xcd\xa1\xf1\x4c\x8a\x2f\xda\x18\x73\x68\x02\x73\x48\x68\x03\x27\x47\xc1\xb2\x84\x21\xc8\xc5\x37\x1f\x6e\x86\xac\x4e\xd7\x5c\x6e\x6f\x37\xb0\x27\x1e\xd7\x84\x70\xbf\x0f\x0b\xaa\x44\x9f\x1b\xd3\x19\x33\x0c\xb0\x92\x93\xd7\x53\x9d\x81\xa0\x67\x19\x33\xe8\x8c\xe0\x79\x25\xed\x61\x5c\xc5\x69\x64\xea\xc1\xd1\x08\x91\xbe\x4e\x29\x86\x59\xc1\xbb\x94\x32\xf0\x04\xe8\xfc\x5c\x29\x1b\x6e\x41\x9d\x79\x34\xd3\x4a\x7e\x87\x6e\xbd\xbe\xc0\xa3\x90\x3d\xd6\xb7\x07\xc1\xbf\xe0\xe8\xb5\x87\x48\x13\xc3\x97\xad\x92\xfd\xbd\x5d\x9d\x51\xae\x28\xbf\x68\xe2\x1e\x66\x47\x63\x42\x81\xf7\xed\xcf\x3a\xe9\xa0\x2d\xc7\x28\xc2\xd2\xc1\xcd\x1c\x46\x26\x84\x6f\xdf\xe1\xd1\xd1\x9e\xd5\x89\x1e\x5d\x4a\x6d\x67\x0e\x82\xf9\xd2\x03\x8a\x6c\x3f\x1e\xd0\x2d\x56\x89\xd1\xd5\xe3\x76\x0b\x62\x7f\xac\x29\x9d\x4a\x91\x1e\xaa\x47\x7b\xe4\x11\x5b\x14\xe9\xec\x59\x25\x52\x68\xbb\x42\xd4\x55\x08\xde\xc0\xe6\x5e\x94\x66\x15\x74\xcb\xb3\x4e\xdf\x49\x91\xc2\xce\xeb\x8e\xdf\x66\xb9\x56\x87\x39\x57\x45\xd5\xdd\xb9\x7c\xda\x99\xad\xa3\x0f\xd5\xd6\xf5\x9b\xdb\x62\xf5\x53\xa9\xff\xe9\xcc\x72\xdd\x82\x3c\x63\x13\x45\xcc\xfd\xdf\x00\x9d\xe0\x3e\x1d\x14\x03\x00\x00")
+
+func templatesMetricsGrpcTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMetricsGrpcTpl,
+		"templates/metrics/grpc.tpl",
+	)
+}
+
+func templatesMetricsGrpcTpl() (*asset, error) {
+	bytes, err := templatesMetricsGrpcTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/metrics/grpc.tpl", size: 788, mode: os.FileMode(420), modTime: time.Unix(1792419393, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesMetricsIrisTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\x31\x6e\xc3\x30\x0c\x45\x67\xf3\x14\xac\x27\x0b\x70\xed\xbd\x45\xa6\x0c\x9d\x52\x14\xc9\x09\x58\x89\xb6\x85\xd8\x52\x4a\x51\x85\x81\xc0\x77\x2f\x54\xa7\x43\x47\x12\xff\xbf\xf7\x6f\x64\xaf\x34\x32\x2e\xe4\x03\x80\x5f\x6e\x51\x14\x1b\xa8\xea\xd1\xeb\x94\x3f\x3b\x1b\x97\xfe\x4a\x4a\x42\xa9\xf7\xe2\x53\x0d\x50\xd5\xf7\x3b\x76\xa7\xe8\xf2\xcc\xb8\x6d\xfd\xc2\x2a\xde\xa6\x1a\x0c\x40\xdf\xa3\xb0\x8d\xe2\x4e\xfb\xf3\x71\x25\xd4\x89\xd1\xc6\x1c\xb4\x45\x97\x85\xd4\xc7\xd0\x22\x05\x87\x3e\x3c\x0f\xb3\x1f\x27\x45\xe1\xaf\xcc\x49\x13\xc6\x01\x99\xec\xf4\x0b\x8b\x59\x19\x95\x97\xdb\x4c\xca\x30\xe4\x60\xff\x0b\x1a\xab\x2b\x96\x61\xdd\x31\x06\xe5\x55\x0d\xde\xa1\x72\x31\x30\xbe\x1c\xf0\x31\xad\xbb\x28\x89\x9e\x77\x7e\x63\xa0\xb2\xba\x76\xef\xbc\x6a\x63\x00\xaa\x6f\x92\x87\x27\xa9\xf8\x30\x42\xe5\x07\x94\x52\x2f\xb1\x37\xd6\x63\x16\xe1\xa0\xe7\x92\x69\xcc\x2b\x0a\x3e\x1d\x30\xf8\xb9\x98\xaa\xbd\x79\x40\xe9\x3e\x48\xa7\x02\xdf\x76\x7f\x59\xd6\x9d\x58\xa7\xe8\x1a\xd3\xee\x86\xf6\x0f\x79\x51\xd2\x9c\x8e\xd1\x71\x63\x0c\x6c\x3f\x03\x00\x47\x38\xe0\xf3\x86\x01\x00\x00")
+
+func templatesMetricsIrisTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMetricsIrisTpl,
+		"templates/metrics/iris.tpl",
+	)
+}
+
+func templatesMetricsIrisTpl() (*asset, error) {
+	bytes, err := templatesMetricsIrisTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/metrics/iris.tpl", size: 390, mode: os.FileMode(420), modTime: time.Unix(1792419393, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesMetricsMetricsTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x4b\x6f\xdb\x46\x10\x3e\x73\x7f\xc5\x94\x87\x82\x0c\x18\xea\xd4\x8b\x01\x1f\xea\x18\x89\x03\xc4\x4e\x60\x37\xe9\xa1\x28\x84\x15\x39\x22\x17\x26\x77\xe5\x7d\xf8\x01\x41\xff\xbd\x98\x5d\x2e\x49\x59\xb1\x63\x27\x3d\xd9\xe4\xbc\xe7\xfb\x66\x86\xda\xf0\xea\x9a\x37\x08\x3d\x5a\x2d\x2a\xc3\x98\xe8\x37\x4a\x5b\xc8\xd8\x76\xfb\x16\xc4\x1a\xca\x73\xd1\x68\x6e\x85\x92\x06\x76\x3b\x96\xa4\x35\xb7\x7c\xc5\x0d\x2e\xcc\x4d\x97\x7a\x2d\x94\x75\x10\x49\xb4\x69\xf8\xb3\x68\xad\xdd\xa4\xd1\x87\x44\x28\xff\xdc\x6c\x20\x6d\xf4\xa6\x4a\x83\xae\xb1\xba\x52\xf2\x76\xcf\xc3\x53\x21\xcd\x83\xac\x1e\x85\xb2\xa2\xc7\x94\xb1\x24\x6d\x84\x6d\xdd\xaa\xac\x54\xbf\xd8\x68\xd5\xa3\x6d\xd1\x99\x45\xd5\x09\x94\x76\xd9\xa8\x8e\xcb\x66\x26\x48\x5f\x6d\xb1\xa8\x54\xd7\x61\x65\x95\xfe\x19\x63\xfa\x37\xb4\x22\x67\x6c\xb1\x80\x4b\x6c\x84\xb1\xfa\x01\x5a\xd5\xd5\x06\x6c\x3b\x76\x1e\x0c\xea\x5b\xac\x61\xf5\x00\x67\x5c\xd6\x1d\xea\x02\x78\xa7\x64\x03\x77\xc2\xb6\x5e\xf3\x83\x02\xed\x24\x55\x0e\x5c\xd6\xe4\x6e\xa3\x55\x85\xc6\x8c\xe8\xdd\x72\x3d\x85\x38\x86\x29\x91\xf2\x02\xef\xa2\x20\xcb\xd9\x76\x4b\xd0\xe2\xcd\x63\x58\xc8\x3e\x63\x49\xc5\xbb\xce\x1c\xd8\xbf\x53\x4e\x5a\xd4\xdf\xb0\xca\x66\x82\xe1\xed\xe7\x8d\x35\x5b\x96\x24\x17\xbc\xc7\xa3\x00\xf4\xd2\x57\xa4\x97\xad\x2f\xa7\x5e\x5a\x65\x79\x97\x16\x2c\x49\xce\xb0\xdb\x1c\x41\xea\x4d\x41\xad\x7d\x71\x43\xf9\x21\xf4\xea\x81\x4a\x6a\x55\x4d\x85\x42\xa5\x6a\x24\xbb\x5d\x01\xff\xfc\x6b\xac\x16\xb2\xd9\xa6\x41\x9e\x16\x90\x7a\xf1\x2e\x67\x49\xed\x02\x51\x0f\x32\x3f\x13\xc6\xaa\x46\xf3\xfe\x51\xee\xe3\xfb\xfd\xec\x01\xbe\x53\x80\x90\xcd\xd2\x60\xa5\x64\x6d\x66\x35\x90\xea\x69\x0c\xfb\xf2\x4a\x92\x13\x57\x5d\xa3\x35\x47\xf3\x44\x4f\x71\x3d\xbc\x7e\x41\xad\x42\xbe\xef\x44\xd3\xda\x83\x5a\x3f\x70\xd7\xe0\xbc\x48\xff\xe2\x19\x78\x84\x5c\xae\xbd\xab\x27\xa1\x19\x2a\x41\x21\x9b\xa1\x38\x52\xdd\xe5\x8c\x88\xf4\x16\xb0\x33\x48\xe4\x59\x2c\xc0\xc9\x9e\xdb\xaa\xc5\x1a\x3a\xbe\xc2\x2e\x10\x5c\xe3\x8d\x43\x63\x0d\x48\x65\xc1\xcb\xc9\x11\x07\xad\x9c\xc5\x02\xee\x5a\x51\xb5\xb0\x52\x4e\x86\x81\x20\x5e\xdf\xf2\xce\xa1\x89\xf1\xbd\x62\xf0\xc8\x2a\x25\x8d\x9d\xc5\x39\x86\x74\x7c\x48\x59\x24\xf0\x18\xf2\x57\x38\x4c\x73\xbb\x8c\x9e\x5e\xc6\xde\x31\xee\xea\x61\xc8\xda\x62\xbf\xe9\xb8\x45\x4f\x64\x63\xb9\x75\xe6\x19\x2a\x7b\x1b\xfa\x67\xd0\xfc\xbf\x59\x3d\x2f\x69\x19\x1d\xbf\x8e\xd6\x2f\x2e\xf1\xa7\x39\xfe\xbd\x26\xfc\x1a\xdd\xf7\x91\xfc\x31\xe1\xa7\x1a\x9f\xe4\xfc\x73\x07\x2b\xb2\xb0\x77\x40\x97\xab\x3c\x77\x16\xef\x59\x42\xfb\x5a\xa9\x0e\x86\x83\xe2\xc9\x0e\x95\x92\x12\x2b\x82\x21\x08\x09\x78\x61\x2c\x1d\x84\x21\x99\x78\x6f\x59\xe2\x15\x66\xa5\xbe\x8b\x97\x69\x3f\x29\xb6\x76\xb2\x02\x21\x85\xcd\x72\xd8\xb2\x24\xee\xfd\xf2\xdc\x19\x1b\x1e\x50\x67\x2c\x49\xa6\xcb\x46\xa3\xf1\x41\x8d\xfe\xb2\xbc\x38\x10\x7f\x09\xa7\x66\xd2\x99\x89\x1f\xcb\x3c\xf1\x76\x79\x11\xfb\x73\x78\x68\x92\x70\x63\x8a\xbd\x05\x92\x8c\x73\x5b\xec\x1d\xfb\x71\x04\x28\xab\xc8\x84\x82\x25\x39\xdb\x3d\x79\xc9\x16\x0b\xb8\xb2\x5c\xdb\x77\xbc\xa3\x8e\x3b\x69\x0d\x70\xa0\xa0\xc0\x0d\x08\x09\x61\xe7\x15\xa0\xd1\x3a\x2d\x09\x66\x0e\xbe\x71\x1a\x2b\xa5\x6b\x7a\x11\x77\x1f\x6d\x24\xab\xe2\x1e\x57\xb2\x1a\x87\xc1\x9f\x66\xda\xc9\xa1\xe7\x63\xc4\x2c\xf7\xbe\xb2\x60\x52\xf8\x0b\x06\x61\xdc\x3d\x24\x86\x14\xe1\xe8\x18\xe8\x98\x97\x17\xea\x2e\x9b\x71\xbc\xfc\x28\x2b\x7a\x0e\x99\x3d\xef\x68\x32\x3a\x45\x6f\x14\xfa\x5a\xfe\x2d\x6c\xfb\x89\x16\xe6\x37\xbf\x49\xf7\xec\xf3\x18\x60\xec\xeb\x0f\xd4\x3f\xaf\x7c\xb9\x99\x4f\xf6\x4a\xc8\x0a\x33\x5f\x40\x5e\x5e\x85\xcd\x91\xe5\x39\x4b\x76\x6c\xb7\x87\x66\x44\xe0\x32\x60\x3a\x81\x30\x80\xfc\x2a\x1c\x08\x82\x68\x37\x07\x60\xf5\x30\xbb\x10\x71\x11\x15\x80\x65\x53\xc2\xc2\x19\xd4\x66\x71\x24\xea\x22\x7c\x43\xd1\x6c\x39\x33\x83\x6a\x48\xed\x31\x5a\x7e\x01\x0d\x5d\x2e\x06\x2b\x10\xd2\xfe\x1a\x74\xcf\x7a\x3d\xc4\x51\xac\x07\x8b\xe3\x63\x48\x53\x8a\x9c\x24\xc3\x8b\xe9\xfc\xb1\x24\xd9\x31\x3f\xaa\x35\x52\x4e\xc3\x47\x75\xf9\xd1\x2a\x4e\x18\x59\x67\xf2\xd9\x58\x3d\x89\xf3\x70\x8a\x5f\xc9\x8e\x3d\xab\x57\x92\xe4\xd9\xfd\x39\x7e\x28\xa3\x3e\x3d\x79\xcd\xbe\xac\x57\x34\xd0\x9b\x8e\x57\x33\xde\xc4\x05\x0a\x7a\xf0\x49\x5f\xd9\xb8\x56\x7a\x98\xda\x29\x54\x56\xaf\xe0\x8d\xb9\xe9\xca\xd3\x93\x02\x24\xef\xa7\x51\x43\xad\x95\x26\x10\x7a\x57\x7e\x52\xd5\x35\x81\x5c\xe3\x1a\x35\xf4\xae\xfc\x2a\xbb\xf0\x8a\x25\x62\x1d\xd2\xfa\xed\x18\xa4\xe8\xc8\x60\x5a\xbf\x5f\x65\x4c\x20\x23\x1d\x3f\x32\x61\xa7\x1f\xc3\xfe\xae\x3d\x3d\xb9\xb2\xdc\xce\x76\x2d\xd5\x45\xf9\x4c\xd4\x1a\xbd\x5e\xee\xfb\xdc\x6b\x2f\x4d\xcd\xf0\x63\x22\x0c\xcc\xfe\x2f\x0e\xb5\x1e\xdd\xd0\x46\x24\xd1\x97\xf1\xba\x80\xc5\x7b\x0b\x6b\xa5\x7b\x6e\x43\x9f\x06\x4f\x59\x0e\x74\x4f\xcb\xe8\x78\x3b\xe6\x14\x7f\xec\x44\xd1\x7b\xa5\xb3\x18\xa0\x38\x90\x0e\x47\x82\x05\xbc\xaf\x28\xbd\x98\xe4\xa0\x01\x2a\x24\xb5\x88\x09\x6f\xb8\x6d\x09\x66\x5e\xd7\x3a\x66\xbc\xe2\xd5\x75\xa3\xe9\xeb\xb1\x20\x3f\xd3\x1a\x19\xbf\x59\x34\x58\x05\xa6\x75\x16\x6a\x75\x27\x87\xf1\xa7\x40\x99\xf7\x13\x21\xce\xde\xf8\xdc\x7d\x22\xba\x08\x88\xfb\xd9\xec\x84\xf1\x8f\x34\x60\x12\x6d\xf9\x89\x48\x24\xb3\xd4\x56\x9b\xb4\x00\xf2\x41\xcb\x7b\xed\x55\x66\xb8\x0f\x4d\x91\xa2\xf3\xd6\x84\x36\xd1\xe7\x9e\xdc\xf8\x48\x17\x78\xe7\x83\x9d\xbb\x7b\xa2\x53\xef\xee\x87\xd6\x64\x69\xac\x38\x2d\xa6\xae\x13\xbd\x8c\xbe\x25\xf3\xdf\x67\x99\x6e\x07\x85\x23\xe8\xdd\x7d\x01\x97\xc8\xeb\x33\xe4\x35\xea\xbf\x44\x8f\xca\xd9\x23\xf8\x03\xde\x84\x3b\x13\x06\x71\xc7\x92\x46\x81\xd1\xb7\xc1\x41\xd6\x09\x33\xd1\xca\xe8\xdb\x02\xa4\xe8\xd8\xee\xbf\x01\x00\xa6\xbc\x7d\xeb\x19\x10\x00\x00")
+
+func templatesMetricsMetricsTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMetricsMetricsTpl,
+		"templates/metrics/metrics.tpl",
+	)
+}
+
+func templatesMetricsMetricsTpl() (*asset, error) {
+	bytes, err := templatesMetricsMetricsTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/metrics/metrics.tpl", size: 4121, mode: os.FileMode(420), modTime: time.Unix(1792419393, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesMetricsOzzoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\x4d\x6f\xfc\x34\x10\xc6\xcf\xf1\xa7\x18\xf6\x50\x25\x7f\xa5\x0e\xe2\x58\x58\x2e\x55\x11\x88\x16\xaa\x6d\x2b\x0e\x08\x55\xc6\x99\x24\x56\x13\x3b\x8c\x27\xdd\xd2\xd5\x7e\x77\x34\x4e\x42\x77\x51\x25\xa4\xaa\x5a\x4f\x9e\xf9\xcd\xdb\x33\x1a\xfb\x62\x5a\x84\xc1\x38\xaf\x94\x1b\xc6\x40\x0c\xb9\xca\x36\x1e\xb9\xea\x98\xc7\x8d\x52\xd9\xa6\x75\xdc\x4d\x7f\x6a\x1b\x86\xaa\x0d\x97\xe1\xfd\x3d\x54\xf2\xef\x92\xc2\xc4\xce\xb7\x9b\xff\x97\x54\xc6\x5a\x8c\x31\xd1\x0e\x07\xd0\x77\xa1\x9e\x7a\x84\xe3\xb1\x1a\x90\xc9\xd9\xb8\x51\x85\x52\x55\x05\x84\x36\x50\x7d\x37\x07\x97\x57\x04\xee\x10\x6c\x98\x3c\x97\x50\x4f\x64\xd8\x05\x5f\x82\xf1\x35\x38\x7f\xd9\xf4\xae\xed\x18\x08\xff\x9a\x30\x72\x84\xd0\x00\x1a\xdb\x25\x58\x98\x18\x81\x71\x18\x7b\xc3\xa8\x9a\xc9\xdb\xf3\x02\xb9\x85\x2f\x4b\x87\xfa\x3a\x78\xc6\x37\x2e\x00\x89\x02\xc1\x41\x65\x75\xf0\x08\x57\x5b\x58\x5a\xd4\x0f\x6c\x88\x77\x73\x9d\xbc\x50\x19\xed\xe5\xeb\xc5\x3c\x9a\xbe\x0d\xed\x0e\xe3\x18\x7c\xc4\xdf\xc8\x31\xd2\xe1\xfc\x79\x05\x56\xaf\x91\x12\x1e\xd8\xf0\x14\xaf\x40\x76\x2c\x60\x9e\xe2\xaf\x3f\x1f\x55\xf6\xa1\x81\x2d\xd0\x5e\x65\x48\x24\x55\xac\xfe\x05\xdf\x38\x2f\x94\xca\xaa\x2a\xed\x63\x6e\xd3\x45\xa0\x04\xad\xb1\x06\x0e\x10\xbc\x45\x20\xe4\x89\x3c\xd6\xd0\x50\x18\x92\x78\x70\x75\xdd\xe3\xde\x10\xaa\x2c\xa6\x6a\x02\xa5\xfd\x52\x5a\x65\xae\x91\xb9\xe1\xab\x2d\x78\xd7\xcb\xf0\xab\x6c\x7b\xda\xe2\x4f\x9e\x91\xbc\xe9\x1f\x90\x5e\x91\x6e\xa4\x03\x95\x49\xae\x68\x6e\x88\x4a\x08\x2f\x02\x46\x22\x9d\xaf\x8b\xfd\xf1\xf1\xf1\x3e\x49\x8b\x6f\xe5\xb3\xb0\xcf\xe1\x37\x44\x0b\xff\x3a\xd4\x28\x9b\xcd\x8e\x4a\xfe\xe4\x00\xb9\x6c\x24\xad\x5c\xdf\x21\x77\xa1\x2e\x41\xc0\xf8\xb8\x5c\x35\xb7\x45\x09\x33\x4e\x4e\x92\x26\x97\x51\xd4\x51\xfd\x6b\x81\x55\x0b\x8d\xf3\x8b\x9b\x56\x53\x88\x5d\xe4\x9d\x98\x30\x18\xb6\x9d\xf3\x6d\x92\x2c\x8e\x2a\x01\x75\xab\x85\x55\x4d\x11\x29\x56\xdf\xb9\xfa\xfb\x12\xf6\x9d\xb3\x1d\xb8\x08\x38\x8c\xfc\x37\xec\x3b\xf4\xe0\xc3\x29\x07\xe3\xe2\xb8\xf3\x76\x3f\x71\x5c\x64\x92\xa2\x07\x95\x3d\x97\x30\x1a\x32\x43\x94\x2d\x5a\xbd\x93\x54\xca\x0b\xfd\x83\xf3\xf5\x27\x9b\xf8\x88\x3c\xed\x6e\xf5\xbd\xe1\xae\x50\xd9\x68\x1c\xa5\xfc\xc1\xbc\x60\xfe\xfb\x1f\x4e\x8e\xd6\x18\x8b\x87\x63\x09\x5f\x97\xf0\xcd\x97\x1e\x7d\x3e\x97\x29\x0a\x95\x35\x81\xc0\x9b\x01\x4b\x78\x35\xfd\x94\x1c\x4f\xc6\xb7\xb8\x76\x22\x07\x9b\x99\x5b\x30\xe3\x88\xbe\xce\xd3\xb3\x3c\xcd\x2a\xe4\x60\x33\xeb\x79\xb9\xd0\x07\xe8\x64\x90\xf4\x23\xe6\x45\xb2\x98\x6b\x66\xe5\x32\x4f\x5e\xc0\x76\x7b\x32\xd3\x1c\x85\x8b\x8b\x45\xf5\xb4\xbb\x9d\x2b\x6b\xad\xff\x23\x5d\xc7\x4f\xd8\xd5\x05\x73\x96\x84\x4f\x3c\x45\xc8\x13\x79\xd8\x6c\xd4\xf1\x9f\x01\x00\xde\x4e\x9e\xef\xfd\x04\x00\x00")
+
+func templatesMetricsOzzoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMetricsOzzoTpl,
+		"templates/metrics/ozzo.tpl",
+	)
+}
+
+func templatesMetricsOzzoTpl() (*asset, error) {
+	bytes, err := templatesMetricsOzzoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/metrics/ozzo.tpl", size: 1277, mode: os.FileMode(420), modTime: time.Unix(1792419393, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesResourceEchoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x56\xdf\x6f\xdb\x36\x10\x7e\x16\xff\x8a\x9b\x50\x14\x62\xe0\xd2\x1b\x50\xf4\x21\x41\x1e\xd6\xd6\x4b\xbb\x76\x49\x90\xa4\x7b\x19\x86\x81\x21\x2f\x16\x11\x89\x74\xc8\x53\xd2\x40\xd0\xff\x3e\x90\x92\x3c\x2f\xf1\x8f\x6c\x1d\x86\x6e\x4f\xa6\xcd\xbb\xe3\x77\xdf\xf7\xf1\xcc\xb6\x7d\x01\xcf\x6a\xa7\xb1\x82\xfd\x43\x10\x3f\xc5\x95\x38\x96\x35\x42\xd7\xb5\x2d\x3c\xb3\x71\x19\x77\xce\x30\xb8\xc6\x2b\x5c\xdd\xbc\xc6\xfb\x95\xac\xef\x1b\x72\x1f\xf0\xbe\x0f\x78\xd1\x75\x6c\x21\xd5\xb5\x9c\x23\xd4\xd2\x58\xc6\x4c\xbd\x70\x9e\xa0\x60\x59\xae\x9c\x25\xfc\x4c\x39\xcb\x02\xe9\x70\x53\x41\xae\x25\xc9\x4b\x19\x70\x1a\x6e\xaa\x9c\x65\x39\x7a\xef\x7c\x88\x2b\x8b\x34\x2d\x89\x16\x71\x1d\xc8\x2b\x67\x6f\x73\xc6\xb2\x7c\x6e\xa8\x6c\x2e\x85\x72\xf5\xb4\x92\x97\x81\xa4\xba\x9e\xa2\x2a\x5d\xda\x6c\xdb\xd4\x4a\x53\x45\xa4\x7d\x4d\xce\xd8\x74\x0a\xcb\x96\xba\xee\x9c\x9c\x47\xf0\x28\x75\x00\x69\x35\xdc\x79\x43\x18\x80\x4a\x84\x21\x1d\x2b\x71\x21\x2f\x53\x0d\x08\xe8\x6f\x51\xc3\xe5\xfd\x18\x30\x96\x81\x52\x5a\x5d\xa1\x0f\x8c\xee\x17\x7f\xda\xe9\x0f\x30\x96\xd0\x5f\x49\x85\xd0\xb2\xec\xa3\x09\x54\x28\xfa\x0c\x03\x03\xe2\x4d\xff\xc9\xa1\xf8\xe5\xd7\x70\x53\x89\x98\xde\xab\xd1\x75\x13\x48\x2c\x70\x96\x1d\xe1\xda\xac\x09\x18\x0d\xc6\xd2\xab\x97\x1c\x8a\xbd\xcd\xe9\x6f\x3c\x4a\xc2\xf5\x15\x6a\x78\x94\xc8\xfb\x73\x59\xf6\x69\xa1\xff\x56\xde\x5b\xac\x90\x70\x17\xe2\x3e\xb8\x7b\x28\xcb\xbb\x81\xce\x9e\xf1\x8d\x7a\xa0\xd5\x0b\x67\x2c\x3d\xa6\xfd\x8f\x02\xe4\x1b\x45\x91\xf6\x90\x94\x58\x89\x49\xd2\x0c\x67\x7b\x9c\x9b\x40\xe8\x57\x5b\x39\x73\x4d\xf4\xc2\xb8\xb5\x1b\x05\xdc\x19\x2a\xc1\xb3\xab\xc6\xaa\x2d\x15\x0b\x0f\x7b\xd1\xa4\x62\xa6\x4a\x37\x81\xf5\xb8\x78\x84\x5c\xc6\x8b\xf5\x7c\x4d\x5b\x6d\x4a\xea\x58\xe6\xc5\xd1\xec\xa2\xc8\xdb\x76\xe5\x6e\x9e\x4a\x2a\xa1\xeb\xf2\x09\x94\xa2\x32\x81\x78\x0c\x3b\x3d\x39\xdf\x16\xa7\x92\x3b\xf8\xd6\x82\xd3\x7d\xa3\x53\xd1\x39\x0e\x35\x3f\xed\x8a\x6c\x92\x79\x52\xf0\xdb\xd9\xc7\xd9\xc5\x6c\x47\xbc\x4e\xa6\xe1\x51\x94\x44\x62\x51\xc2\xde\x9a\xf6\x39\xc4\xbe\x0a\x05\x89\xc6\xc1\x56\x83\x99\x22\x6f\x71\x37\x39\x3f\xf2\x57\x8a\x44\x96\xe8\xaf\x9d\x38\xc3\x9b\x06\x03\x15\x7c\xb4\x63\xc1\x39\xcb\xcc\x55\x0a\xff\xe6\x10\xac\xa9\x62\x89\xcc\x23\x35\xde\xc6\x5f\x59\x16\x89\xee\xbf\x2a\xf1\xe3\xf9\xc9\x71\x11\x67\x91\x38\x27\x49\x4d\x38\xf9\x30\x49\x68\x9e\x00\x7a\x8e\x5b\x30\x1b\xbd\x44\x3c\x4c\x38\x71\x2a\x7d\xc0\xf7\x96\x0a\x15\x97\xb2\x2e\x72\xa3\x73\x3e\x81\xef\xbe\x9d\xc0\xab\x97\x5b\x51\xc7\x33\x8e\xf1\xee\xdd\xc5\xc5\xe9\x2c\x9e\xb0\x8a\xf8\xb5\xd4\x03\x09\x13\xc8\x8d\xbd\x95\x55\xbc\x8f\x3a\xe7\xb1\x51\x96\xd5\x8f\x98\x3b\xc2\x0d\xc4\xc5\x9b\xbc\x84\xe1\x7c\x10\xef\x43\x81\xde\x47\x4f\xc7\x89\x2e\x66\xde\x1f\xbb\x33\x77\x17\xf8\x23\x70\x69\x8b\x7e\x70\x8d\xd5\x2c\xeb\x00\xab\x80\xf0\x65\x22\xd4\x4f\x50\x40\x0d\x43\x70\x93\x08\xb7\xd2\x43\x0d\x0f\x67\xda\x92\xe8\xfd\x43\x50\xe2\xb5\xb1\xba\x78\x5e\xf3\x83\xed\x60\xe3\x7f\xaa\xb9\x1a\x87\xc5\xcf\x91\x64\x49\xc6\xd9\x00\x5d\xc7\x56\x2b\xd6\xe3\x26\x16\xfc\xe0\x4b\xf5\x44\xef\x23\xeb\xce\x27\x57\xf7\x28\xd0\xea\x87\x67\x8e\xca\x8e\x7f\x0a\xeb\xc5\xdd\xdd\xe4\x66\x45\xfa\xca\xfa\x69\xb2\x34\x0b\xbd\x55\x96\xaf\xe6\x6e\xfc\x9f\xfd\x91\xd5\x62\x7c\xce\x75\x1d\x1c\x82\xd1\xbd\x4d\x7f\xfb\x8b\xf3\xe0\xe0\xdf\x19\x06\xeb\xec\x3c\xbe\x55\xfe\x79\x3b\x3f\x75\xc0\xe8\xe1\xd5\xf3\xd5\x3b\xf9\xbf\x25\xec\xf8\x98\xdc\x8e\x6d\x73\xcd\xf1\xab\x12\xc7\x2e\x89\x62\x69\x95\xab\xe5\x8f\x9c\x75\xbf\x0f\x00\x86\x1a\x64\xc4\x0c\x0d\x00\x00")
+
+func templatesResourceEchoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesResourceEchoTpl,
+		"templates/resource/echo.tpl",
+	)
+}
+
+func templatesResourceEchoTpl() (*asset, error) {
+	bytes, err := templatesResourceEchoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/resource/echo.tpl", size: 3340, mode: os.FileMode(420), modTime: time.Unix(1792415498, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesResourceGinTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xd1\x6f\xdb\xb6\x13\x7e\x16\xff\x8a\xfb\x09\x45\x21\x06\x0e\xfd\x1b\x50\xf4\x21\x45\x1e\xd6\x34\x4d\xb3\x66\x49\x10\xa7\x7b\x19\x86\x81\x11\xcf\x12\x51\x89\x74\x48\x2a\x69\x20\xf0\x7f\x1f\x48\x49\x9e\x1b\xcb\x49\xba\xad\xa8\xd1\xed\xc9\x84\x79\xfc\xee\xee\xbb\xef\xee\xa0\xb6\xdd\x85\x67\xb5\x16\x58\xc1\xde\x3e\xb0\x9f\xc3\x89\x9d\xf2\x1a\xc1\xfb\xb6\x85\x67\x2a\x1c\xc3\xcd\x05\x5a\xdd\x98\x1c\x57\x2f\x3f\xe2\xdd\xca\xab\x1f\x1b\xa7\xdf\xe3\x5d\x67\xb0\xeb\x3d\x59\xf0\xfc\x23\x2f\x10\x6a\x2e\x15\x21\xb2\x5e\x68\xe3\x20\x23\x49\x9a\x6b\xe5\xf0\x93\x4b\x49\x62\x9d\xb0\xd7\x15\xa4\x82\x3b\x7e\xc5\x2d\x4e\xed\x75\x95\x92\x24\x45\x63\xb4\xb1\xe1\xa4\xd0\x4d\x4b\xe7\x16\xe1\x6c\x9d\xc9\xb5\xba\x49\x09\x49\xd2\x42\xba\xb2\xb9\x62\xb9\xae\xa7\x85\x54\xbb\x85\x56\x32\x0f\xa7\x78\xd9\xb6\x31\x95\xa6\x0a\x91\x76\x98\x21\x51\x39\x07\x76\xa2\x8b\x42\xaa\x02\xbc\x5f\xb3\xab\xba\xab\xce\x16\x95\x08\x36\x94\x90\xe9\x14\x96\x4c\x78\x3f\x73\xda\x20\x18\xe4\xc2\x02\x57\x02\x6e\x8d\x74\x68\xc1\x95\x08\x3d\x1a\x56\xec\x92\x5f\x45\xd7\x60\xd1\xdc\xa0\x80\xab\xbb\xc1\x60\x80\x81\x92\x2b\x51\xa1\xb1\xc4\xdd\x2d\x3e\xbb\xe9\x1c\x48\xe5\xd0\xcc\x79\x8e\xd0\x92\xe4\x44\x5a\x97\xe5\xee\x13\xf4\xc4\xb1\x83\xee\x97\x42\xf6\xeb\x6f\xf6\xba\x62\xe1\x79\x57\x44\xef\x27\x10\xc9\xa3\x24\x39\xc2\xd1\x57\x13\x90\x02\xa4\x72\x2f\x5f\x50\xc8\x76\x36\x3f\x3f\x30\xc8\x1d\x8e\x23\xd4\xb0\xf6\x90\x76\x7e\x49\xf2\x61\x21\xfe\xd2\xbb\x37\x58\xa1\xc3\xc7\x22\xee\x8c\xfd\xfd\xb2\xbc\xeb\xe9\xec\x18\xdf\x58\x0f\x54\x62\xa1\xa5\x72\xeb\xb4\xff\x09\xe0\x4c\x93\xbb\x40\xbb\x8d\x95\x58\xb1\x89\xa5\xe9\x7d\x1b\x2c\xa4\x75\x68\x56\x53\xb9\xd0\x4d\xd0\xc2\x70\xf5\x78\x14\x70\x2b\x5d\x09\x86\xcc\x1b\x95\x3f\x80\x98\x19\x28\xa4\x62\xc7\x11\xdf\x4c\x60\x3c\x30\x1a\x62\x2e\x43\x43\x3e\x1f\xc9\xab\x8d\x8f\x3c\x49\x0c\x3b\x3a\xbc\xcc\xa2\xf4\x97\x3d\x7d\xce\x5d\x09\xde\xa7\x13\x28\x59\x25\xad\xa3\xc1\xec\xfc\x6c\xf6\x90\x5d\x1e\xe5\x41\x1f\x04\x9c\xee\x49\x11\x41\x0b\xec\x31\x3f\x3c\x66\xd9\x44\xf5\x44\xe3\x37\x87\x27\x87\x97\x87\x8f\xd8\x8b\xa8\x1a\x1a\xaa\x12\x59\xcc\x4a\xd8\x19\x49\x9f\x42\xc8\x2b\xcb\x61\x27\x30\xd9\xeb\x2a\x32\x16\xfe\x8f\xa2\x0f\xcc\x95\x2c\xd2\xc4\xba\x8e\x63\x17\x78\xdd\xa0\x5d\xea\x30\xa3\x94\x24\x72\x1e\x8d\xff\xb7\x0f\x4a\x56\xd0\x8e\x0e\x96\xa4\x1f\x25\xec\xad\xd1\xf5\xf0\x78\x0c\x8e\x1d\x06\x39\x67\xe9\x9c\xcb\x0a\x05\x38\x1d\xc3\x1c\xd1\x4c\x3a\x81\x6e\x28\xa6\x31\x56\xba\x3a\xa3\x92\x24\x67\x3f\xcd\xce\x4e\xb3\x30\x27\xd9\xcc\x71\xd7\xd8\xe3\x30\x3f\x14\xaf\x66\xa1\x1d\x4c\xf4\x32\x89\x22\x7a\xd7\xf6\x38\x7b\x01\xa7\xf7\x4f\x3d\x25\x49\x62\xd0\x35\x46\x91\xc4\x93\x11\xc0\xb3\xf7\x93\x18\xdb\x13\x98\x2e\x70\x94\x68\x29\x96\x34\xf7\x83\x9c\x9d\x73\x63\xf1\x58\x05\x6e\xce\xb9\xe1\x75\x96\x4a\x91\xd2\x09\xfc\xf0\xff\x09\xbc\x7c\x31\x42\xf6\x58\xaa\xaf\xb9\xe8\x89\x5d\xcb\x30\x95\xea\x86\x57\x61\x78\x88\xf4\x5e\x8a\x24\xa9\xd7\xaa\x7e\x84\xa3\x55\x0a\xe3\x67\x19\x8b\x36\x96\x1d\xdb\x0c\x4d\xec\xc3\xb0\xbd\x02\x87\xa7\xfa\x42\xdf\x5a\xba\x21\xc2\x53\xed\xde\xea\x46\x89\xf5\xf8\x94\x76\x30\x0f\x57\xf7\xc2\x03\xac\x2c\xc2\x57\x96\x5a\x81\x5b\xab\xb4\xfa\x09\x32\xcb\xfb\xfd\xb4\xae\xb4\x1b\x6e\xa0\x86\xfb\x8b\x66\xa9\xa6\xbd\x7d\xc8\xd9\xac\xd4\x4d\x25\x5e\x4b\x25\xa2\xff\xe7\x35\x7d\xf5\x39\xd9\x5f\x28\xb5\xcd\x29\x0e\x55\xeb\x96\xc0\x2f\x41\x8f\xdc\x49\xad\x6c\xa8\xdf\x6a\x50\xf5\x70\x89\xd9\x57\x0d\xa6\x2f\xe6\xaa\xeb\xa1\x03\x86\x9d\x3f\xd6\x04\xeb\x14\xfd\xc3\x7a\xec\x0a\xba\x8d\x92\xec\x68\x11\x4f\xd3\x65\xb3\x10\x1b\x74\xb9\x6d\x13\xf0\xbf\x3e\x79\x4a\x9f\x24\x35\x1b\x3e\x76\xbc\x87\x7d\x90\xa2\x8b\xe6\xf7\x2f\xda\x1f\xaf\xbe\xb3\xe5\xd1\xa9\xfc\x9b\x37\xeb\xd8\x10\x1b\x3e\x40\xbe\xc9\x10\xdb\x12\x5e\xfe\xc6\x5e\x15\xfd\x77\xd8\xf6\xcf\xaf\x7f\x7d\x13\x76\xa5\xda\xca\x26\x1c\xbe\xe6\x1f\xaa\xc4\xf7\xce\x4b\x92\xf7\x30\xab\x90\xa7\xfa\x40\x2b\x87\xca\x51\xe2\xff\x18\x00\xc0\xe4\xaa\x53\x7e\x13\x00\x00")
+
+func templatesResourceGinTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesResourceGinTpl,
+		"templates/resource/gin.tpl",
+	)
+}
+
+func templatesResourceGinTpl() (*asset, error) {
+	bytes, err := templatesResourceGinTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/resource/gin.tpl", size: 4990, mode: os.FileMode(420), modTime: time.Unix(1792418641, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesResourceHandlers_testTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x4d\x6f\xe3\x36\x10\x3d\x93\xbf\x62\x56\xa8\x01\xa9\x90\x65\xf4\x9a\x45\x2e\x49\x9b\xa6\x2d\xe2\x35\x62\xf7\xd2\xa2\x28\x18\x69\x2c\xb3\x96\x49\x9b\x1c\xad\xb3\x2b\xf0\xbf\x17\x43\xc9\xa9\x83\x64\x53\x1b\xbb\x17\x99\x5f\xf3\xde\x9b\x4f\x77\xdd\x18\xbe\xdb\xd8\x0a\x1b\xb8\xb8\x84\xe2\x8e\x57\xc5\x54\x6d\x10\xc6\x21\xc8\xad\x2a\xd7\xaa\x46\xd8\x28\x6d\xa4\xd4\x9b\xad\x75\x04\xa9\x14\x89\x41\x9a\xac\x88\xb6\xc9\xd1\x3a\x7e\x08\x3d\xf1\xa1\x27\xa7\x4d\xed\x79\xc9\x47\xda\xd4\x89\x64\x2e\xbd\x04\xdc\x41\x71\x8f\xde\xb6\xae\xc4\xe2\xc6\xa9\x0d\xee\xad\x5b\x43\x52\x6b\x93\x40\x08\x52\x8a\xa4\xd6\xb4\x6a\x1f\x8a\xd2\x6e\x26\xb5\x36\xe3\xda\x1a\x5d\xf2\xaa\xc7\xc0\xc6\xe3\x5b\x40\x58\xae\xec\x2b\x48\x8d\x7a\xf0\xa4\xca\xf5\x24\xde\x9f\x84\xa4\x9d\xf6\xaf\x20\xad\x15\x29\xa7\xfc\x24\x5e\x9f\x04\x64\x3f\x7f\x7e\x4d\x52\x6d\xc7\x7c\x33\xe1\xcf\xd8\xd9\xb6\x8f\xd3\xff\x3e\x99\x94\xd6\x10\x1a\x1a\xc2\x61\xaa\x01\xb9\xeb\x62\x06\xdb\x06\x21\x84\x89\xdf\x35\x89\xcc\xa4\x5c\xb6\xa6\x84\x05\x7a\xea\xba\x43\xaa\x43\xb8\x55\xa6\x6a\xd0\xf9\x94\xe0\xfb\x21\x41\xc5\x22\x83\x4e\x0a\x4f\xd6\x21\x17\x83\xdf\x35\xc5\x14\xf7\x37\x6a\x8d\x73\x3e\x4b\xb3\x62\x20\xc0\xa6\x98\x35\xad\x53\x0c\x74\x6a\x56\x45\xad\x4d\x31\x47\x62\xf3\x94\xd7\xac\x88\x37\x99\x14\x8e\xe9\xf8\x6c\x8a\xfb\x94\xf7\x58\x6b\x4f\xe8\x8e\x05\xdf\xdb\x96\xd0\xa7\x2e\x87\x28\x30\x3b\xaf\x12\x7a\x0a\x4e\xfc\x37\xe7\x78\xaa\x91\x9e\x83\xb7\x67\x71\x08\xc6\x76\xd1\xd6\x15\x57\xad\x6e\xaa\x34\x7b\x1f\x4f\xde\x5d\x82\xd1\x0d\xe7\x44\x50\x71\xa3\x48\x35\xcb\x34\x59\x2a\xdd\x60\x05\x64\xe1\x81\xdf\x02\xad\x10\xb8\x2c\xd0\x5d\xc0\xc8\x27\x39\x5b\x66\x52\x84\xf3\xca\xb2\xd7\x3e\x94\xd7\x93\xfc\xe2\x77\x8f\xe9\x50\x6b\xc5\xe2\xd3\x16\xa7\x58\x5b\xd2\x8a\xac\x7b\x3a\xfe\x75\xfe\x61\x9a\x9d\x1d\xcf\x27\x4a\x9e\x19\xcc\x37\x47\xf7\x11\xef\xda\xc7\xf3\x52\x73\xa8\xfc\x07\x5b\x7d\x62\x07\xba\x0e\xb6\x4e\x1b\x5a\x42\x32\xda\x25\x90\x5a\x77\xa8\xd7\xb9\xda\x6c\x1b\x84\xa4\x0b\x49\xc6\x36\x82\x8b\xde\xb3\xcd\x9f\x7f\x79\x72\x6d\x49\x31\xce\x77\x48\x2b\x5b\x41\x3f\xbd\xa4\x10\x33\x45\x2b\x80\xff\xf6\x57\x4c\x74\xb4\x9f\x93\xa2\xd6\x83\x36\x24\x45\x60\x80\x2e\x3a\xd4\xc3\xcc\xac\xa7\x1c\x92\xae\x3b\x0a\x7e\x04\x0c\x21\xc9\x81\x35\xe7\x10\x9f\xf7\x28\xd7\x0e\x15\x61\x15\xf2\x43\x4b\x0d\xd2\xff\x40\x67\x7f\x31\x1f\x55\xa3\xa3\xb7\xe7\x70\xb0\xbb\xcf\x38\xae\x54\x75\x8f\xbb\x16\x3d\x85\xfc\x38\x84\xcf\x41\x7f\xc6\xb7\x30\x9f\x23\x7e\xf8\x2d\xe4\x27\x9b\x4f\x7e\x38\x09\x60\xd6\xbe\x09\xf0\x22\x72\xaf\x40\xfc\x88\x0d\x12\x9e\x21\x63\x6a\xaf\xfb\x8a\xfe\x4a\x77\xa6\x96\x6e\x6c\x6b\xaa\xaf\x76\xea\x8b\x40\x6f\xe8\x79\x7c\xa9\xe7\x59\xc6\x05\x37\xcb\xd2\x3a\xf8\x3b\x07\x6e\x00\xae\x7f\xa7\x4c\x8d\x71\xe7\x63\x0b\x38\xdc\x1d\x1a\x93\x0f\xb9\x39\x07\x80\x34\xee\x7b\x6f\x7a\xfb\x48\x9c\x0f\xed\xe0\xfb\xa7\xaa\x42\xd7\xbf\xe4\x66\xe1\xd1\x20\x1c\xee\x8a\xdb\x78\xc1\xff\x00\x69\x32\x44\x7a\xcc\x23\x85\x15\xab\xed\xb6\xd1\xa5\x22\x6d\xcd\xe4\x1f\x6f\x4d\xc2\x46\xfb\x97\x2a\x4a\xeb\x18\x3c\x42\x16\x71\x62\xdc\x2e\x16\xb3\x74\x9f\x83\xc3\x5d\x26\xa5\xe0\x59\xba\x2f\xae\x6d\x85\xf0\xee\xb2\x57\x38\x74\x28\x7b\x26\xa8\xf8\xc9\x39\xeb\x96\x69\x32\xf2\x30\xf2\x17\x80\x8f\x5b\x2c\x09\xb9\xe1\xe3\xab\x51\xf5\x1e\x54\x49\xad\x6a\x60\x54\x0d\xf3\xf4\x4b\x4e\x1f\xa1\xe7\x03\x29\xff\x46\xa7\xa5\x10\x41\x8a\x20\xc3\xbf\x03\x00\x54\xf1\xc7\xaf\x5f\x09\x00\x00")
+
+func templatesResourceHandlers_testTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesResourceHandlers_testTpl,
+		"templates/resource/handlers_test.tpl",
+	)
+}
+
+func templatesResourceHandlers_testTpl() (*asset, error) {
+	bytes, err := templatesResourceHandlers_testTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/resource/handlers_test.tpl", size: 2399, mode: os.FileMode(420), modTime: time.Unix(1792417772, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesResourceIrisTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4d\x6f\xdc\x36\x13\x3e\x4b\xbf\x62\x5e\x21\x08\xc4\x60\xa3\xbd\xbc\xe8\x21\x81\x0f\xad\xdb\xa4\x6e\x13\xd7\xb0\xdb\x5e\x8a\xa2\xa0\xc5\x59\x89\xb0\x44\xca\xe4\xc8\x1f\x10\xf4\xdf\x0b\x92\xd2\xae\x9c\xd5\xae\xe3\xa0\xed\x06\x69\x4f\x16\xcc\xf9\x7c\xe6\x99\x87\xcb\xae\x7b\x09\xcf\x6a\x2d\xb0\x82\x57\x47\x90\xbd\x77\x5f\xd9\x29\xaf\x11\xfa\xbe\xeb\xe0\x99\x72\x9f\xee\xe4\x1c\xad\x6e\x4d\x8e\xd3\xc3\x2b\xbc\x9f\x78\x7d\xdd\x92\xfe\x11\xef\x83\xc1\xcb\xbe\x8f\x1b\x9e\x5f\xf1\x02\xa1\xe6\x52\xc5\xb1\xac\x1b\x6d\x08\xd2\x38\x4a\x72\xad\x08\xef\x28\x89\x23\x4b\xc2\x5e\x57\x90\x08\x4e\xfc\x92\x5b\x5c\xda\xeb\x2a\x89\xa3\x04\x8d\xd1\xc6\xba\x2f\x85\xb4\x2c\x89\x9a\x24\x8e\xa3\xa4\x90\x54\xb6\x97\x59\xae\xeb\xe5\x15\x27\x6e\xb8\x5d\x4a\x23\xad\x3f\xeb\x3a\x5f\x7e\x5b\xb9\xea\x42\x1c\xd7\x9c\x5c\x41\xf6\x4e\x17\x85\x54\x05\xf4\xfd\x96\x5d\x15\x8e\x82\x2d\x2a\xe1\x6c\x58\x1c\x2f\x97\xb0\xee\xbe\xef\x2f\x48\x1b\x04\x83\x5c\x58\xe0\x4a\xc0\xad\x91\x84\x16\xa8\x44\x18\xa2\x61\x95\xfd\xcc\x2f\x7d\x6a\xb0\x68\x6e\x50\xc0\xe5\xfd\x68\x30\x86\x81\x92\x2b\x51\xa1\xb1\x31\xdd\x37\x0f\x4e\x42\x02\xa9\x08\xcd\x8a\xe7\x08\x5d\x1c\xbd\x93\x96\xd2\x9c\xee\x60\x00\x2b\x3b\x0e\x7f\x19\xa4\xbf\xfd\x6e\xaf\xab\xcc\xb9\x87\xc1\xf5\xfd\x02\x3c\x60\x2c\x8e\xde\xe2\xac\xd7\x02\xa4\x00\xa9\xe8\xab\xff\x33\x48\x5f\xec\x76\x3f\x36\xc8\x09\xe7\x23\xd4\xb0\xe5\xc8\x42\xde\x38\xfa\xa5\x11\x9f\xe4\xf7\x2d\x56\x48\xf8\x58\xc5\xc1\xb8\xff\x70\x2c\xdf\x0f\x70\x06\xc4\x77\xce\x03\x95\x68\xb4\x54\xb4\x0d\xfb\x26\x00\x99\x36\x27\x07\xbb\xf5\x93\x98\xd8\xf8\xd1\x0c\xb9\x0d\x16\xd2\x12\x9a\x69\x2b\xe7\xba\x75\x5c\x18\x8f\x1e\xaf\x02\x6e\x25\x95\xc0\x9b\x26\x5e\xb5\x2a\xdf\x13\x33\xe5\x4d\x03\x8e\xde\xd9\x19\x37\x74\xbf\x80\xf9\xda\x98\x2b\xbb\x74\x7b\xf8\x7c\xa6\xb5\xce\x3b\xf5\x71\xc4\x9b\x26\x73\xe4\xf0\xfc\x5f\x2f\xf3\x19\xa7\x12\xfa\x3e\x59\x40\x99\x55\xd2\x12\x0b\x86\x67\xda\xee\xb3\xcc\x3d\x4b\xd8\x23\x41\x97\x9d\x14\xc1\xbe\xc0\x75\xe0\xf6\x51\xe3\xb6\x11\x9b\xe0\x03\x3f\x1e\x71\x11\xde\x8a\x0d\x53\x5a\x71\x59\x81\x41\xdb\x68\x25\x06\xb4\x2d\x71\x6a\xc3\xee\xba\xf1\x78\x3e\x41\x8d\xd6\xf2\x02\xc3\x18\xd2\x12\x5e\xcc\xc0\xc7\x7c\x34\xcf\x4f\x3f\x89\x35\x39\x87\x88\x52\x39\x82\x87\x40\x8e\x45\x52\x15\x7e\x1e\x39\xdd\x65\x17\xde\xe4\x58\x0b\x4c\x83\x35\x0b\xff\xff\xe1\xe2\xa7\xd3\xd4\x47\x7b\xcf\x9b\x2e\x48\x5d\xf2\x6a\x8c\xd2\xfb\x36\xf6\xd7\x54\x8d\xda\x30\xad\xc9\xe7\x75\x27\x7e\x9b\x1d\x1f\xca\xcc\x0f\x3f\x1b\xa5\x24\x3b\xc7\xeb\x16\x2d\xa5\x6c\xf4\x49\x19\x8b\x23\xb9\xf2\x0e\xff\x3b\x02\x25\x2b\xe8\x66\x55\x33\x1a\x74\x32\x7b\x63\x74\x3d\x3a\xef\x0a\x99\x7d\xe7\x5a\x4a\x13\x07\x1d\x0a\x20\x0d\xae\xac\x99\xa5\x48\x16\x30\xb4\xef\x6b\x66\x53\x11\x8e\xa2\x32\x1b\xb1\x5f\x80\xbb\x01\x06\x40\x4f\x9c\x4a\x2a\x5e\x5d\xb8\xa5\x37\x3e\x95\xf7\x1e\xb2\xba\x8e\x22\x83\xd4\x1a\x15\x47\xfd\x04\x71\x57\xc3\x47\x60\x5b\xe0\x0e\x68\xa5\x58\x03\xeb\x62\x9e\x71\xc3\x6b\x9b\x32\xc7\xff\x13\x27\x53\x69\x22\x45\x32\x03\xe7\xce\x46\xbe\xe1\x62\x40\x6f\x01\x89\x54\x37\xbc\x72\x8a\x27\x92\x87\x0d\xc4\x51\xbd\x35\xd0\xb7\xb8\x13\x7c\x27\x9b\xeb\x22\xb4\xb1\xd9\x89\x4d\xd1\x18\x27\x1e\xee\xa6\x75\x20\x9d\xea\x73\x7d\x6b\xd9\xbe\xd2\x4e\x35\xbd\xd1\xad\x12\x0b\x48\x94\x26\x58\xb9\xef\x87\x75\x01\x56\x16\xe1\x1f\xa0\x4e\x81\x07\x67\x4e\xfd\x11\xb4\xc9\x37\x17\xe7\x16\x73\x6e\xb8\x81\x1a\x3e\xbc\x03\xd7\x4c\x19\x08\x75\x8e\x5c\xf8\x74\xcf\x6b\xf6\xfa\x13\x28\xb4\xb3\x91\x71\x28\x01\xc1\x5f\x1d\xcd\x38\x49\xad\xac\x03\x6b\x5a\x45\x3d\x1e\x62\xfa\xd7\x57\x30\x0c\x67\x9a\x6f\xa4\xf3\xe6\x47\xc7\x3c\xa3\xb7\x01\xf9\x1b\x78\x16\x06\x78\x40\xaa\x4d\xae\x8b\x49\xa0\x80\x8d\x60\x4f\xa4\x63\xdb\x88\x9d\x74\x3c\x98\x90\xfd\xb7\x07\x9b\x3d\x88\xea\x6c\x7c\x41\xf5\x3d\x1c\x81\x14\xa1\x84\x3f\x9e\x2c\xf6\xaf\xbf\x14\xa5\x0f\x9c\x3d\xcc\x06\xce\xc9\xd2\xe6\x4d\x73\x40\x59\x3a\x24\x28\x4f\x93\x1c\xb1\x79\xca\x7d\x3e\x92\xf3\xef\xde\xa8\x30\x92\xcf\x67\xa3\x36\xaf\xfd\xfd\xd8\x7f\xb1\xa0\xec\xb9\xe8\x4f\xf5\xb1\x56\x84\x8a\x58\xdc\xff\x39\x00\x68\x4e\x26\x4e\x95\x13\x00\x00")
+
+func templatesResourceIrisTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesResourceIrisTpl,
+		"templates/resource/iris.tpl",
+	)
+}
+
+func templatesResourceIrisTpl() (*asset, error) {
+	bytes, err := templatesResourceIrisTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/resource/iris.tpl", size: 5013, mode: os.FileMode(420), modTime: time.Unix(1792418641, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesResourceOzzoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x6f\xdc\x36\x10\x3d\x8b\xbf\x62\x2a\x04\x81\x64\xac\xb5\x2d\x10\xe4\x10\xd7\x05\xda\x34\x8d\x83\xb6\x0b\xc3\x71\xdb\x43\x51\x14\xb4\x38\x96\x88\x48\xe4\x9a\x1c\xd9\x71\x04\xfe\xf7\x62\x28\x69\xbd\xb1\x37\xbb\xb1\xdb\xa2\x1f\xc8\xc5\xa6\xcd\x99\xe1\xe3\x7b\x6f\x46\xec\xfb\x7d\x78\xd4\x5a\x85\x0d\x3c\x3b\x84\xe2\x47\x5e\x15\x0b\xd9\x22\x84\xd0\xf7\xf0\xc8\xf0\x92\x77\x4e\xd0\xdb\xce\x95\xb8\xbe\xf9\x06\xaf\xd7\xb2\xbe\xee\xc8\x7e\x8f\xd7\x43\xc0\x7e\x08\x62\x29\xcb\x37\xb2\x42\x68\xa5\x36\x42\xe8\x76\x69\x1d\x41\x26\x92\xb4\xb4\x86\xf0\x2d\xa5\x22\xf1\xa4\xfc\x45\x03\xa9\x92\x24\xcf\xa4\xc7\xb9\xbf\x68\x52\x91\xa4\xe8\x9c\x75\x9e\x57\x06\x69\x5e\x13\x2d\x79\xed\xc9\x95\xd6\x5c\xa6\x42\x24\x69\xa5\xa9\xee\xce\x8a\xd2\xb6\xf3\xca\xee\xdb\x77\xef\xec\x9c\x7f\xec\x3b\xdb\x91\x36\x55\x8c\xe9\xfb\x78\xa3\xae\x61\xc0\x43\xe9\x5c\x88\xf9\x1c\x56\x37\x0b\xe1\x35\x59\x87\xe0\x50\x2a\x0f\xd2\x28\xb8\x72\x9a\xd0\x03\xd5\x08\x63\x3a\x36\xc5\xa9\x3c\x8b\x35\xc0\xa3\xbb\x44\x05\x67\xd7\x53\xc0\x54\x06\x6a\x69\x54\x83\xce\x0b\xba\x5e\xbe\xb7\x33\x1c\xa0\x0d\xa1\x3b\x97\x25\x42\x2f\x92\x1f\xb4\xa7\xac\xa4\xb7\x30\x12\x51\x3c\x1f\x7e\xe7\x90\xfd\xfa\x9b\xbf\x68\x0a\x4e\x1f\x44\x09\x61\x06\x91\x8c\x5c\x24\x2f\x71\x63\xd6\x0c\xb4\x02\x6d\xe8\xe9\x93\x1c\xb2\xbd\x0f\xa7\x3f\x77\x28\x09\x37\x57\x68\xe1\x4e\x62\x3e\x9c\x2b\x92\x9f\x96\xea\x41\x79\xdf\x62\x83\x84\xbb\x10\x0f\xc1\xe1\xb6\x2c\x47\x23\x9d\x03\xe3\x1f\xd4\x03\x8d\x5a\x5a\x6d\xc8\x1f\x80\x43\xbf\xb4\xc6\xa3\x07\xe9\x90\xab\xb1\x92\x84\x06\x3a\xaf\x4d\x15\x0b\x44\xf8\x86\x20\x4a\x64\xb0\xb2\xa4\x25\xdd\xc8\xc9\xd6\x41\x77\x47\xc0\x1b\x28\xe4\xba\x92\x58\x40\x1f\x35\x5d\x8b\x89\x22\x8f\xb7\x70\x58\x69\x4f\xe8\xd6\x49\x39\xe1\xd2\x7e\xb5\xb5\xfb\x3e\x70\xa5\xa9\x06\x27\xce\x3b\x53\x6e\xa9\x98\x39\xd8\x1b\x1d\x5f\xc4\x33\xdc\x0c\x36\x83\xcb\x19\x77\xcd\xed\xfa\x78\xc3\xdd\xfa\x98\x14\x44\xe2\x0a\x76\x59\xda\xf7\x6b\x1d\x7f\x2c\xa9\x86\x10\xd2\x19\xd4\x45\xa3\x3d\xe5\x1c\x76\x6c\xfd\xb6\xb8\x32\x9a\x2d\xdf\x5a\x70\xfe\xa5\x56\x5f\xc5\xaa\x15\x8e\x45\xbb\x9d\xa1\x5d\x74\x63\x8c\x1e\x0d\xb6\x23\x41\xc5\xa8\x9c\xc5\x89\x64\x66\x35\xec\x6d\x60\x20\x07\xbe\x5a\x56\xde\xf0\x39\x9a\x75\xb4\x28\xd3\xc7\x11\xb1\x9f\x98\xc6\xba\x88\x9c\x15\x43\x33\x17\x27\x78\xd1\xa1\x5f\x59\x3c\xcb\x73\x91\xe8\xf3\x18\xfc\xd9\x21\x18\xdd\x70\x81\xc4\x21\x75\xce\xf0\x7f\x45\xc2\x6c\x0f\x7f\x96\xc5\x2f\x3c\x75\x32\xae\xff\x11\x40\x2b\xdc\x81\x53\xab\x15\xca\x71\x64\x16\xc7\xd2\x79\x7c\x65\x28\x2b\x79\x29\xdb\x2c\xd5\x2a\xcd\x67\xf0\xc5\xe7\x33\x78\xfa\x64\x1b\xd6\xe9\x98\x05\x5e\x1d\x9d\x9e\x1e\xbf\xe0\x43\x32\x9e\xc8\xc5\x6b\x92\xd4\xf9\x6f\xa4\x1a\xef\x3e\x83\x54\x9b\x4b\xd9\x70\x73\xab\x34\xe7\x1b\x8a\xa4\xbd\x43\xd8\x4b\xdc\xc8\x17\x0f\x85\x15\x0e\xeb\x7c\xf1\xca\x67\xe8\xa2\x9f\xf9\x1b\x51\xbc\x70\x6e\x61\x4f\xec\x95\xcf\xef\x83\x6e\x61\xe9\x3b\xdb\x19\xc5\x68\x00\x1b\x8f\x70\x5f\x51\xda\x8f\x50\xa4\x1c\x47\xeb\x36\x51\x2e\xa5\x83\x16\x6e\x4f\xcb\x15\xf1\xcf\x0e\x81\x49\x91\x2a\x7b\xdc\xe6\x07\x7f\x81\x16\xe8\x1c\x73\x66\x5d\xb4\x62\x10\xfc\xa5\xd7\xe7\xd3\xc4\xf9\x99\x65\x92\xa4\xad\xf1\x10\x82\x58\x87\xd1\x4e\x9b\x98\xfd\x6d\x40\xd0\xa8\xdb\xc7\x4e\xf6\x98\x3e\x53\x9b\x1c\xb2\x85\x9a\x49\x3d\x26\x71\xf8\x10\x0c\x4d\x75\x84\x52\xe1\x7b\xd8\x86\x03\xd8\x11\x0f\x50\xba\x5b\xaa\x9d\x4a\xff\x9b\xda\xef\x93\xeb\xee\xba\x2e\x69\x8b\xe9\xf5\x1a\x02\x1c\x82\x56\x03\x92\xdf\xef\x35\xaa\x0e\xfe\xe1\x39\xb5\xa9\x77\xa6\xa7\xda\x03\x7b\xe7\x01\xfd\xa0\xc6\x47\xde\x7f\xa5\x1f\xfe\x17\x32\x4f\x2f\xeb\x6d\x90\xff\xd4\x88\x5c\xd8\xa8\xa2\xa1\x9b\x21\x69\x74\x23\xc2\x1f\x03\x00\xf8\xa7\xf8\x7d\x2e\x0e\x00\x00")
+
+func templatesResourceOzzoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesResourceOzzoTpl,
+		"templates/resource/ozzo.tpl",
+	)
+}
+
+func templatesResourceOzzoTpl() (*asset, error) {
+	bytes, err := templatesResourceOzzoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/resource/ozzo.tpl", size: 3630, mode: os.FileMode(420), modTime: time.Unix(1792415498, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesResourceStdlibTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xdd\x6f\xdb\x36\x10\x7f\x96\xfe\x8a\x9b\x50\x14\x52\xa1\xd2\x1b\x50\xf4\xa1\x45\x1e\xd6\xd6\xfd\x58\x53\x27\x88\xd3\xee\xa1\x28\x06\x46\x3c\xdb\x5c\x25\x52\x21\x29\x7f\x40\xd0\xff\x3e\x90\x94\x1c\x27\x96\x13\x67\xeb\xd6\x64\xdb\x93\x19\xf1\x3e\x7f\xf7\xbb\x3b\x29\x75\xfd\x18\x1e\x14\x92\x61\x0e\xcf\x0e\x80\x7c\xb0\x27\x32\xa2\x05\x42\xd3\xd4\x35\x3c\x10\xf6\x68\x6f\x4e\x50\xcb\x4a\x65\xb8\x79\xf9\x15\x57\x1b\x5a\x3f\x57\x46\xbe\xc7\x95\x17\x78\xdc\x34\x61\x49\xb3\xaf\x74\x8a\x50\x50\x2e\xc2\x90\x17\xa5\x54\x06\xe2\x30\x88\x32\x29\x0c\x2e\x4d\x14\x06\xda\x30\x7d\x9e\x43\xc4\xa8\xa1\x67\x54\xe3\x40\x9f\xe7\x51\x18\x44\x28\x32\xc9\xb8\x98\x0e\x7e\xd7\x52\xb8\x07\x4a\x49\xa5\xed\x49\xa0\x19\xcc\x8c\x29\xed\x59\x1b\x95\x49\x31\x8f\xc2\x30\x88\xea\xda\x85\x5f\xe5\x36\x3a\x6f\xc7\x26\xc7\x27\x40\x0e\xe5\x74\xca\xc5\x14\x9a\x66\x4b\x2e\xf7\x57\x5e\x16\x05\xb3\x32\x49\x18\x0e\x06\xb0\xce\xbe\x69\xc6\x46\x2a\x04\x85\x94\x69\xa0\x82\xc1\x42\x71\x83\x1a\xcc\x0c\xa1\xb5\x86\x39\x39\xa5\x67\xce\x35\x68\x54\x73\x64\x70\xb6\xea\x04\x3a\x33\x30\xa3\x82\xe5\xa8\x74\x68\x56\xe5\xa5\x1b\xef\x80\x0b\x83\x6a\x42\x33\x84\x3a\x0c\x0e\xb9\x36\x71\x66\x96\xd0\x82\x45\x5e\xfa\xdf\x04\xe2\xcf\x5f\xf4\x79\x4e\xac\xba\x2f\x5c\xd3\xa4\xe0\xf0\x49\xc2\xe0\x0d\xf6\x6a\xa5\xc0\x19\x70\x61\x9e\x3e\x49\x20\x7e\xb4\x5b\xfd\xa5\x42\x6a\xb0\xdf\x42\x01\x5b\x8a\x89\xf7\x1b\x06\x1f\x4b\xf6\xa7\xf4\x5e\x61\x8e\x06\x6f\x8a\xd8\x0b\x37\x57\xcb\xf2\xb6\x85\xd3\x23\xbe\xb3\x1e\x28\x58\x29\xb9\x30\xdb\xb0\x5f\x18\x30\xaa\xca\x8c\x85\x5d\xbb\x4a\x6c\xc8\xb8\xd2\xb4\xbe\x15\x4e\xb9\x36\xa8\x36\x53\x39\x91\x95\xe5\x42\x77\x75\x73\x14\xb0\xe0\x66\x06\x45\xb5\x0c\x27\x95\xc8\xae\xb1\x19\x17\xd5\x12\x1e\x59\xaa\x93\xb1\x4d\xf0\x43\xb5\x4c\xa1\x3f\xbe\xc4\x86\x3e\xb3\xbd\xf8\xb0\x27\xbd\xda\x29\x35\x61\x50\x54\x4b\xe2\x73\x7e\x5d\x89\x2c\x8e\xde\x0c\x4f\x2d\x1c\x17\xbd\x7d\x4c\xcd\x0c\x9a\x26\x4a\x61\x46\x72\xae\x4d\xb2\xad\x73\x7c\x34\xbe\x46\x29\x73\xfc\x49\xf6\x77\x35\xa8\x39\xf3\xfe\xa6\xd8\xeb\xee\xe3\x4d\x7a\x55\xc9\xfa\x5d\xbe\x1a\x1e\x0e\x4f\x87\x37\x68\x33\x47\xc0\x64\x5d\x60\x5d\xca\x8b\x06\x9f\x03\xf5\xf5\xfc\x65\x7c\x34\x82\x33\xc9\x56\x20\x27\xee\x81\x17\xd4\xe8\x6b\x18\xcf\xe0\x51\x0f\xee\x49\x67\x2f\x5e\x80\x2b\xe3\x49\xab\xf5\xab\x35\xaf\x6c\x31\xa9\xa9\xb4\x65\x79\x0a\xf3\x8b\xf6\xaf\x1b\x57\xcf\x05\x79\x8b\x94\xa1\x8a\x13\x32\x46\x13\x47\xae\xa1\x84\x79\x7c\xba\x2a\x31\x4a\x21\xa2\x65\x99\xf3\x8c\x1a\x2e\x85\x1f\x93\x49\x18\x2c\x88\xb3\xdd\x2a\x7a\xfb\x49\x18\xd8\x6b\x32\xc2\xc5\xd0\x8e\x55\x54\xf1\x22\x21\xfe\x18\xcf\xbb\xd4\x27\x94\xe7\x5d\xbc\x2d\x47\xdb\xf0\xec\xc4\xb3\x39\xbb\x2e\x84\x02\xb5\xa6\xd3\x9b\x12\xb7\xd6\xf6\xc9\xba\xb5\x66\x1b\x90\x8b\xa9\x4b\x7b\x46\xd6\xa8\x75\xb2\x29\x14\xb4\xfc\xec\x65\xbe\xf8\x9f\x3a\x72\xe1\x44\xcf\x3a\x13\x8d\x4b\xe4\xfa\xa8\x2c\xa5\x77\x45\xa5\xda\x56\x3b\xc1\xf3\x0a\xb5\x71\xa1\x58\x79\x37\x1b\x6d\x67\xcd\x88\x6b\x23\xe2\x06\xb3\xea\xc6\x54\x9c\x24\x61\xc0\x27\x4e\xe8\x87\x03\x10\x3c\x87\xba\x77\xef\x04\xed\xa6\x21\xaf\x95\x2c\x3a\xe5\x4d\x33\x64\x68\x13\x8a\x23\x0b\x1d\x32\x30\x12\xac\xfb\x9e\x51\x62\x6b\xef\x93\x77\xb1\x25\x9b\xab\x2b\x08\x66\xc4\x1a\x88\x17\xa9\x4f\x73\xec\x6a\xf8\xce\x52\x4b\xd0\xdc\xcd\x11\xe5\x1c\x39\xdd\xd6\xa7\xcd\x21\x50\x68\x2a\x25\xc2\xa0\xb9\x52\x82\x0d\x33\x47\xef\x53\x17\xd4\x1e\x50\x4f\xf1\x56\x48\x73\xb6\xc6\xb9\xdd\xe9\xe4\x98\x2a\x8d\xef\x84\x05\xc9\x76\xed\x27\x9a\x57\x18\x47\x9c\x45\x49\x0a\x3f\xfd\x98\xc2\xd3\x27\x3d\xc8\xef\xc8\xff\x05\x65\xad\xb7\x14\x22\x2e\xe6\x34\xb7\xcb\x85\x45\x97\xf3\x0e\x83\x62\xab\xda\x6f\xf0\x52\x95\xec\x56\x5a\xbb\x95\x4a\x93\x77\x3a\x46\xe5\x5a\xd9\xbe\xc8\x58\x3c\x47\xf2\x44\x2e\x74\xb2\x3b\x98\x91\x34\xaf\x65\x25\x58\x0a\x91\x90\x06\x26\xf6\x7c\x39\x12\xc0\x5c\x23\xfc\x4d\xac\x9a\xe2\xdd\x23\x55\xb1\x07\xa3\xfc\x6a\xb9\x0d\xa9\xe6\x54\x41\x01\x57\xdf\x3d\xd6\xa4\x79\x76\x00\xdd\x5c\x7c\x85\x7e\x2e\x2a\xf2\x42\xb2\x55\x42\xfc\xdf\xf1\xc3\x22\x79\x7e\x6b\x7e\xed\x44\xa0\xab\x9f\x07\xfe\x93\xe5\xa0\x1b\xde\xda\x62\xbc\x19\x55\xd1\x5d\x62\xfc\xad\xfd\xb7\x15\xdd\xf4\xd6\x31\xbd\x7d\xf5\xbb\x44\xf6\x6d\x00\xbe\x11\x09\x7d\x31\xef\x14\x0f\x7d\xfe\x6c\x3f\x32\xfa\x97\x8e\x7b\x38\xe1\xfe\xef\x89\x5d\x3d\x11\x14\xa4\xfb\xa6\x6d\x1a\x38\x00\xce\x7c\x00\xbf\xed\xb5\x13\x9e\xdf\xdf\x85\xe0\xb9\xfc\x3d\x7a\xb1\x6f\x0c\xb5\x5f\x92\xff\xcc\x18\xfa\x7e\xa9\xff\xe5\x75\xe8\x3f\x5c\xee\xe1\x04\xfa\x2f\xb4\x94\x2f\xce\x5d\x69\xa9\xf6\x9f\x2c\xbd\xf8\xfe\x8b\x52\xbf\xf2\xf1\xbb\x61\x67\x24\x5f\x4a\x61\x50\x98\x24\x6c\xfe\x18\x00\x66\xf9\x0f\xfb\xf1\x14\x00\x00")
+
+func templatesResourceStdlibTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesResourceStdlibTpl,
+		"templates/resource/stdlib.tpl",
+	)
+}
+
+func templatesResourceStdlibTpl() (*asset, error) {
+	bytes, err := templatesResourceStdlibTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/resource/stdlib.tpl", size: 5361, mode: os.FileMode(420), modTime: time.Unix(1792418641, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServerGrpcTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x5f\x6f\xdb\x36\x10\x7f\x26\x3f\xc5\xcd\x4f\x52\x21\xcb\xcd\x80\xbd\xa4\xf5\x80\x2d\x1b\xba\x6c\x6d\x57\x2c\x19\xf6\x10\xe4\x81\xa5\x4e\x32\x61\x9a\x34\xc8\xb3\x93\x20\xf0\x77\x1f\x8e\xa2\x64\xc5\x4d\xda\x3e\x59\x3a\xdd\xfd\xee\x7e\xf7\xd7\x5b\xa5\xd7\xaa\x43\xd8\x28\xe3\xa4\x34\x9b\xad\x0f\x04\x85\x14\x33\xed\x1d\xe1\x3d\xcd\xa4\x98\x61\x08\x3e\x44\x7e\xb2\xbe\xe3\x1f\x87\x49\xee\x93\xcc\xc7\x45\x34\x9d\x53\x96\x5f\xe2\x43\xd4\xca\xa6\x47\x32\x1b\x9c\x49\x29\x66\x9d\xf7\x9d\xc5\xba\xf3\x56\xb9\xae\xf6\xa1\x5b\x74\x61\xab\x67\x2f\x7e\x59\xac\x11\xb7\xca\x9a\x3d\xce\x64\x29\xa5\xf6\x2e\xa6\x90\x16\x0b\xd0\xde\x39\xd4\x64\xbc\xbb\x36\x1b\xf4\x3b\x82\xcf\x7e\xe7\x9a\x08\xb4\x42\x58\x29\xd7\xc4\x95\x5a\x23\xf8\x16\x14\x38\xbc\x9b\xe8\x4b\xf1\xa5\xed\x12\x7e\x82\x57\xc0\x71\xd6\x57\xa8\xbd\x6b\xa4\x58\x2c\xc0\x34\x16\x4f\xd0\x39\x20\xe3\x3a\x50\x13\x40\xb8\x33\xb4\x62\x1d\xa5\xc9\xec\x11\x98\x76\x04\xbf\x45\x27\xc5\x14\x62\x09\x67\x3f\xbe\x3e\x71\x53\x4a\xb9\x58\x40\x5c\xed\xa8\xf1\x77\xa7\x54\x9a\xa0\x8c\x63\x6f\xcc\xc9\xb8\x79\x6b\x4d\xb7\xa2\x01\xdf\x8d\x66\x72\xaf\xc2\x17\x18\x4b\x38\x3b\xe5\x34\x75\xf5\x87\xf7\xeb\x08\x61\xe7\xc0\x38\x08\xb8\xc7\x10\x11\x7c\x68\x30\x70\xce\x02\x76\x26\x52\x50\x9c\xa3\x17\x3d\xf5\x10\x37\xb7\xed\xce\xe9\x42\xd3\x3d\xe4\x46\xa9\x2f\xfa\xdf\x12\x52\xbb\x24\x86\xde\x5d\x65\xab\x8c\x8d\x21\xc2\xca\xfb\x35\x90\x4f\x51\x78\xa7\x31\xd5\x2e\x62\xd8\x63\x00\x93\xe9\x63\x53\x01\xd6\x5d\x0d\xe4\x19\xa7\xb5\xbb\xb8\x02\x42\x8b\x1b\xa4\xf0\x20\xd9\xf7\x04\xbc\x48\x90\xdf\x08\xa8\x84\x47\x29\x9e\x92\x58\x82\xda\x6e\xd1\x35\xc5\x13\x71\x95\x22\x2c\xe5\x21\x53\xb8\xb0\x3e\xe2\x24\x7e\x9d\xde\xbf\x9f\x40\x6f\xc0\x58\xac\xd7\x28\x52\x9f\x55\xc4\x81\x43\x42\x2f\x92\x0a\xb0\xa8\x98\x86\x3b\xa1\x98\xbe\x3d\xcf\x8c\x35\x45\x40\xda\x05\xd7\xfb\x2a\x4a\x29\x0e\x03\x81\x3e\xb0\xbf\xb7\x5c\xd4\x1c\x7c\x3f\x2e\xdc\xa4\xa0\x5c\x03\x91\x94\xb5\xd8\x4c\x7a\x3b\x72\x37\xa8\x6c\x5a\xb1\x12\x23\x19\x97\x34\xb3\xb5\x23\x0c\x1a\xb7\xe4\x43\x1c\x50\x28\xa6\x19\xb4\x5c\x65\xdf\x82\xa1\xd8\x77\x6d\xcf\xf5\x49\x20\x45\x09\x37\xb7\x3c\xec\xf5\xd5\x44\xcc\x4c\x32\x91\x67\xbe\x32\xcd\x24\xbc\x18\x03\xcd\x6d\x5f\xe8\x53\x49\x59\x0d\xca\x7f\x0d\xcb\xe4\x93\x0a\x6a\x13\x8b\x71\xb9\x64\xec\x24\x46\xae\xec\xe3\x07\x75\x7f\x84\xbe\x6c\x2c\x9e\x4f\x97\xc1\xa1\xac\xe4\xe3\xe3\x1c\x4c\x0b\xf5\x7b\xdf\x75\x3c\xa2\x87\xc3\x18\xd3\x4a\x19\xf7\xaf\x53\xe1\xe1\xf2\x98\x99\x62\xc7\x02\x56\xc6\x70\x8c\x28\xa9\x5e\x51\x40\xb5\x99\xea\xc6\x24\x19\x95\xd9\x15\xba\x06\x0e\x87\xd1\xeb\x07\xa4\x60\x74\xfc\x3e\xaf\x59\xf9\x3b\xdd\x1e\xb5\x9f\xf1\x7b\x1d\x94\x1e\xd9\x52\x50\x1a\x2f\xb8\xac\xc5\x53\x6d\x71\x98\x76\x1c\x4f\x47\x84\x18\xf6\xbc\x48\x54\xd3\x04\xd8\x39\x32\x16\xae\x2e\xdf\x5d\x7e\xbc\x06\x1f\xf8\xe9\xfa\xf7\x7f\x3e\xf0\xc8\x04\xd4\x68\xf6\x3c\xf4\xb4\x42\xd7\xaf\x80\x38\x4c\xcc\xe9\x0a\xe4\xbd\x6b\x8e\xcb\x29\x57\x27\xf5\x60\xf2\xc9\x36\xc3\xc7\x34\xca\xb1\x62\xa8\xbe\xaf\x98\x86\x72\x79\x6e\xee\xd8\x19\x87\xd8\x2a\x63\x23\xef\x24\xcb\x33\xee\x26\xfd\x5a\xf0\xe7\x57\x93\x56\xac\x7a\x32\x91\x82\x71\xdd\x64\x00\xad\x89\x15\xbf\xc1\xf9\x12\x1c\x52\xfd\x3e\x21\x15\x33\xd2\xdb\x59\x6f\x53\x4a\x61\xda\xa4\xf2\xc3\x12\x9c\xb1\xd3\xb1\x4d\x30\xb1\xfe\xd3\x1b\x57\x60\x08\xd5\x48\x60\x1c\xf9\x5f\x95\x5e\x77\x81\x2f\x44\x51\x96\x3c\xdf\x52\x0a\x4d\xf7\x15\x44\xf2\x5b\x76\xda\xdf\xe0\xfa\xa3\x27\xd3\x3e\xe4\x05\xf1\xac\x75\x05\x3e\xd6\xa9\xf1\xc2\x6e\x4b\x15\xe4\x93\x5d\xe7\x7a\x94\x52\x34\xd8\x62\x48\xc0\x45\x29\xa5\xc0\x10\x22\x7b\xd8\xa8\x35\x16\x7a\x35\xa4\xaf\x82\xb3\x52\x8a\xce\x0f\x6b\x8b\xe9\x24\xd5\xb7\x73\xae\x7b\x9f\xaf\xc2\x9a\xc8\xd1\x26\xa0\x88\x16\x35\x31\x6f\xad\x22\x32\x0a\x2c\xe1\xed\x9c\x8d\xce\xb3\xec\xed\x5c\xd3\x7d\xfd\x9b\x77\x58\x94\xe7\x52\xf0\x3d\xe6\x3d\xc4\x57\x33\x33\x04\xc2\xb0\x31\x4e\x11\x46\x30\x9b\x0d\x36\x46\x11\xda\x07\x29\x44\x0e\x58\x08\xeb\xbb\xfa\x53\x30\x8e\xac\x2b\x66\x9c\x49\xe2\xb2\x73\x3f\xd4\x75\x3d\xe3\x48\x44\x5e\xd2\x4f\x69\x45\x0a\x3b\x4d\x8f\x87\x52\x8a\x13\x5e\x82\x09\xbd\xe3\xce\x6f\x77\xf6\x6a\xf0\x23\xd2\x36\x2d\x32\x16\x4b\x7a\x9e\x13\xa2\x03\xab\xac\x73\x7e\x94\xa4\x13\xfd\x4b\x4b\x18\x8a\x93\x56\x4e\xc4\x93\xc7\xd1\xd3\x61\x52\x70\xad\x9c\x46\xcb\x91\x0f\xd5\xfd\xcf\xd0\x2a\xdb\xbe\x50\xf1\x53\x0f\x43\x8d\x7b\xac\xa2\x1c\xd7\xee\x57\x1a\x91\xee\xcb\xf1\xa6\x64\xe1\x4b\x03\xf7\xcd\x7f\x17\x79\xc2\xb2\xd1\x57\xae\x36\xe7\x90\xff\xe7\xa4\xc6\xba\xb9\x4d\x32\x29\x5a\x1f\xc0\x70\x06\x2c\xba\xa7\xc7\xbb\x84\x39\x9c\xbd\x01\x03\x3f\x2f\xe1\xf5\x1b\x30\xf3\xf9\xb1\x2f\xc7\x73\xcf\x60\x47\x66\xe9\xe8\xdf\x98\xdb\xcc\x50\x1c\x5e\x4a\x46\xac\xeb\xba\x94\x87\xff\x07\x00\xc2\x9d\x0a\x81\x32\x0b\x00\x00")
+
+func templatesServerGrpcTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerGrpcTpl,
+		"templates/server/grpc.tpl",
+	)
+}
+
+func templatesServerGrpcTpl() (*asset, error) {
+	bytes, err := templatesServerGrpcTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/grpc.tpl", size: 2866, mode: os.FileMode(420), modTime: time.Unix(1792420141, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesServerHttpTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x4f\x6f\x1b\xb7\x13\x3d\x2f\x3f\xc5\x40\x87\x1f\x76\x03\x69\x15\xff\x8a\x5e\x9c\xa8\x40\x9a\x16\xb5\x8b\x36\x28\x62\x03\x39\x04\x39\xd0\xe4\x68\x97\x10\x45\xaa\x43\xae\x24\xc3\xf0\x77\x2f\x86\xcb\x5d\xaf\x65\xab\xa9\x2e\x5a\x92\xc3\x37\xf3\xde\xfc\xe1\x4e\xaa\x8d\x6c\x10\xb6\xd2\x38\x21\xcc\x76\xe7\x29\x42\x29\x8a\x99\xf2\x2e\xe2\x31\xce\x44\x31\x43\x22\x4f\x81\xbf\xac\x6f\xf8\xcf\x61\x5c\xb6\x31\xee\xf8\xdb\xa7\x03\x1f\x96\xc1\x34\x4e\x5a\x5e\x84\xfb\xa0\xa4\x4d\x9f\xd1\x6c\x71\x26\x2a\x21\x94\x77\x21\x01\x2f\x97\x40\x28\xf5\x15\x4a\x8d\x74\x6b\xb6\xe8\xbb\x08\x77\xbe\x73\x3a\xa4\x03\xe3\x1a\x88\x2d\x42\x9b\x0c\x02\xf8\x35\x48\x20\xfc\xbb\xc3\x10\x45\xf1\xf2\xea\x0a\x7e\x84\x37\xc0\x7e\xea\x1b\x54\xde\xe9\xd1\xc5\x19\x70\x09\x87\xd6\x5b\x1c\x30\xe7\x60\x9c\xb2\x5d\x3a\x32\x31\xc0\x9d\xd7\xf7\xa2\x98\xde\x5f\xc1\x0f\x6f\x5f\x71\x71\x20\x13\xf1\xc4\x07\xef\x31\x10\x47\x1c\x76\xde\x05\x14\xc5\x33\xbb\x33\x58\x46\xdb\x17\x50\xb2\x87\x5a\x7b\x4a\x7a\x38\x3c\xc6\x21\xe6\x5e\x94\x0d\xe2\x6e\x21\xad\xd9\x63\xc2\x50\xde\x39\x54\xd1\x78\x27\x8a\x29\xde\x0a\x2e\xfe\x7f\xea\xb3\x12\x62\xb9\x84\xd0\x76\x51\xfb\x83\x3b\xf1\xac\x49\x1a\x37\xa4\xc1\xb8\xc5\xda\x9a\xa6\x1d\x7d\x07\xf0\x6e\xbc\x29\xf6\x92\x5e\xc0\xac\xe0\xe2\x34\x25\x53\x6f\x57\xde\x6f\x02\x50\xe7\xc0\x38\x20\xdc\x23\x05\x04\x4f\x1a\x89\x69\x11\x36\x26\x44\x92\xcc\xe3\xac\xa7\x1e\xe2\xeb\xb7\x75\xe7\x54\xa9\xe2\x11\x72\xb1\xd6\x1f\xfb\xff\x0a\x52\xc9\x26\x92\xde\xdd\xe4\x5b\x19\x1b\x29\x40\xeb\xfd\x06\xa2\x4f\x51\x78\xa7\x30\x29\x1c\x90\xf6\x48\x60\xb2\x02\xa8\xe7\x80\x75\x53\x43\xf4\x8c\xb3\xb6\x5d\x68\x21\xa2\xc5\x2d\x46\xba\x17\xec\x7b\x02\x5e\x26\xc8\xef\x04\x54\xc1\x83\x28\x9e\x93\x58\x81\xdc\xed\xd0\xe9\xf2\xd9\xf6\x3c\x45\x58\x89\xc7\x4c\xe1\xa3\xf5\x01\x27\xf1\xab\xb4\xfe\xef\x04\xfa\x0b\x8c\xc5\x76\x5a\x46\x79\x27\x03\x0e\x1c\x12\x7a\x99\x4c\x80\xb7\xca\x69\xb8\x13\x8a\xe9\xec\x75\x66\x6c\x59\x10\xc6\x8e\x5c\xef\xab\xac\x44\xf1\x38\x10\x70\x78\xb8\xe9\xc5\x55\x84\x32\x62\x00\x39\x04\xeb\xd7\xd0\x4a\xa7\x2d\x12\x58\x4e\x4e\x2a\x3c\xef\x40\x6a\x4d\x7d\x7c\xe3\xe5\x92\xf7\x20\x44\x32\xae\x99\x8f\xb7\x78\x0e\xd5\x57\xfd\xa2\x82\x37\x69\x99\x9d\x3d\x88\x21\xa4\xff\x4d\xb6\x39\xd2\x0f\x5a\xd3\x25\x3c\xfb\x31\xf8\x5c\x14\x45\x86\x9a\x9e\x66\x57\x7c\xfa\xf9\x74\xfe\x5c\xbe\x9c\x66\x83\xdd\x68\xc1\x18\x30\x1d\x49\x6c\xf1\x65\x32\x16\xb2\xc9\x74\x52\xb0\xc9\xb5\xb6\x27\x16\x30\x69\xec\xb9\x28\x1e\xb3\xbe\x49\x4b\xae\x85\x00\x81\xf6\xd0\xb9\x68\x2c\xdc\x5c\xff\x76\xfd\xe9\x16\x3c\xf1\xd7\xed\xaf\x9f\xff\xe4\xc2\x20\x54\x68\xf6\x5c\xda\xb1\x45\xd7\x17\x7a\xe0\xea\xe7\x34\xbd\xd2\xeb\x07\x13\x5b\xf3\xd4\x85\xd9\x35\x48\xa7\x7b\x77\x5c\x4e\xc3\x61\xaa\xd9\x30\x67\xa4\x5e\x76\x4e\xa5\x74\xb9\x40\x0e\xec\x8f\xa3\x5b\x4b\x63\x03\x37\x5f\x9f\x6f\x0e\x90\x11\x80\x21\xfa\x8c\x27\x3a\x25\xdb\x4e\xd3\x39\xa9\x34\x15\x8f\x73\x08\xd1\xef\xe0\x72\x05\xfd\xbb\x53\x7f\xf2\xd1\xac\xef\x73\x55\x8e\x55\xfa\xb3\x54\x9b\x86\x78\xa2\x96\xd5\x1c\x7c\xa8\xaf\x5d\x44\xa2\x6e\x17\xe7\x90\x9f\xa9\x3a\xcb\x53\x89\x42\xe3\x1a\x29\x01\x97\x95\x10\x05\x12\x05\xf6\xb0\x95\x1b\x2c\x55\x3b\x50\x99\xc3\x45\x25\x8a\xc6\x0f\xbd\xc2\x05\x95\x4c\xdf\x2f\x58\xfe\xfa\x8f\xc4\xeb\x83\xd3\x29\xee\xd4\x08\x09\x8e\x67\x25\x12\xe5\xd9\x54\x04\xb4\xa8\x22\x37\x8e\x92\x01\x79\x17\x56\xf0\x7e\xc1\x48\x97\x79\xef\xfd\x42\xc5\x63\xfd\x8b\x77\x58\x56\x97\xa2\xe0\x29\xcf\x8d\xc3\xcf\x46\xa6\x0d\x11\x69\x6b\x5c\xea\x29\xb3\xdd\xa2\x36\x32\xa2\xbd\x17\x45\x91\x59\x14\x85\xf5\x4d\xfd\x17\x19\x17\xad\x2b\x67\xac\x74\x7a\x56\x58\xed\xba\xae\x67\x1c\x9d\xc8\x8a\x2a\xe9\x14\x5a\x66\x3c\xc8\xf7\xc5\xc4\x36\xe7\xfc\x8c\xa4\x27\x95\x31\x8a\xd8\x63\x25\xde\x66\xdd\x93\x5b\x81\x33\x76\x50\x0b\x56\x49\xab\x71\xb6\xa8\x78\x4c\xa1\x0c\x1d\x9b\x44\x0a\xf5\xef\xde\xb8\x12\x89\x9e\x1c\xf1\x78\xad\x86\xc1\x32\x6c\x9e\x2b\xc6\xef\x3e\x31\xb9\xe0\xf2\xa5\x7f\x19\xdd\xf0\x30\x26\x90\x5f\x9e\x9c\x43\x7e\x9c\x0d\x0b\x66\xd1\x3d\x9f\xe0\x15\x2c\xe0\xe2\x1d\x18\xf8\x69\x05\x6f\xdf\x81\x59\x2c\x9e\xea\x64\x9c\xf9\x0c\xf6\xc4\x2c\x4d\xfe\xaf\xe6\x5b\x66\x78\x5e\x8c\x50\xd7\x75\x25\x1e\xff\x19\x00\x88\x28\x94\xc9\xbb\x09\x00\x00")
+
+func templatesServerHttpTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesServerHttpTpl,
+		"templates/server/http.tpl",
+	)
+}
+
+func templatesServerHttpTpl() (*asset, error) {
+	bytes, err := templatesServerHttpTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/server/http.tpl", size: 2491, mode: os.FileMode(420), modTime: time.Unix(1792418225, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSql1DownTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\xce\xc1\x4d\xc5\x30\x10\x84\xe1\x3b\x55\x4c\x03\x7e\x15\x20\x2a\xe0\x00\x7a\x0d\x64\x63\x0f\xf1\x0a\x7b\x0d\xde\x0d\x69\x1f\x25\xe2\x80\xf4\x0a\x98\xef\x9f\x94\xf0\xd6\x24\x13\xf7\xf7\x57\x78\x48\xb0\xd3\xc2\x11\x55\x02\x32\x89\xdd\x59\x10\x03\x93\x3f\x9c\x81\xa8\x44\x91\x90\x55\x9c\xf0\x5c\xd9\x05\x5d\xb7\x29\xa1\xc3\x9e\x52\xc2\xa1\x51\xd5\x10\x55\x1d\x1f\xda\x78\xc3\x7d\x5f\x9d\xdf\x3b\x2d\x1e\x06\x98\xa3\xb5\x55\xf2\xa7\x5f\xad\xaf\xf3\x49\xf9\x23\x4e\xec\xaa\x8d\xc3\x2e\x09\x79\x52\x82\x05\xd2\x86\x6d\xae\x85\xa0\xe4\x8a\x25\x0f\x73\x6a\xfb\xc7\x1a\x0f\x3c\x9b\x74\xbe\x2c\xb7\xdf\x01\x00\x88\xbc\x6f\x4f\xe2\x00\x00\x00")
+
+func templatesSql1DownTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSql1DownTpl,
+		"templates/sql/1.down.tpl",
+	)
+}
+
+func templatesSql1DownTpl() (*asset, error) {
+	bytes, err := templatesSql1DownTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/1.down.tpl", size: 226, mode: os.FileMode(420), modTime: time.Unix(1792412527, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSql1UpTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\xcc\xc1\x89\xc3\x30\x10\x05\xd0\xfb\x56\xf1\x1b\x90\x2b\x58\xb6\x82\x3d\xec\xe2\x06\x3c\x96\x7e\xac\x01\x69\x44\x3c\x23\x0c\xa9\x3e\x04\x02\xb9\x3f\x5e\x4a\xf8\x6b\x92\x89\xf5\xff\x17\x1e\x12\xec\xb4\x70\x44\x95\x80\x9c\xc4\x74\x16\xc4\x80\x9a\x86\x4a\xd3\x07\x11\x95\x28\x12\xb2\x8b\x13\x9e\x2b\xbb\x7c\xa5\x84\x4b\xa3\xaa\x21\xaa\x3a\x6e\xda\xb8\x60\x9d\xbb\xf3\x3e\x69\xf1\x66\xe8\x7a\x9c\x12\x3a\xcc\xe1\x75\xcc\x56\xb0\x13\xf9\xa4\x04\x0b\xa6\xab\x1d\xaf\x69\xcb\xc3\x9c\xda\x3e\x1c\xc6\x0b\xdf\x26\x9d\x3f\xdb\xf2\x1c\x00\x74\x75\x1f\xd0\xb4\x00\x00\x00")
+
+func templatesSql1UpTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSql1UpTpl,
+		"templates/sql/1.up.tpl",
+	)
+}
+
+func templatesSql1UpTpl() (*asset, error) {
+	bytes, err := templatesSql1UpTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/1.up.tpl", size: 180, mode: os.FileMode(420), modTime: time.Unix(1792412527, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlCockroachdb1DownTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\xce\x4d\x4a\x03\x41\x14\xc4\xf1\xfd\x9c\xa2\x2e\xd0\xb9\x80\x22\x28\x46\x08\x04\x8c\x4e\x16\x2e\xf3\xa6\xbb\x4c\x37\xf6\x47\xec\xf7\xc6\xf1\xf8\x92\xc1\x85\xe0\x01\xea\xf7\x2f\xe7\x70\xc8\xe2\x89\xf1\x65\x0f\x35\x31\x16\x56\x53\x58\x14\x83\x74\x62\x56\x06\x58\x43\xe7\x17\xbb\xc1\x22\x11\xc4\x64\x12\x25\xd4\x47\x16\x41\x49\xe7\x2e\x96\x5a\x1d\x9c\xc3\x92\x2c\xa6\x0a\x8b\x49\xf1\x9e\x32\x37\x18\xe7\x49\xf9\x39\xb3\xda\xbf\x01\x7a\xcb\x79\x12\xff\xa1\x6b\xeb\x72\x7d\x12\x7e\x89\x2b\xb6\xd6\xda\x52\x57\x09\xbe\x53\x8c\x01\x92\x5b\x3d\x6b\x0a\x04\xc5\x47\x9c\x7c\xab\xca\x94\xff\xb0\x95\x0b\x6e\xab\x14\xde\x9d\x36\x83\x73\x83\x73\x78\x7c\x7d\x3e\xe0\x78\xff\xb0\xdf\x62\xf7\x84\xed\xdb\x6e\x3c\x8e\xe0\xb7\x94\x4b\xe6\xcd\xcf\x00\xe0\xb2\x9f\xb4\x06\x01\x00\x00")
+
+func templatesSqlCockroachdb1DownTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlCockroachdb1DownTpl,
+		"templates/sql/cockroachdb/1.down.tpl",
+	)
+}
+
+func templatesSqlCockroachdb1DownTpl() (*asset, error) {
+	bytes, err := templatesSqlCockroachdb1DownTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/cockroachdb/1.down.tpl", size: 262, mode: os.FileMode(420), modTime: time.Unix(1792412527, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlCockroachdb1UpTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\x90\xd1\x6e\xd3\x40\x10\x45\xdf\xf3\x15\xf7\xb1\x91\x70\x7e\x00\x84\x94\x62\x57\x8a\x70\x4a\x48\x1c\x89\xf2\x92\x8e\x77\xa7\xf1\x90\xf5\x6e\xd8\x19\xd7\x2d\x5f\x8f\x4c\x1b\xf2\xbc\x67\xcf\x9d\x7b\x8b\x02\x9b\x40\x8e\xb1\xfb\x5e\x43\x8d\x8c\x7b\x8e\xa6\xb0\x8e\x0c\x94\x19\x83\xb2\x87\x25\x48\x14\x13\x0a\xf2\x87\x61\x1d\xc3\x93\x51\x4b\xca\x50\xd7\x71\x4f\xb3\xa2\xc0\x28\xd6\x49\x84\x75\xa2\x78\x92\xc0\x0b\xec\x86\x56\xf9\xf7\xc0\xd1\xde\x31\xf4\x72\xcc\x64\x92\xa2\x42\xbb\x34\x04\x8f\x96\xe1\x32\x93\xb1\xc7\xa0\x12\x8f\x93\xe9\xd1\xa5\xa8\x2c\xe1\x8a\x23\xf2\x88\x4f\x91\x7a\xfe\xfc\xb8\x98\x15\xc5\xac\x28\xf0\x25\xb9\x53\x4e\xe4\xba\xf2\x16\x74\x3e\x07\x61\xbd\xc4\xb8\x8e\xe2\x91\x15\xa4\x48\x31\x48\x64\xb4\xe4\x4e\xc7\x9c\x86\xe8\xf1\x2b\xb5\x0a\x8a\x1e\x3e\xb1\x4e\xa2\x98\x0c\x14\x42\x1a\x51\x96\xf5\xbf\x97\x72\x5d\x4f\x9d\x5b\x46\x2f\x2f\xec\xaf\xd5\x18\x4a\x3d\xc3\x32\x45\x25\x37\x5d\xb6\xc0\x26\xf3\x13\xe7\x49\xb4\xdf\xaf\x4a\x9c\xb3\xf4\x94\x5f\x71\xe2\x57\x45\x7a\xe6\x8c\xb7\x0d\x1c\xeb\xe4\xa4\xe7\x24\x1e\x63\x16\x63\x74\xc9\xa0\xe7\x64\xfa\xbf\xd2\xb6\x5a\x36\x15\x9a\xe5\x6d\x5d\x61\x75\x87\xfb\x6f\x0d\xaa\x1f\xab\x5d\xb3\x03\xbf\x50\x7f\x0e\x8c\x9b\x89\x03\x00\xf1\x6f\x79\x13\x73\xbf\xaf\x6b\x6c\xb6\xab\xf5\x72\xfb\x80\xaf\xd5\x03\xca\xea\x6e\xb9\xaf\x1b\x1c\x39\x1e\x32\x45\x9f\xfa\xc3\x30\x88\xbf\x99\x7f\xb8\x7c\x7f\x1f\xfd\x40\x86\x66\xb5\xae\x76\xcd\x72\xbd\x69\x7e\x5e\x6d\x17\x43\x4c\xe3\xcd\x7c\x56\x14\x98\x7f\xfc\x3b\x00\x4f\x34\x05\x75\x2c\x02\x00\x00")
+
+func templatesSqlCockroachdb1UpTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlCockroachdb1UpTpl,
+		"templates/sql/cockroachdb/1.up.tpl",
+	)
+}
+
+func templatesSqlCockroachdb1UpTpl() (*asset, error) {
+	bytes, err := templatesSqlCockroachdb1UpTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/cockroachdb/1.up.tpl", size: 556, mode: os.FileMode(420), modTime: time.Unix(1792412527, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlDbTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\xcc\x31\x8e\xc2\x30\x10\x05\xd0\x7a\xe7\x14\x5f\xa9\x76\xb7\x20\x77\x88\xd2\x73\x06\x27\xfe\x49\x2c\x82\xed\xcc\x8c\x85\x10\xe2\xee\x08\x24\xfa\xa7\x57\xc3\x7c\x09\x2b\x61\xc7\x2e\x92\xae\xb5\xa8\xa3\x8b\xc1\xc3\x14\x8c\xbd\x1d\x7b\x27\xd2\xf7\x18\x07\x28\xbd\x69\x36\xf8\x46\x7c\x01\x4a\x65\x66\xc4\x74\xc7\xb9\x32\x63\x29\x8a\x66\xc4\x2d\xf9\xf6\x81\x2b\x33\x35\x38\xe3\x3b\x51\xd6\x62\xc9\x8b\x26\x9a\x2c\x2d\xcf\x18\x87\xdf\x3f\xfc\xdb\xb1\x9f\xc6\x01\x0f\xf9\x51\x7a\xd3\x8c\x38\xc9\xf3\x35\x00\x00\xd2\xa2\x6e\x99\x00\x00\x00")
+
+func templatesSqlDbTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlDbTpl,
+		"templates/sql/db.tpl",
+	)
+}
+
+func templatesSqlDbTpl() (*asset, error) {
+	bytes, err := templatesSqlDbTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/db.tpl", size: 153, mode: os.FileMode(420), modTime: time.Unix(1792414900, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlEntGenerateTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x51\x00\xae\xff\x70\x61\x63\x6b\x61\x67\x65\x20\x65\x6e\x74\x0a\x0a\x2f\x2f\x67\x6f\x3a\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x67\x6f\x20\x72\x75\x6e\x20\x2d\x6d\x6f\x64\x3d\x6d\x6f\x64\x20\x65\x6e\x74\x67\x6f\x2e\x69\x6f\x2f\x65\x6e\x74\x2f\x63\x6d\x64\x2f\x65\x6e\x74\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x2e\x2f\x73\x63\x68\x65\x6d\x61\x03\x00\x8a\xf4\xa7\xa5\x51\x00\x00\x00")
+
+func templatesSqlEntGenerateTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlEntGenerateTpl,
+		"templates/sql/ent/generate.tpl",
+	)
+}
+
+func templatesSqlEntGenerateTpl() (*asset, error) {
+	bytes, err := templatesSqlEntGenerateTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/ent/generate.tpl", size: 81, mode: os.FileMode(420), modTime: time.Unix(1792415925, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlEntSchemaTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x3f\x4f\xc3\x30\x10\xc5\x67\xdf\xa7\x38\x45\x42\x6a\xa5\x92\xec\x6c\x0c\xb0\xc1\x02\x1b\x62\x70\x93\x4b\x6a\xe1\xd8\xa9\x7d\x1d\x2a\xeb\xbe\x3b\xb2\x53\x68\xe9\x1f\xb6\xf8\xdd\xdd\x7b\xbf\xbb\x4c\xba\xfd\xd2\x03\x61\x6c\x37\x34\x6a\x00\x33\x4e\x3e\x30\x2e\x40\x55\xe4\x78\xf0\xb5\xf1\x0d\x39\xae\xce\xde\x4d\x67\xb4\xa5\x96\x73\x2d\x6e\xed\x45\x79\xb6\xbb\x21\x37\xbd\x21\xdb\x55\xb0\x04\x68\x1a\x4c\x09\xeb\x17\xdf\x91\xad\x5f\xf5\x48\x28\x82\x1b\x6f\xbb\x88\xbc\x21\x24\xc7\x07\x32\xf4\x7d\x51\x8e\xdd\xef\x7a\x6d\x4b\x3b\x97\x8f\x36\x90\x66\xea\x70\xbd\xcf\x7d\xd9\x78\x34\x43\xd0\x6c\xbc\x8b\xc0\xfb\x89\xae\x04\x45\x0e\xbb\x96\x31\x81\x22\xc7\xf5\x5b\x09\x02\x29\x54\x8f\xce\x79\x9e\xa7\x71\xd4\x53\xbc\x32\xcd\xfe\x5f\x22\xe8\x77\xae\xc5\xc5\xc5\xdc\xf2\xd4\x7b\xb1\xc4\x8f\xcf\x79\xc3\xfa\x28\x67\xa2\x40\xbc\x0b\xee\x5a\x35\x81\xca\xbc\x71\x6b\x4f\xc5\x72\x8e\x87\x4c\x33\x05\xe3\xb8\xc7\xea\x6e\x5b\x9d\x93\xc9\x0a\x94\x1c\x36\x7c\xce\x3f\x21\xe6\xbb\x5e\x20\xde\x46\x9f\x87\x0a\x75\x3e\x59\x79\xfe\xa1\xfd\x55\x13\xa4\x74\x8f\x41\xbb\x81\x7e\x5c\x0e\x89\x22\xa0\x54\xce\x7c\x72\x5c\x24\x14\x59\x95\x6e\x72\x1d\x8a\x80\x12\x90\xef\x01\x00\xda\x0b\x75\xa2\x98\x02\x00\x00")
+
+func templatesSqlEntSchemaTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlEntSchemaTpl,
+		"templates/sql/ent/schema.tpl",
+	)
+}
+
+func templatesSqlEntSchemaTpl() (*asset, error) {
+	bytes, err := templatesSqlEntSchemaTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/ent/schema.tpl", size: 664, mode: os.FileMode(420), modTime: time.Unix(1792415970, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlGooseMigrationGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xcf\xb1\x4e\xc3\x30\x10\x06\xe0\x39\xf7\x14\x27\x4f\x09\xaa\xe2\x81\x27\x40\xcc\x30\x21\x76\xd7\x39\x12\x8b\xc4\x76\xef\x2e\x25\x28\xf2\xbb\xa3\xd2\x20\x44\xbb\xa0\xae\xfe\xed\xdf\xdf\x9f\x9d\x7f\x77\x3d\xe1\x14\x7a\x76\x1a\x52\x14\x80\x30\xe5\xc4\x8a\x35\x54\xc6\xa7\xa8\xb4\xa8\x81\xca\x74\x4e\xdd\xde\x09\x59\x39\x8c\x06\xa0\x32\x7d\xd0\x61\xde\xb7\x3e\x4d\x36\x33\x89\x8c\x9f\xb6\x4f\x49\xc8\x1e\xef\x0d\x34\x00\x6f\x73\xf4\x18\x62\xd0\xba\xc1\x15\xaa\xef\xac\x7d\xe8\xba\xa7\x9f\xaf\x1e\xcf\xe5\xf5\x9c\xd7\x15\xdb\x57\x62\x09\x29\x62\x29\x3b\xec\xd2\x47\xfc\x7b\xd6\x40\x01\xb0\x16\x2f\xef\xa2\xcb\x79\x0c\x24\xa8\x03\xe1\x29\x7a\x76\x13\x61\x29\x28\x7e\xa0\xc9\xfd\xee\x3a\x73\x2e\x9f\xd7\x5e\x17\xdc\x46\xb6\x9b\x67\x87\xba\xe0\x9d\x1c\xc6\xf6\x65\x69\x90\x98\x13\x9f\xfc\x4c\x3a\x73\xc4\x18\xc6\x8d\x72\x8d\x44\xa6\x23\xb1\xfe\x17\x73\x5d\x70\x23\xe7\x6b\x00\xa0\xfa\x85\xa1\xc5\x01\x00\x00")
+
+func templatesSqlGooseMigrationGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlGooseMigrationGoTpl,
+		"templates/sql/goose/migration.go.tpl",
+	)
+}
+
+func templatesSqlGooseMigrationGoTpl() (*asset, error) {
+	bytes, err := templatesSqlGooseMigrationGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/goose/migration.go.tpl", size: 453, mode: os.FileMode(420), modTime: time.Unix(1792413762, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlGooseMigrationsTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\xdf\x6f\x9c\x46\x10\x7e\x66\xff\x8a\x29\x52\x22\x48\x28\xd8\xea\xdb\x39\x54\x6a\xea\x5c\x54\xa9\x71\xdd\x9e\xda\x17\xd7\x8a\x80\x1d\xb8\x95\x61\x16\xef\x2e\x77\x76\xcf\xfc\xef\xd5\x2e\x70\x1c\x76\x92\xa7\x3c\x58\xe6\x66\x77\xbe\x99\xef\x9b\x1f\xdb\x66\xc5\x5d\x56\x21\xe8\xfb\x9a\x31\xd1\xb4\x52\x19\x08\x98\xe7\x17\x92\x0c\x3e\x18\x9f\x1d\x0e\x3f\x82\x28\x21\xfe\xd0\xe4\xc8\xa1\xef\x99\xe7\xa3\xfd\x1c\x4e\x90\x26\x9b\x52\x52\x69\x9f\x79\x7e\xd9\x18\xfb\x4f\xc8\xa4\xd4\x47\x77\x92\x66\x01\x21\xf5\x33\x7f\x6d\x54\x21\x69\xe7\x33\xe6\xf9\x95\x30\xdb\x2e\x8f\x0b\xd9\x24\xad\x42\xad\xeb\xc7\xa4\x92\x52\x63\xb2\xfb\xc9\x9e\x27\x09\x7c\x94\xd0\x88\x4a\x65\x46\x48\xd2\xa0\xb0\x12\xda\xa0\x02\xb3\xc5\x46\x63\xbd\x43\x0d\x7b\x61\xb6\xe0\xbc\x60\xbf\x45\x82\x81\x1a\x72\xe6\x7d\x06\xff\x70\x80\xf8\x93\xe4\x5d\x8d\xd0\xf7\x89\xbe\xaf\x93\x19\xcd\x67\x21\x3b\x1c\x96\x94\x93\x64\x0e\xb7\xde\xc0\x56\xd6\x5c\xdb\x60\xb0\xf9\xf3\xf7\xd3\x44\x0a\xd9\xb4\xa2\x46\x0e\x82\x8c\x74\x17\x72\x41\x99\x7a\x64\x49\xc2\x92\xa4\x92\x2b\xa7\xdc\x89\x47\xf2\x26\xb6\xc2\xef\x32\x35\x1b\xd7\x1b\x70\xd7\xe2\xf5\x66\x90\xa8\xd6\x36\x4d\x77\xe9\xd3\xd1\x13\x52\xf0\x67\x9c\x85\x98\x2c\x49\xe0\xaf\x8e\x4e\xee\xb6\xa8\x4a\xa9\x1a\x0d\x19\x3d\x82\xc2\xfb\x4e\x28\xe4\xc0\x33\x93\xe5\x99\xc6\x93\x7c\x58\xd9\x51\xb1\x74\x0e\x42\x70\xb5\x85\x03\xf3\x5a\x25\x77\x82\xa3\x8a\xac\x09\x56\x29\x10\xee\xaf\x47\x5b\x10\x32\x4f\x94\xee\xe0\x87\x14\x48\xd4\xd6\xc1\x53\x68\x3a\x45\xd6\xca\xbc\x9e\x31\xef\xf3\xe0\x9a\xc2\x04\x15\xff\xdd\x06\x63\xaf\xc5\xef\xb3\xe2\xae\x52\xb2\x23\x1e\x84\x21\x3b\xf5\x1d\x48\x0d\x49\x21\xa8\x8e\x34\x64\x73\xda\xa0\xbb\xbc\x90\x4d\x93\x11\x5f\x41\xd7\x46\xc0\xe5\x9e\xe0\x86\x6e\x23\x90\x0a\x76\xa8\xb4\x90\x34\x50\x1b\x21\x82\x4c\x55\x1a\x6e\x6e\xb5\x51\x82\xaa\x13\x86\xa2\x84\x1a\xc9\x1d\x87\x90\xa6\x70\xf6\x8c\x85\x54\x3a\xbe\xc2\x7d\xe0\x77\x3a\xab\x70\x35\xe6\x80\xd0\xb5\x4f\x53\xd0\xa7\x31\xa0\x1f\x0e\x94\x47\x51\x56\x29\xfc\xd1\x22\x05\xe1\xc5\xb7\x35\xf2\x38\x96\xa8\xe0\xd7\x5a\x6a\x0c\x42\xf6\x3d\x44\x2f\xcc\x83\x2d\xd6\x97\x64\x66\x9e\xde\x0b\x53\x6c\xc1\x32\xbe\x39\xbb\xb5\x74\x0b\xdb\x13\x7e\xd7\xfa\x2b\xe6\x7d\xa5\x60\xe6\x21\x9c\xee\x59\xda\xee\x26\xd9\x18\xe7\xcc\x5b\x6a\xf8\x33\x9c\x5b\x4c\x67\xa5\x09\x6b\x1c\xf6\xf8\x17\x23\x85\xd3\xfa\xe6\xfc\x76\xa9\xcb\xd3\x13\x10\xbc\x9b\x7c\x27\x46\x65\x63\xe2\x0f\xb6\x19\xcb\xc0\x17\xb4\xcb\x6a\xc1\x81\xba\x26\x47\x05\xb2\x9c\xfb\x41\xaf\xe0\x95\xf6\x23\x98\x90\x2d\x44\xcf\x86\xbf\x52\x2a\x10\x36\xd3\xb3\x0b\x10\xf0\x0e\x08\x5e\xbf\x76\x81\x53\x17\xf8\x02\xc4\xdb\xb7\x43\xd0\x97\xcc\x2f\xe5\x9e\x46\xee\x16\x6b\xe0\x3f\x55\xdb\x4a\x30\x7e\x1f\x2b\x75\xf4\xfc\x88\xe6\xf2\xfd\x3f\xc3\xe9\x84\xf0\xb2\x6e\x8b\xc2\xd9\x08\x9e\x25\x7c\xad\x04\x99\x32\x98\x02\xc1\x2b\xfe\x2f\xf9\xd1\xd4\xd6\xe1\x5c\x6f\x12\xb5\xeb\x9e\xac\xab\xcd\x8a\x7d\x51\xb4\x8e\xee\xc8\xb6\xe9\xd4\xb6\xc7\xa9\x99\xf5\x3a\xbb\x5d\xf4\xad\x6d\xf8\xdf\x74\x80\x4a\x45\xc3\x3a\xb5\x58\x57\xf2\x0a\x1f\xcc\xc8\x27\x3c\xed\x39\x97\x43\xff\x7c\x76\xdd\xe8\x2d\xda\x16\x82\x37\x03\xda\x64\x72\x9a\x49\x15\x8e\x43\xc8\x73\x48\x67\x61\x66\xf0\x68\x31\x84\xc7\x0d\x66\x9f\x17\x41\xc2\x88\xac\x16\xff\x21\x7f\x39\x7a\x3c\x8f\xaf\x05\x55\x5f\x9f\xbe\x09\xdb\x3a\x3e\x5f\xff\xde\xdc\x59\xc7\xd2\x96\x3a\xde\x74\x79\x70\x3c\x59\x6f\xa2\xc5\x42\xfe\xd6\x60\x2e\x63\xcd\x1b\xde\x5b\xbc\x03\x1a\x4a\x1d\xaf\x37\x90\x82\xd4\xf1\xa5\x50\xeb\x4d\x30\x6f\xe5\x70\xb1\xf0\x27\xe0\x41\xd2\xab\x13\xa1\x07\xcb\xa5\xc8\x6a\x2c\x4c\xe0\x1e\xbe\xf1\x07\xf4\xbd\x1f\x46\xc0\xf3\xe8\x24\x64\xc8\xfa\xff\x07\x00\x84\x53\x02\x6b\x11\x08\x00\x00")
+
+func templatesSqlGooseMigrationsTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlGooseMigrationsTpl,
+		"templates/sql/goose/migrations.tpl",
+	)
+}
+
+func templatesSqlGooseMigrationsTpl() (*asset, error) {
+	bytes, err := templatesSqlGooseMigrationsTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/goose/migrations.tpl", size: 2065, mode: os.FileMode(420), modTime: time.Unix(1792413762, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlGoosePackageTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8d\x41\x4e\xc5\x30\x0c\x44\xf7\x3d\xc5\x1c\x00\x35\xc7\x60\xc3\x02\x04\x17\x08\x8d\x15\x5b\x24\x71\xb1\x2d\xaa\xde\x1e\xf5\xab\x95\xba\xf8\xdb\x79\x4f\x6f\x52\xc2\x7b\x5e\x7e\x72\x25\x74\xa9\x96\x43\x74\x38\x58\x5b\x71\x04\x13\xaa\xaa\x13\x5e\xf5\x46\x5f\xb0\xb1\x2c\x0c\xa3\x2a\x1e\x64\x87\xd7\x9d\xda\x1f\xf9\x94\x12\x36\xa6\x71\x4c\x58\xcf\xae\x38\xa4\xaf\x6a\x41\x05\xdf\xfb\x03\xf9\x6f\xbb\xf0\x8c\x2f\x26\x7c\x7e\xbc\xdd\xff\x37\x09\x96\x71\xd4\x82\xc5\x51\xc4\x68\x09\xb5\x1d\xd9\x08\x4d\x73\x79\x9a\x3a\xbd\xb6\xcf\xd3\xb5\x74\xa9\x96\x43\x74\xf8\xff\x00\x63\xa5\x64\x7b\xe9\x00\x00\x00")
+
+func templatesSqlGoosePackageTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlGoosePackageTpl,
+		"templates/sql/goose/package.tpl",
+	)
+}
+
+func templatesSqlGoosePackageTpl() (*asset, error) {
+	bytes, err := templatesSqlGoosePackageTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/goose/package.tpl", size: 233, mode: os.FileMode(420), modTime: time.Unix(1792413762, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlMigrationDownTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x65\x00\x9a\xff\x2d\x2d\x20\x50\x6c\x61\x63\x65\x20\x53\x51\x4c\x20\x73\x74\x61\x74\x65\x6d\x65\x6e\x74\x73\x20\x74\x68\x61\x74\x20\x61\x72\x65\x20\x75\x73\x65\x64\x20\x74\x6f\x20\x72\x65\x76\x65\x72\x74\x20\x74\x68\x65\x20\x7b\x7b\x20\x2e\x4e\x61\x6d\x65\x20\x7d\x7d\x20\x73\x63\x68\x65\x6d\x61\x0a\x2d\x2d\x20\x6d\x69\x67\x72\x61\x74\x69\x6f\x6e\x20\x77\x69\x74\x68\x69\x6e\x20\x74\x68\x69\x73\x20\x66\x69\x6c\x65\x2e\x03\x00\xe1\x8b\x79\x60\x65\x00\x00\x00")
+
+func templatesSqlMigrationDownTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlMigrationDownTpl,
+		"templates/sql/migration.down.tpl",
+	)
+}
+
+func templatesSqlMigrationDownTpl() (*asset, error) {
+	bytes, err := templatesSqlMigrationDownTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/migration.down.tpl", size: 101, mode: os.FileMode(420), modTime: time.Unix(1792412527, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlMigrationUpTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x64\x00\x9b\xff\x2d\x2d\x20\x50\x6c\x61\x63\x65\x20\x53\x51\x4c\x20\x73\x74\x61\x74\x65\x6d\x65\x6e\x74\x73\x20\x74\x68\x61\x74\x20\x61\x72\x65\x20\x75\x73\x65\x64\x20\x74\x6f\x20\x61\x70\x70\x6c\x79\x20\x74\x68\x65\x20\x7b\x7b\x20\x2e\x4e\x61\x6d\x65\x20\x7d\x7d\x20\x73\x63\x68\x65\x6d\x61\x0a\x2d\x2d\x20\x6d\x69\x67\x72\x61\x74\x69\x6f\x6e\x20\x77\x69\x74\x68\x69\x6e\x20\x74\x68\x69\x73\x20\x66\x69\x6c\x65\x2e\x03\x00\xc9\x36\xa4\x39\x64\x00\x00\x00")
+
+func templatesSqlMigrationUpTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlMigrationUpTpl,
+		"templates/sql/migration.up.tpl",
+	)
+}
+
+func templatesSqlMigrationUpTpl() (*asset, error) {
+	bytes, err := templatesSqlMigrationUpTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/migration.up.tpl", size: 100, mode: os.FileMode(420), modTime: time.Unix(1792412527, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlMigrationsTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\xdf\x6f\xdb\x36\x10\x7e\x16\xff\x8a\x9b\x80\x0c\x52\xa1\x4a\xed\xb0\x27\x37\x2e\xb0\x75\x35\xb0\x87\x66\x45\x8d\x6e\x0f\x59\x50\xd0\x22\x25\x13\x91\x48\x85\xa4\x6c\x78\x8e\xff\xf7\x81\x14\xa9\x5f\x76\x8a\x04\x7b\xd9\x53\x14\x92\xf7\xdd\x77\xdf\x1d\x8f\xe7\x06\xe7\xf7\xb8\xa4\xa0\x1e\x2a\x84\x58\xdd\x08\xa9\x21\x42\xc7\xe3\x6b\x60\x05\xa4\x1f\xeb\x0d\x25\x70\x3a\xa1\x20\xa4\xe6\x33\x34\x1f\x52\x0a\xa9\xcc\x57\x51\x6b\xf3\x47\x69\x99\x0b\xbe\x0b\x11\x0a\x6a\x56\x4a\xac\x29\x84\x25\xd3\xdb\x76\x93\xe6\xa2\xce\x4a\x51\x61\x5e\xbe\x76\x5b\x99\xff\xbb\xfb\x39\x44\x01\xd9\x10\xc9\x76\x54\x42\x78\x3c\x42\xfa\xc9\x59\x9f\x4e\x06\xf6\x39\x10\x99\x12\xad\xcc\xe9\x0b\x8f\x67\x4c\x14\x2a\xb4\x41\xd2\x4a\x51\x17\xdf\xff\x31\x2c\x14\x64\x19\x14\xac\xa2\xd0\xe9\x84\x82\x6f\xf0\x12\x84\xcc\xd8\xba\x48\xb9\x4d\x64\x8c\x8e\xc7\x69\x6a\xb3\x0c\x3a\x3b\x26\xf8\x6a\x0d\x5b\x51\x11\x05\x7a\x4b\x87\x55\x05\xb9\xa8\x1b\x56\x51\x02\x8c\x6b\x61\x37\x37\x8c\x63\x79\x40\x59\x86\xb2\xac\x14\x0b\x5b\x1d\x23\x8b\xec\x55\x6a\x2a\x6a\x87\xe5\xb0\xb8\x5a\x83\x3d\x96\xae\xd6\x13\xed\xcd\xa1\x4f\xfe\x90\x82\x25\x84\x03\xce\x84\x3b\xca\x32\xd8\x60\x45\x2b\xc6\xe9\x0d\xae\x29\x30\x42\xb9\x66\x05\xa3\x33\xc2\xa0\x1e\x5a\xac\xb6\x8c\x97\x80\xab\x0a\x1a\xc9\xc4\x88\x87\x42\xb9\xe0\x4a\x4f\xa1\x96\x10\xfa\xff\x43\xeb\xe8\x4b\xcb\x47\xa4\x1a\x2a\x0b\x21\x6b\x05\x98\x1f\x40\xd2\x87\x96\x49\x4a\x80\x60\x8d\x8d\xd5\x18\xbb\x68\x79\x3e\x35\x8e\x62\xb0\xc5\x05\x47\x14\xd4\x89\xf9\x86\xc5\x12\x38\xdd\xbb\xc2\x88\x62\x14\xb0\xc2\xae\xff\xb0\x04\xce\x2a\x73\x30\x90\x54\xb7\x92\x9b\x55\x14\x9c\x50\x7f\x62\xb1\x84\x7c\x4b\xf3\xfb\x5f\x1d\xd9\xa8\x8e\xdf\x3d\xdf\xb4\x4e\xbf\x36\xd1\xb9\x81\x3b\xb0\x5c\xba\x40\x68\xfa\x51\xca\x1b\xf1\x61\x8b\x79\x49\x2d\x1b\x8f\xc9\x59\x85\x82\xe0\x74\xe6\x64\xbc\x7f\xb2\xfa\xf9\xaa\x97\x2d\x57\x80\x07\x85\x40\xb5\x9b\x5c\xd4\x35\xe6\x64\x01\x6d\x93\x00\x11\x7b\x0e\xb7\xfc\x2e\x81\x1d\x95\x8a\x09\x9e\x80\x90\x50\x08\x99\x53\xb8\x76\x4b\xef\x3b\x59\xbd\x60\x58\x96\x0a\x6e\xef\x94\x96\x8c\x97\x23\x75\x59\x01\x15\xe5\x76\x3b\x86\xe5\x12\xde\xcc\xe4\x10\x52\xa5\x37\x74\x1f\x85\xad\xc2\x25\x5d\xf8\x68\xa1\x6d\x1e\x3d\x8b\x47\xe7\xf1\x71\x46\x20\x8c\xe7\x5a\xfe\xd1\x50\x7e\x41\xcb\xc1\x9b\x31\x08\x08\x2d\xa8\x84\x0f\x95\x50\x34\x8a\xd1\x7f\x29\x00\xb5\x67\x3a\xdf\x82\x09\xee\xf6\xcd\x9d\x39\x92\x9b\xd2\x0b\xdb\x26\x5c\xa0\xe0\x25\xf5\x31\x81\x36\x24\x03\x63\xe9\xab\xc3\xe3\x1a\x45\x2c\x32\x37\xd1\xbe\x45\xc1\x54\xde\xf7\xf0\xd6\x70\xb0\xab\xbc\x0b\x6a\x09\xae\x67\xa6\xbf\x68\xc1\x6c\x1a\x6e\xdf\xde\x4d\xfd\x3f\x3e\x02\x87\x6b\x6f\xeb\x99\x14\xb5\x36\x25\x27\x64\x11\x85\x8c\xef\x70\xc5\x08\xf0\xb6\xde\x50\x09\xa2\x18\x6a\x47\x2d\xe0\x4a\x85\x09\x78\x64\x03\x71\x9a\x85\xb0\xd6\xb4\x51\xd1\x6b\xde\xc7\xe1\x32\x68\x43\x71\xdf\x09\x10\x26\xf5\xa1\x4f\x45\x9d\xfe\xd9\x6d\x44\xf1\xa0\xe4\xec\x36\xb0\xca\x9d\xe9\x98\x1b\xc6\x9f\x25\xe3\xba\xe2\x51\xc8\xc5\x88\x23\xe0\xa6\xa9\x18\x25\x61\x3c\x92\xda\xdd\x9b\xae\xe7\xb1\x62\xac\xc8\xe5\x8c\xf4\xf8\x45\x14\x3a\xd6\x70\x45\x20\xb2\xc4\x17\x70\xa5\xe3\xbf\x79\x38\xba\x32\x76\x3d\x1e\xca\xc6\x3a\xec\x12\x69\x2b\x39\x5c\xcc\x13\x78\x0d\x3f\xcd\x7d\x3f\x75\x3f\x2e\xdc\x05\x5b\x36\xbd\x77\xa7\xe3\xc5\xf4\x0f\x8a\x5e\x08\xf8\x52\xe2\x7b\x29\x7d\x74\xe7\x59\x1f\x35\xa0\x3a\x5d\x19\x76\x91\x3b\x1b\xdb\x2b\x87\xdb\x4a\x2f\xd0\x45\x2f\x2d\xbf\xe7\xe6\xae\xfb\xd8\xfa\x5e\x34\xf8\x78\x73\x37\xbd\xec\xdf\xe9\x8b\xce\x81\x55\x7b\xe8\x82\x26\x8d\x27\xd4\xf5\xac\xf1\x35\x87\xe8\x95\x07\x72\x6b\xb6\x04\x85\x8c\x0d\x18\x2b\x80\x6c\x60\x39\x88\x34\x80\x27\x93\xec\xf4\xcf\x0e\x17\x1a\x18\x67\x9a\xe1\x8a\xfd\x43\xc9\x79\x8f\x22\x9b\xf4\x33\xe3\xe5\xd3\x6d\xca\x63\xbb\x16\x23\xf3\x3e\x97\x9c\xee\xd7\x76\x86\xf8\x6e\x77\x9a\xda\x77\x43\x4a\x0f\xe1\xc7\xa0\xf4\x2f\xa6\xb7\xbf\x73\xa5\x31\xcf\x69\x44\x36\x09\xfc\xd8\x6f\x7d\x10\xbc\x60\xe5\xf1\x74\xd1\x89\x92\x79\xea\x5b\xe7\x13\x2e\xdd\xa2\x97\xf5\x86\xee\x27\xce\xc2\xd9\xb4\x63\x26\xbf\xe3\xd1\x8f\x1e\x66\x3a\x32\xff\xd9\xd1\x28\x4c\xc0\xc6\x6f\x4c\xd2\xdf\x6c\x24\xdd\x6a\xc7\x34\x76\xef\x5a\xaf\x0b\x88\x86\xf2\xf9\xe0\x61\x10\xd5\xcc\xe7\x93\xd3\x53\xef\xba\xaf\x14\xaf\x38\x44\xca\x7e\x39\x1e\xa3\x2a\x39\x9f\xcc\x9d\x02\x26\x32\x5b\x1e\x3d\x99\xd5\x3a\x99\x8c\x52\xf1\x74\xe2\x75\x76\xce\x91\x7d\xcd\xcc\x6d\x5c\x37\xae\xeb\x98\x50\x16\x59\x66\xaf\xc5\x30\xcc\xc4\x0e\xa5\xe3\xdd\x49\x32\x79\x70\x40\xd2\xa2\x55\x66\x22\xf3\x4d\x91\x02\x1e\x26\x25\xbd\xc5\x1a\xf6\x58\x41\x83\xa5\xa9\xdb\xea\xe0\x4f\x11\x03\xb5\xa1\x85\x90\x74\x2a\xaa\x82\x3d\x95\xd4\xcd\x74\x5e\x45\xdc\x8f\x6f\x09\xec\xb7\x2c\xdf\x02\x53\x80\xab\x3d\x3e\xd8\x94\x18\xac\x82\x49\xa5\x07\x98\x77\x3d\x09\x05\x58\x9b\x19\x63\x43\x0f\x82\x13\x73\xbc\x07\x03\x75\xcf\x1a\x60\x1a\xb0\x82\x56\xb5\xb8\xea\x52\x33\x7b\x52\x61\x7e\x8d\x47\x33\xc8\xcb\xef\xd0\x74\x54\x18\xd7\x3c\x0a\x86\x20\x1d\xa4\xd9\x5e\x99\xc0\x9e\x03\x89\x02\x99\x0c\x43\xf2\x70\x31\x0d\xc8\x17\x8a\xc9\xd7\x26\xf2\x0e\x9e\x43\x50\x8e\x88\xb1\x62\x04\x6c\x18\x78\x20\x3b\x98\x9f\xb7\x46\x14\xb8\x06\x9d\xc0\xb7\xcb\xef\xee\xe5\x66\x3b\x7d\x76\x27\x98\x4f\x3d\xa6\x53\xd6\x16\xd8\xf9\x86\xeb\x21\xd1\xc7\xcb\xcf\x43\x5f\xa8\xde\xe4\x8a\x40\x23\x29\xc1\xda\xfd\xc8\xe8\x01\xae\xc8\x3b\xfb\xd4\x1f\xec\x32\x96\xf9\x96\xed\x26\xbf\x81\xba\x02\x1c\x3f\xd2\xde\x36\x3e\x1b\x99\xff\x1d\x00\xe6\xda\x45\x7d\x87\x0f\x00\x00")
+
+func templatesSqlMigrationsTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlMigrationsTpl,
+		"templates/sql/migrations.tpl",
+	)
+}
+
+func templatesSqlMigrationsTpl() (*asset, error) {
+	bytes, err := templatesSqlMigrationsTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/migrations.tpl", size: 3975, mode: os.FileMode(420), modTime: time.Unix(1792421770, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlMigrations_testTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x41\x8f\xdb\x36\x13\x3d\x4b\xbf\x62\x42\x20\x81\xf4\x45\xd1\x7e\x29\x7a\xda\xad\x0f\xd9\xec\xa6\x4d\xd1\x26\x68\xbd\x69\x0f\x49\xb0\xa1\xa5\x91\x4d\xac\x44\x2a\x24\x65\x7b\xe1\xea\xbf\x17\x43\x4a\xb2\x2c\x7b\xdb\x20\x08\x7a\xb2\x45\x0e\x1f\xdf\xcc\x3c\xce\x4c\xcd\xb3\x3b\xbe\x44\x30\x9f\xcb\x30\x14\x55\xad\xb4\x85\x28\xdc\xed\x9e\x81\x28\x20\x9d\x23\xe6\x06\xda\x36\x0c\x58\xa6\xa4\xc5\xad\x65\x6e\x0f\x65\xee\x57\x73\x6e\xf9\x82\x1b\x3c\x33\x9f\x4b\x16\x06\x0c\xb5\x56\xda\xd0\xbf\xa2\xb2\xf4\xa3\x0c\x1b\xd0\x6e\x56\x5a\x6d\xf8\x86\xdf\xfb\xb3\x35\xb7\xab\xb3\x42\x94\x48\x7f\x26\xb8\xc6\x6a\x21\x97\x0e\xc8\xa2\xb1\x42\x2e\x59\x18\x06\x95\x58\x6a\x6e\x11\xd8\x52\xd8\x55\xb3\x48\x33\x55\x9d\x2d\x55\xc9\xe5\xf2\x59\xb7\x75\xd6\xff\xae\xbf\x67\x61\x1c\x86\x67\x67\x60\xb2\x15\x56\xfc\xb7\x06\xf5\x3d\xe4\x68\x32\x2d\x16\x68\xc0\xae\x10\x2c\x5f\x94\x68\x12\xc8\x54\xd9\x54\xd2\x00\x97\x39\x08\x99\xe3\x16\x0d\x64\x1a\xb9\xc5\x1c\x16\xf7\x64\x4a\x38\x1e\x59\x28\x69\xc2\x4c\x49\x63\x0f\x80\x67\xf0\x69\xb7\x83\x74\xee\x96\xa0\x6d\x3f\xb9\xab\x6f\xd0\xd8\x5f\x87\x63\xc0\xeb\xba\x14\x68\x00\x79\xb6\xda\xc3\x41\x53\x27\x90\xab\x8d\x4c\x1c\x81\xa6\x06\xbe\xe4\x42\x26\xb0\x46\x2d\x8a\x7b\x21\x97\x04\x65\x57\xdc\x02\xae\x9d\x13\x6a\x23\x47\xc7\x71\xcb\x33\x5b\xde\x83\xa6\x5d\x83\x06\x84\x35\xd0\xd4\x7b\x8b\x14\x5e\x5b\xd0\x0d\x39\x48\xc0\xc6\x7a\x3c\x04\xac\x6a\x7b\x0f\x7d\x0a\x41\xf2\xca\x3b\x7c\x73\x3d\xbf\xb9\xbd\x7a\x71\xf3\xe2\xf2\xc5\xfc\xfa\xf6\xdd\xef\xbf\xec\x76\x47\xf9\x4b\x40\x69\xe0\x60\x87\xa5\x1e\x86\xc0\x37\x2b\x94\xd0\x48\x83\x76\xb7\x03\x2c\x0d\x42\xdb\x3a\xe7\x84\x01\x73\x27\xea\x1a\xf3\x89\x8d\xd3\x53\x1a\x16\x8d\xcc\x26\x51\x8b\x2c\xfc\xaf\x93\x40\x7a\x13\xc3\x2e\x0c\x54\x8d\x92\x6c\xae\x2e\x23\x1b\x87\x41\x8e\x05\x6a\x78\x59\x2a\x83\x51\x4c\x22\x49\x00\xb5\x86\xf3\x19\x48\xdc\x78\x1c\xda\x08\x44\xe1\xd6\x1f\xcd\x40\x8a\x92\x70\x02\x9b\xbe\xe2\x96\x97\x11\x6a\x1d\x87\x41\x1b\x3a\x9b\xdb\x04\x6e\x07\x84\x2a\xfd\x03\xb5\x11\x4a\x46\xf1\x45\x7f\xba\x53\x58\x7a\xad\xf5\x1b\x51\x76\xfb\x63\xbc\x22\x62\xb8\xad\x31\x23\xf9\x70\x39\x89\xf2\x39\x3c\x5e\xb3\x04\x46\x57\x4a\x61\x05\x2f\x89\x6f\xde\x54\xb5\x57\x90\x73\x6c\x81\x85\xd2\x48\x1b\x9d\x4d\x18\x14\x4a\xbb\x9b\x06\x7a\x73\x8b\xb5\x89\x9e\xc7\x61\xd0\xf9\xa7\xb4\x49\x5f\x1b\x72\x29\x01\x65\x1c\x49\x65\xaf\xb7\xc2\x58\x17\xbb\x20\x58\x68\xe4\x77\x61\x10\xb4\x3e\x31\xc7\x51\x99\x84\xc5\xc5\x25\x20\x69\x09\x25\x5d\x6c\x6e\x27\x91\x09\x83\x80\x17\x16\xf5\xb1\x0b\x03\xab\x31\xd9\x67\xcf\xe3\x8b\x87\xee\x2c\x22\xa6\x55\x59\x0a\xb9\x84\x05\xcf\xee\xf6\x1a\x86\xc7\x79\x17\xb9\x81\xc9\x40\x2f\x08\x7c\xb8\xfb\x7b\x13\xf0\x91\x4b\xa0\xa8\x6c\x3a\xaf\xb5\x90\xf6\x9f\x80\xf7\xa0\xf1\x03\x94\xff\x85\x31\xd2\xc3\xa6\x87\xfa\xb5\x7c\x5d\xf8\xa6\x74\x4f\xa3\x1e\x90\x0d\x7a\x8d\xcc\x3c\xc4\x20\xe2\x81\xff\x95\xda\x8c\xb5\x4b\xf1\x7e\xf2\xe4\x94\x92\xd5\xcb\x15\x97\x4b\x3c\xf9\x2e\x8e\xf8\x76\x7a\x4c\xe0\x30\xa8\xbc\x2c\xf7\x5c\x0d\x8b\x27\x5c\xde\xd5\xdf\x9e\x49\x9f\xe9\x71\xb8\x8e\x68\xb4\xe1\x71\x43\xeb\xcb\xb3\xef\x70\x7d\x65\xa6\xaa\xb8\x3f\xea\x4a\x16\x2d\x51\xfd\x01\x83\x98\x03\xb5\x2b\x03\x76\x23\x32\x3c\x55\xa0\xc9\x86\x28\x08\x03\x22\xc7\xaa\x56\x16\xa5\x4d\xe1\xad\x5d\xa1\x76\x28\x06\x32\x2e\xa1\x54\x3c\xa7\xb6\x02\x86\x57\x08\x85\xd8\xda\x46\xa3\x81\xc6\x74\x58\x44\xea\xea\x72\x54\x0e\x69\xe1\x3f\xaf\x84\xdf\x32\x71\xbe\x74\x09\xe2\xf2\xff\x0b\x10\xf0\x03\x7c\x77\x01\xe2\xe9\x53\x97\xe5\xfd\x5d\xe4\xe7\xd5\x65\xd4\xcd\x1a\xe9\x25\xcf\xee\x96\x5a\x35\x32\x8f\xe2\x04\xf2\x45\x02\x8c\x82\xc8\x1e\x7e\x8e\xdd\x85\x24\x95\x36\x6c\xc7\x33\x05\x25\x7c\x1f\x2f\xc8\x94\x94\x98\x59\x03\x56\xb9\x4c\x7c\x6d\x17\x2c\xb8\x2b\x57\x84\xee\x2a\x96\x55\x27\xbb\xe2\xbe\x0f\x26\xbe\x03\x92\x4a\xba\xc1\x82\x5c\x3a\xd5\x0e\x7d\xfa\xc7\x39\x9e\xe6\xdf\xa6\x3f\x61\x59\xa3\xa6\xa4\xe6\x46\x52\x04\x95\x49\x7f\x44\x8b\x72\x1d\xb1\x23\x07\x98\xef\x82\x64\x39\x9b\x01\x63\xb0\x7b\x60\x34\x0b\x9c\x09\x30\x52\xfb\x39\x83\xa7\xd0\x4f\x69\xe9\xcf\x4a\xc8\xc8\xa6\x37\x58\xd5\x57\x42\x53\x56\x5c\x46\x52\xf3\xb9\x14\x16\x59\xec\x00\x3b\x4f\xdd\xfb\x9d\xdf\x89\xfa\x04\x15\x10\x06\xa4\xa2\x07\x63\x59\x7c\x30\xfa\x91\x56\xd6\x5c\xbb\x04\xbb\x7e\xe6\x39\x2f\x5c\xcf\x84\x19\x0d\xaa\xe9\xdb\x1a\x65\xc4\x68\xe0\xba\xd2\x62\x8d\x1a\xda\x96\x25\x90\x1b\x79\x2c\x8c\xa9\x10\xbd\x10\xc6\x65\x04\x0a\x2e\xca\x6e\x20\x1c\x32\x41\x5f\x7e\xbe\x83\x5c\x14\x05\x6a\x03\x85\x56\x95\xb3\x1a\x9a\x3b\xf5\x6b\x9f\xa4\xc3\xb2\x34\x4a\x53\xd2\x6d\x61\x9e\x80\xb1\x58\x83\x1f\x6c\x8f\xb2\x27\x0a\xe0\x99\x6d\x4e\xcc\x01\x17\xfd\xc6\xa3\xd9\x80\x35\xf6\xac\x88\x58\x47\xb4\x12\xa6\xe2\x36\x5b\xf9\x5e\x00\x8f\xcd\x07\xd9\x1f\x38\xff\x20\xe9\xd3\x23\xb9\x0f\xe6\xf9\x8c\xf9\xf9\xdd\x51\x94\xf6\x3c\x26\x83\xf3\xf0\x58\xba\x9b\xb9\x01\x25\x11\x4a\x21\x11\x6a\xd4\xa0\xd5\x06\x54\x31\x1e\x90\x7d\x98\xc6\x8e\x1d\x68\xd9\x47\x65\x1a\x14\xad\x36\x66\x28\x61\xf9\x22\x75\x33\x7c\x34\x42\xfd\xa2\x5a\xd6\x95\x45\x02\x4b\xf7\xb5\xb1\x9b\xf9\x07\x78\xbf\xed\x17\xbf\xb4\x46\xae\x79\xd9\xa0\xa1\x8c\x55\xfc\x0e\xa3\xf7\x1f\x49\x9b\x6f\x9a\xb2\x9c\x3b\x77\x12\x28\x51\x46\xdd\x45\xd4\xad\x73\x92\xd7\xde\x5a\x48\x8b\xba\xe0\x19\xee\xda\x23\xd3\xa1\x5a\x6a\xd7\x0b\xbb\x9b\x88\x06\x81\xbc\x17\x1f\x61\x06\x4f\xfc\xea\x7b\xf1\x71\xff\x6a\x28\xc2\x9d\xc6\x4c\x7a\xd9\x88\x32\x47\xed\x6b\xaf\x73\xf0\x0d\x6e\x6d\x14\x4f\x8a\xae\xdb\x99\x67\x5c\x46\x84\x9d\xa6\xe9\x97\x94\xd7\x30\x08\x0a\x81\x65\x7e\xe0\xfe\xc8\x6d\xcf\x8d\xbc\x76\xb7\x8b\x04\xd6\x27\xdd\xe9\x50\xbc\x47\x3d\x71\x57\x65\xfa\x8f\x57\xce\x20\x5a\xa7\x3e\xaa\x54\x72\x80\xf5\x93\x14\xf9\x9b\xfe\xa9\x85\x45\xbf\x1b\x1d\x40\x78\x6c\x3a\xf0\x17\xb0\x38\x3e\x65\xcf\x3e\x48\xd6\xa5\x73\x12\x91\x6b\xad\x27\x6d\xef\xa4\xba\x34\xda\x46\x4b\x37\xf8\xa6\x73\xab\x85\x5c\x46\x71\xd8\xfe\x3d\x00\x49\xe8\x22\xd0\x57\x0f\x00\x00")
+
+func templatesSqlMigrations_testTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlMigrations_testTpl,
+		"templates/sql/migrations_test.tpl",
+	)
+}
+
+func templatesSqlMigrations_testTpl() (*asset, error) {
+	bytes, err := templatesSqlMigrations_testTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/migrations_test.tpl", size: 3927, mode: os.FileMode(420), modTime: time.Unix(1792414124, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlModelsTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x52\x41\x6e\xdb\x30\x10\x3c\x8b\xaf\x18\x08\x28\x60\x17\x90\xfd\x82\x9e\x0c\x14\xe8\xc1\x41\x0f\x41\xce\x65\xa4\xa5\x43\x44\x24\x15\x72\x55\xb7\x20\xf8\xf7\x62\x69\xa5\x91\xe2\xdb\x6a\x66\x67\x77\x67\xa8\x49\xf7\xaf\xfa\x42\x48\x6f\xa3\xca\xb9\x83\x35\x38\xfc\x70\x53\x88\x9c\x50\x8a\x52\xb6\xd6\xd8\x55\x32\x6a\x7f\xa1\x0d\xdf\xb4\x39\xe3\x80\x52\xda\xda\x40\x7e\x10\xd5\x7e\xfd\x91\xf3\xbb\xee\x1c\x06\x1a\xd3\x82\x31\xb9\x69\xd4\x4c\x68\x9d\xc0\x6d\x9d\x22\x84\xcc\xe8\x6a\x4f\x87\x81\x8c\xf5\x1f\x2d\x02\x1f\x8f\x90\x95\x0f\xda\x11\x4a\x81\x4d\xd0\x88\xe1\x8a\x60\xc0\x2f\x54\xb9\x47\xfd\x3c\x56\x92\xa5\x50\xfc\x77\xa2\x8d\x26\x71\x9c\x7b\x46\x5e\x7b\xfa\x6e\x69\x1c\xea\x6d\xcd\xba\x55\xea\x47\xd1\x97\x82\x5f\xf5\x43\x5f\x50\x8a\x94\x92\xd4\x29\x38\x47\x9e\xa5\x73\xb9\xeb\x03\x59\xac\x94\xb2\x0e\xa3\xac\x77\x3e\xe9\xd1\x0e\x9a\x6d\xf0\x4b\x28\xb7\xf8\x7f\x6a\x66\x8a\x5e\x20\xf5\x5b\xc7\x3a\x76\xc1\x9e\x74\x94\x5d\xdf\x10\xe9\x42\x7f\xa6\xc3\x79\x4e\x7c\x0a\x6e\xb2\x23\xed\x72\xc6\x14\xad\x67\x83\xf6\xcb\x5b\xbb\x1e\xf3\xe9\x39\x36\xa5\x35\x77\x77\xa8\xe3\x11\x0b\x44\xe8\x5f\xa8\x7f\x4d\x35\x5a\x73\x8b\x28\x18\x38\x3c\x93\x09\x91\x60\x59\x1e\xe0\x1a\x2d\x33\x79\x65\x66\xdf\x63\xe7\xf0\x75\x95\xe0\xfe\xff\xa8\xdd\x1e\x14\x63\x88\xdb\xdc\x3f\xed\x6e\xac\xa9\x7e\x4f\xb2\x56\xac\x66\xd5\x34\x91\x78\x8e\xfe\xa6\x4e\x87\x07\xba\xde\x79\x3d\x53\x4a\xf2\x17\x8b\xd7\x66\xe3\xf1\x5d\xec\xed\xa8\x36\x44\xce\x1d\xc8\x0f\xe8\x4a\xf9\x37\x00\x34\x7d\x4d\xc5\x04\x03\x00\x00")
+
+func templatesSqlModelsTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlModelsTpl,
+		"templates/sql/models.tpl",
+	)
+}
+
+func templatesSqlModelsTpl() (*asset, error) {
+	bytes, err := templatesSqlModelsTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/models.tpl", size: 772, mode: os.FileMode(420), modTime: time.Unix(1792415925, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlMysql1DownTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\xce\x4d\x4a\x03\x41\x14\xc4\xf1\xfd\x9c\xa2\x2e\xd0\xb9\x80\x22\x28\x46\x08\x04\x8c\x4e\x16\x2e\xf3\xa6\xbb\x4c\x37\xf6\x47\xec\xf7\xc6\xf1\xf8\x92\xc1\x85\xe0\x01\xea\xf7\x2f\xe7\x70\xc8\xe2\x89\xf1\x65\x0f\x35\x31\x16\x56\x53\x58\x14\x83\x74\x62\x56\x06\x58\x43\xe7\x17\xbb\xc1\x22\x11\xc4\x64\x12\x25\xd4\x47\x16\x41\x49\xe7\x2e\x96\x5a\x1d\x9c\xc3\x92\x2c\xa6\x0a\x8b\x49\xf1\x9e\x32\x37\x18\xe7\x49\xf9\x39\xb3\xda\xbf\x01\x7a\xcb\x79\x12\xff\xa1\x6b\xeb\x72\x7d\x12\x7e\x89\x2b\xb6\xd6\xda\x52\x57\x09\xbe\x53\x8c\x01\x92\x5b\x3d\x6b\x0a\x04\xc5\x47\x9c\x7c\xab\xca\x94\xff\xb0\x95\x0b\x6e\xab\x14\xde\x9d\x36\x83\x73\x83\x73\x78\x7c\x7d\x3e\xe0\x78\xff\xb0\xdf\x62\xf7\x84\xed\xdb\x6e\x3c\x8e\xe0\xb7\x94\x4b\xe6\xcd\xcf\x00\xe0\xb2\x9f\xb4\x06\x01\x00\x00")
+
+func templatesSqlMysql1DownTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlMysql1DownTpl,
+		"templates/sql/mysql/1.down.tpl",
+	)
+}
+
+func templatesSqlMysql1DownTpl() (*asset, error) {
+	bytes, err := templatesSqlMysql1DownTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/mysql/1.down.tpl", size: 262, mode: os.FileMode(420), modTime: time.Unix(1792412527, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlMysql1UpTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x90\x41\x6f\xd3\x40\x10\x46\xef\xf9\x15\xdf\x11\xa4\xba\x27\x0e\x48\x50\x24\x27\xd9\x06\x0b\x67\x1b\xec\x8d\x44\x4f\xe9\xd8\x9e\x64\x57\xac\x77\x8d\x77\xac\x10\x7e\x3d\x32\x6a\x48\xef\xef\x3d\x7d\x33\x59\x86\x9d\xa7\x96\x51\x7f\x2f\x91\x84\x84\x7b\x0e\x92\x20\x96\x04\x34\x32\xa6\xc4\x1d\x24\xc2\x05\x27\x8e\xbc\xfb\xc3\x10\xcb\xe8\x48\xa8\xa1\xc4\x48\xad\xe5\x9e\x16\x59\x86\xb3\x13\xeb\x02\xc4\xba\x84\xa3\xf3\x7c\x8f\x7a\x6a\x12\xff\x9a\x38\xc8\x2b\x86\xde\x9d\x46\x12\x17\x43\x42\xb2\x71\xf2\x1d\x1a\x46\x3b\x32\x09\x77\x98\x92\x0b\xa7\xb9\xf4\xd2\xc6\x90\xd8\xf9\x1b\x8e\xc0\x67\x7c\x0e\xd4\xf3\x97\x97\xfb\x45\x96\x2d\xb2\x0c\xdb\xcb\xbc\xb9\x8b\x9c\x10\xa2\x20\x4d\xc3\x10\x47\x81\x8c\x14\x12\xb5\xb3\x45\x1e\xeb\x75\x79\x87\x14\xf1\x93\x79\x00\x53\x6b\xdf\x34\x53\x4f\xde\xcf\x25\x0e\x71\x3a\xd9\xf9\xca\x86\x31\xf2\x40\x6e\xe4\x0e\xcd\x05\x96\x42\x77\x1d\x4a\xb7\xf7\xe0\x48\xce\x63\xa0\x51\x70\xa6\x0b\xc4\x8e\xb3\x7f\xdd\xb5\xaa\x54\x6e\x14\x4c\xbe\x2c\x15\x8a\x47\xe8\x27\x03\xf5\xa3\xa8\x4d\x0d\xfe\x4d\xfd\xe0\x19\xef\x66\x0e\x00\x5c\x87\x65\xb1\x29\xb4\xc1\x5e\xd7\xc5\x46\xab\xf5\x3f\x5c\xef\xcb\x12\xf9\xde\x3c\x1d\x0a\xbd\xaa\xd4\x56\x69\x83\x5d\x55\x6c\xf3\xea\x19\xdf\xd4\xf3\xdd\x55\x7f\xfd\xdc\x81\x04\xa6\xd8\xaa\xda\xe4\xdb\xdd\x2d\xb0\x56\x8f\xf9\xbe\x34\x58\xed\xab\x4a\x69\x73\xf8\x8f\xcc\xfa\x7b\x28\xbd\x29\xb4\x7a\x28\x42\x88\xeb\xe5\x0d\xfe\x9a\x57\xb5\x32\x0f\x93\x1c\x3f\xf6\xcd\x87\x4f\x7f\x07\x00\xa2\x09\xa0\xfc\x21\x02\x00\x00")
+
+func templatesSqlMysql1UpTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlMysql1UpTpl,
+		"templates/sql/mysql/1.up.tpl",
+	)
+}
+
+func templatesSqlMysql1UpTpl() (*asset, error) {
+	bytes, err := templatesSqlMysql1UpTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/mysql/1.up.tpl", size: 545, mode: os.FileMode(420), modTime: time.Unix(1792412527, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlReplicasTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x7b\x6f\xdb\xc8\x11\xff\x5b\xfc\x14\x13\x02\x09\xc8\x98\xa6\x13\xa0\x2d\x50\x27\xba\xc2\xb1\xd5\x5e\x10\x3b\x49\x2d\xbb\x45\x6b\xfb\x92\x15\x39\x92\x16\xa6\x76\x99\xdd\xa5\x6d\x41\xd1\x77\x2f\x66\x1f\x14\x29\xcb\xc8\xf5\x70\x77\xc0\xc5\xe2\x3e\xe6\xf1\x9b\x99\xdf\xec\x6e\xcd\x8a\x5b\x36\x43\xd0\xdf\xaa\x28\xe2\x8b\x5a\x2a\x03\x49\x34\x88\x0b\x29\x0c\x3e\x98\x38\x1a\xc4\x25\x33\x6c\xc2\x34\x1e\xe8\x6f\x15\x7d\x4f\x17\x76\x58\x6a\xfa\x57\xe1\x0c\x1f\x6a\xfa\xa5\x8d\x2a\xa4\xb8\xf3\x3f\xb9\x98\xd9\x79\xbd\x14\x45\xf8\x7b\xc0\x8c\x5c\x70\xfb\x69\xf8\x02\xe3\x28\x8d\xa2\x42\x0a\x6d\x35\x1e\x1c\x40\xad\xf8\x82\xa9\xe5\x48\xdc\x81\xbc\x43\xa5\x78\x89\x1a\xcc\x1c\xa1\x90\x42\x60\x61\xb8\x14\xe0\x44\x83\x9c\xda\x09\xbf\x03\x82\x89\xd1\xa0\x23\x63\x08\xf1\xc9\xd1\xc5\xd1\xbb\xa3\xf1\xe8\xcb\xe5\xf9\x69\x1c\x0d\x0e\x0e\x40\x61\x5d\xf1\x82\x69\x52\x52\x71\x6d\x82\x82\xc5\x82\x81\xc6\x9a\x29\x66\xb0\x7c\xac\x50\x07\x8d\x61\x7f\x34\xe8\x4a\xea\xaa\x3a\x1f\x7d\x3e\x7d\x7f\x7c\x44\x2a\xc7\x4e\xe7\x1c\x59\x65\xe6\xef\x85\x41\x75\xc7\x2a\xe0\x4e\x67\x8d\x8a\xcb\x12\x26\x68\xee\x11\x85\x1d\x72\x0b\xa1\x98\x63\x71\xbb\x43\xe3\x96\x9c\x21\xbc\x7e\x05\x2f\x81\xa0\xcc\xc7\x58\x48\x51\x12\xa0\x77\x4c\x79\x38\x15\xb2\x72\x6c\x98\xc1\x05\x0a\x03\x0b\x66\x8a\xb9\xc7\x53\x87\x51\x0d\x4a\x36\xe4\xb0\x91\x8f\xbc\xeb\x6e\x1e\x82\x8b\x73\x7e\xd6\x68\x73\x2c\x17\x35\xaf\x30\xf9\x9a\xfc\x8d\xeb\xf4\x97\x6b\xfd\x72\x3c\x3a\x1d\x1d\x5f\x5c\x4f\xbe\xa6\x56\x71\x25\x8b\x5b\x2e\x66\xe7\xc8\xca\x9e\x5a\x32\x88\x0c\x60\x06\x16\x8d\x36\xa0\x1a\x01\x52\x74\x03\x19\x0d\xba\x7b\x9f\xd4\x9a\x5e\x4f\xfe\xfe\xe9\xfc\x5a\xef\x25\x97\x9f\x4f\x8e\x2e\x46\xdf\xc7\x3f\x1f\x9d\x8f\xbe\x7f\xfc\x74\xad\xf7\x3e\x8c\xfe\x73\xad\xf7\xfc\xb8\xfb\xb0\xb3\xa9\x33\xaf\x11\x9a\x4d\x91\x62\x06\x4f\xc8\xbf\xfa\xe5\x68\xff\xbf\xaf\xf6\xff\x7a\xb3\xf7\x35\x25\x40\x37\x49\x43\x91\x63\xd6\x8b\x7d\x29\xaa\x65\x37\x4b\x58\x25\xc5\x0c\xee\xb9\x99\x7b\x4f\x75\x53\x19\x8a\x20\x37\x1a\x2a\xa6\x4d\xd4\xe6\x81\x0b\x6f\x64\x96\x75\x8b\x37\x65\x75\x53\x18\x58\x45\x83\x72\x02\xf6\xbf\x97\xfa\x5b\x95\x9f\xbc\x0b\x41\x5f\x82\xab\x9d\xfc\x9d\x94\x55\xb4\x6e\xc3\xec\x05\x68\xb8\xba\x79\xe9\x7f\x47\x03\x81\x0f\x86\x64\x84\x3d\x97\x5c\x98\xbf\xfc\x29\x1a\x68\x23\x6b\x1a\x86\x62\xce\x84\xd7\xb9\x5a\x47\xd1\x20\x94\x8f\xb6\xa0\x2c\xd8\x2d\x26\x0b\x56\x5f\xb9\xd4\xbf\xf1\xa6\xa4\x9d\x75\x67\x0d\x50\x41\xe7\x67\x8d\xc1\x07\x0f\xd2\x39\x65\x92\x02\x8d\xa2\xd4\x1d\x90\xbe\x35\xa8\x38\x6a\x60\xa2\x04\xa3\x98\xd0\xcc\x22\xa6\xc1\x48\x60\x1e\x92\x65\x0b\x04\x13\x25\x21\x85\x77\xa8\x96\x20\xcd\x1c\xd5\x26\x59\x43\x92\x86\x5c\xb1\x08\x06\xad\xad\x33\xc1\x92\x12\x14\x9a\x46\x09\x9f\x7a\x34\xa4\x42\x49\x6d\xfc\x95\x35\x0a\x2c\x61\xb2\x84\x4f\x35\x8a\x68\xda\x88\xc2\x6f\x4f\xd2\x20\x7b\x45\xb5\x4e\xa2\xfc\xc0\x6a\x4d\x01\xb0\xda\xbd\x29\x1f\x70\xd9\xb7\xe0\xdf\xdc\xcc\x3f\x7b\x6e\xb2\x15\xe6\xac\x08\x58\xc8\x29\x14\xe6\x61\xcb\x9f\x0c\x30\x9f\xe5\x34\x48\xe0\xd1\x7a\x92\xa4\xe4\xbd\x86\x7b\xc5\x8d\x41\x41\x66\xd2\x06\xcd\x16\x08\x0a\xbf\x35\xa8\x8d\x33\xb9\xa3\x2f\x21\xc9\x9e\xbd\xf3\x63\xf7\x37\xdd\x1e\xe8\xf8\x14\x66\x48\xc4\xbf\x58\xd5\x20\x09\xc8\x82\x51\x1f\x70\xb9\x5a\x67\x60\x54\x83\x29\x79\xed\x69\x85\x10\xc7\x0a\x0b\xa3\x7b\x78\x82\x46\x75\xc7\xc5\xcc\x3a\xba\x74\xa6\x25\x0e\xb4\xd4\xef\xdb\x65\x5e\xe6\xd6\x7b\xaa\x4d\xc1\x27\x1c\x19\xc9\x2d\x52\xb9\x33\xac\x6b\x53\x0a\xcf\x86\x20\x78\x05\xdf\xbf\xc3\xb3\x1e\x59\xe5\x67\x44\x39\x63\x2b\x2b\xb1\x82\x53\x5a\xd4\x21\x96\x5d\x2b\x56\xd1\x20\x20\x52\x4e\xa2\xc1\x7a\x13\x73\x97\x99\x49\x70\xff\x9f\xb4\x3e\xc0\xa8\x1a\xa1\xbd\xf1\x52\x00\x6b\xd3\xf8\x7e\x8e\x02\xb8\x01\xde\xa9\x04\x0f\x87\xf2\x59\x94\xf6\x24\xfd\x18\x96\x0c\x98\x9a\x69\xc8\xf3\x9c\x53\x23\x99\xb2\x02\x09\x85\xc4\x82\x75\x2e\xef\x75\x06\xa8\x94\x54\x69\x27\xb6\x2a\xdf\x80\xee\xa5\xa5\xf9\xb6\x5a\x3f\xe1\xe4\xe7\x79\xde\x73\xf4\x5c\xde\xff\x7e\xbe\x6e\x84\xfd\x66\x77\x83\xb7\xbf\xca\xc9\xbe\xbe\xa7\xfc\x1c\x3d\x60\xb1\xdb\xc7\x2e\xd9\xf4\x73\xb9\xb3\xe7\xb7\x47\xce\xba\x62\x1b\xc5\x8e\xd0\x95\x93\x7c\x4b\xc9\x53\xf6\x7f\x56\x74\x6c\x41\xbf\x12\x6a\xf7\xf9\xab\xdc\xe8\x6f\xfd\xb1\x27\x21\xdd\xc6\x66\xf1\x84\xcd\x8f\x25\x7a\x09\xc1\xda\x77\x38\xe3\xe2\xe2\x81\x58\x5d\x19\x6a\xa7\x9d\x9e\xb0\x23\xaf\x64\x6d\x74\x2f\xb3\x32\x90\x0a\xa4\x20\x51\x1d\xbf\x5c\xab\xb8\xe7\x1a\xb7\x3c\xf4\xea\x76\xbb\x66\x85\xdb\x8c\xba\x78\xf8\x54\xdb\xae\x14\x3c\xbc\x78\xe8\xfa\xc7\xa7\x6e\xad\xa7\x9c\x17\x2f\xec\x67\x4e\x07\x9c\x4f\x74\x10\x78\xf1\xe2\x49\x96\x1a\xba\x2d\x1d\x7a\x69\x09\x25\xef\x18\xe7\x8c\x49\xbb\xc4\x53\x4e\x76\x2d\x08\x31\xf7\xbd\xa5\xd3\xe2\x02\x14\x2d\x19\xf7\x7b\x5b\x06\xf7\x73\x5e\xcc\x2d\x45\xa3\x76\x1d\x96\x44\x51\x6f\xf1\xa8\x85\x0e\xd2\x63\xe0\xd6\x1a\xaf\xda\x9b\xdf\x53\x6d\x0f\x1c\xdb\xad\x9c\x0b\x3a\x5c\x8a\x72\x5f\xc9\x09\x17\x20\x55\x89\x2a\x83\x29\xab\x2a\x2e\x66\xa4\x79\xc2\x8a\xdb\xad\x1e\xe8\x82\x4e\xb1\x44\x60\x0a\x41\x48\xe1\x6d\x6b\x51\xeb\xda\x36\x95\x0a\x14\x13\xb3\xcd\xc1\x95\x46\x07\x0a\x0e\x87\xed\xc8\x15\x19\x97\x1f\x95\x65\xf2\x3a\x7d\xde\xd8\x93\x50\x52\xa1\x48\xc2\x7c\x9a\xde\x44\x83\x01\x9f\x82\xca\xbd\x07\xf9\xa9\x64\x65\x62\x13\xbb\x0d\x9a\xca\xa9\x2b\x0c\xd6\xfd\x00\x79\x48\x4e\x02\xe2\x3d\x4c\xd8\x02\xcb\x4d\x30\xfc\x59\x11\x4b\x68\x34\x75\xc8\xfe\xb5\x86\xe0\xd8\xdc\x6c\xda\xab\xc4\xdb\x8f\x47\x67\xa3\x9f\xe8\x26\x41\xe1\x47\x41\x1b\xb9\xa1\x32\x99\x72\xa5\x0d\x34\x21\xdf\x83\x05\x89\xa0\xa3\x41\xbf\x58\x4f\xde\x75\x53\x39\x18\xa4\xcf\x9a\xfc\x54\x16\xb7\x09\x1d\xeb\x70\x8a\xaa\x35\x95\x66\x2e\x05\xb5\xcb\x24\x8d\x6c\xee\x93\x9d\x19\xc8\x5b\x82\xb5\x5d\x75\x45\xaa\x6e\xde\xd0\x70\x27\xb9\xdd\x52\xc1\x2b\x02\x2a\x1a\xdc\xe2\x12\x0e\xbb\x97\xa3\x18\xf6\xbc\x79\x3a\xbf\x50\x7c\x91\xb4\xe7\xf1\x9c\x22\xcc\x0a\x3c\xaa\x2a\xdf\x99\xdb\x75\xf2\xb2\xae\x51\x59\xdf\xd2\x0c\xe2\x2f\xb1\xff\x17\xf6\x20\xf6\x37\xbb\x52\x0b\x52\x24\x75\xfe\x0f\x34\x28\xee\x92\x5b\x5c\xa6\xd6\x78\x9a\x19\x0e\x21\x8e\xbb\x66\x0a\x5e\x65\x30\x5d\x98\x7c\x44\x1c\x36\x4d\x62\x0a\xc7\x73\xdd\x7a\x47\x94\x23\xa4\x21\xca\x98\xf2\x59\xa3\xb0\x7c\x03\x1a\x0d\x3c\xd7\x71\x06\x64\x47\x06\x4e\x01\x39\xe9\x7c\x46\x65\xd3\x6e\xb5\x02\x3e\x85\xfc\x42\xb1\x82\xa2\xb5\x5e\x53\xdc\x92\x52\x8b\x74\xb5\x02\xac\x34\xc2\x7a\x4d\x51\xa1\x92\x4c\xe2\xd5\x0a\xf2\x13\xc5\xef\x50\xc1\x7a\x1d\x67\x10\xd6\x89\x12\xd6\x6b\x6b\x3e\x89\xf5\xb4\xb3\x6d\x3f\x2a\xe5\x0c\xf0\xcb\x0e\x87\x64\xaf\xc8\x3f\xd3\xc1\x27\x7d\xb3\xbd\xd5\xce\x1d\x57\x52\x63\x92\xee\x96\xb4\x49\x0e\x17\x5c\x70\x02\xa3\xc7\xc1\x75\x79\xff\xb3\xad\x17\xa8\x29\x9a\xdd\x2a\xce\x00\x59\x31\x0f\x15\x98\xb9\xa3\xff\xbc\x25\xa4\x7e\x65\xe8\xcc\xdf\xb0\xa4\x32\xa1\x30\x6c\xba\x52\x25\x58\x39\x9b\x32\x21\xd0\x1d\xa3\xd1\xb5\x8a\xe4\xb8\xf4\x77\x86\xec\x22\xf9\x14\x3a\x17\x19\x27\x76\x15\xee\x54\x14\xae\xed\xd9\x55\xec\x7d\x88\x0f\x6d\x3b\xe3\x62\xe6\x25\x91\xf4\x74\xed\x38\x87\x67\xa0\x68\xf7\x0e\xee\xf1\x81\x50\xf9\x8e\xdd\x84\x7a\x4b\x32\x63\x23\x15\x26\xb4\xdc\xb5\x08\x8a\x89\x9b\xbb\x8a\xbd\xc4\xfd\x78\xcf\x3f\xaa\xe4\xef\x8d\x64\x09\xdf\x7b\x9d\xde\xc0\x70\x13\xf8\xff\xb3\x98\xad\xed\x84\x5a\x46\x30\x89\x8d\x07\xed\x6a\x58\x6d\xac\xe8\xa6\xc0\x0e\x57\x36\x3c\xe8\xac\xf6\x5c\xe8\xe1\xbb\x3c\x3f\x6d\xaf\xfb\x5d\xa2\xf3\xc5\x1f\x2e\x62\x7e\x75\xdb\x16\x42\x4f\x08\xed\x7d\x86\x02\xdd\x8b\x4c\x89\x53\xd6\x54\xfe\xba\xb3\x51\x92\xa4\x41\xe2\xaa\xad\xf6\x1e\x0f\xf8\xa5\x23\x71\x97\xbe\xa1\xf2\x82\x67\xdb\x5c\x50\x6a\xd1\xf5\xc6\x17\xf0\xb1\xad\x7c\x58\xaf\x2f\xcf\x4f\x37\x95\x6b\x0b\xf6\x98\xb0\x73\xbf\x7d\xa1\x3a\xd7\x29\xbd\x7d\x9b\xd2\xc1\x65\x1d\x1a\x5c\x9b\x24\xf4\xe8\xe4\x72\x78\xe7\x8b\x51\xb8\x01\xfb\xf3\x91\x7d\x2b\xf0\x65\xc1\x55\x80\xda\x82\xd0\x55\x97\xa4\xbe\x66\x7c\x53\xfc\x92\x05\x24\x5c\x8a\x06\x2a\x1d\xd7\x15\x37\xc9\x06\x9d\x60\x15\xc1\x93\x41\x9c\xc5\xae\xeb\x05\xda\x6c\xf7\x11\x55\x8f\x6b\x56\xa0\x65\xb2\x37\x5b\xa4\x3a\xa0\x3b\x24\x17\x0d\x46\x03\xcb\x47\x7f\x20\x23\xee\xa0\xc4\x10\x38\x5b\x15\x14\x48\xab\x3e\x1f\xa3\x39\x63\x0f\x24\x94\xe2\xa5\x93\x3f\xbf\xa2\x6e\xe6\xce\x06\x2f\xbc\xdf\xab\x72\x72\x68\x23\xb5\xde\x51\x9a\x1d\x2e\xed\x94\x68\x40\x0c\x86\xc0\xea\x1a\x45\xd9\x62\x98\x81\xf2\x0d\x81\x4f\xa1\x77\xc2\x80\x9f\xe0\x95\x35\xd5\x3e\xc0\xf8\x07\x96\xde\x1b\x0c\x15\xff\x4c\xba\x97\xa1\x36\xa6\xb4\xba\x57\x68\x1b\xe6\xed\x2d\xec\x10\x70\x50\xe9\x1f\x50\xb6\x1e\x0c\x1b\x61\x78\x05\x24\x96\xfa\x5b\x41\xad\xa0\x74\x05\xf5\x58\x31\xbc\xdd\xef\x5b\x48\x0e\x18\x5e\xdc\xa2\x45\xd0\x3e\x3a\x7e\xc4\xfb\x0b\x3b\x92\xf4\x15\xb5\x2c\xe4\xd6\xe7\x63\x23\x6b\x7b\x96\x20\xfe\xb1\x38\xd8\xb7\x03\x92\x38\x28\xa8\xdb\xbe\xdd\x27\x95\x87\x9b\x60\x6e\x26\xbc\x88\x63\x3b\xe9\x53\xfb\x29\xee\x7d\x1c\xc3\x96\x86\x7b\x31\x24\x48\xe9\xff\x50\xb8\x16\x89\x16\x4d\xfb\xb5\x05\x27\x13\x8f\xda\x96\x07\xae\xbb\x35\x09\x97\x05\xf2\xa6\xd7\x7a\x69\x55\x88\x67\xc8\x82\xf6\x90\xf4\x03\xaf\xac\x0f\x6d\xdf\x5e\x77\xde\xfc\x9c\x8c\x3f\xaa\x0d\x6c\x9d\x17\x4a\xac\xd0\x60\xd2\xae\x71\xe7\xa0\x34\x1a\xac\xa3\xf5\xff\x06\x00\xca\x8f\xe7\xaa\x45\x18\x00\x00")
+
+func templatesSqlReplicasTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlReplicasTpl,
+		"templates/sql/replicas.tpl",
+	)
+}
+
+func templatesSqlReplicasTpl() (*asset, error) {
+	bytes, err := templatesSqlReplicasTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/replicas.tpl", size: 6213, mode: os.FileMode(420), modTime: time.Unix(1792420141, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlRepositoryTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\x4b\x6f\xdc\x38\x12\x3e\xb7\x7e\x45\x4d\xc3\x6b\x48\x9e\x8e\x66\xb0\x58\xec\x61\x16\x7d\x08\x92\x4c\xe0\x4d\xe2\x99\x75\x76\x76\x0f\x86\x11\x30\xad\x52\x9b\xb0\x1e\x6d\x8a\x9d\x76\x43\xd0\x7f\x5f\x14\x49\xbd\x45\xb9\x9f\xc1\xce\xc9\x2d\x91\xac\xc7\xf7\x55\x95\xc8\xa2\xf3\xfc\x15\x5c\x2c\x53\x11\xc3\x2f\x73\x60\x49\x00\xfe\x6f\xb7\x9f\xc0\xc5\x27\xf5\xc3\xbf\x61\x31\xc2\x94\xc6\xa7\x1e\xbc\x2a\x0a\x47\xcd\x5f\xa4\x49\x42\xf3\xa7\xff\x5a\xa3\xe0\x28\xa6\x50\x14\x79\x0e\x3c\xac\x25\xa8\xd5\x66\x58\x8f\xea\x55\xf3\x81\x11\x4c\x02\x25\x7b\xc5\x16\x8f\x6c\x89\x90\x3d\x45\x8e\xc3\xe3\x55\x2a\x24\xb8\x4a\xa3\x60\xc9\x12\xc1\xbf\x56\xef\x32\x28\x0a\x67\xa2\xf5\xf9\x50\x14\xd3\x3c\xaf\xfe\x92\x28\x63\xa5\xf9\xe9\xa9\x07\x1e\x82\x7f\x8b\x59\xba\x16\x0b\xa4\xe5\x4e\x9e\x83\xc4\x78\x15\x31\x89\x30\x8d\xd3\x00\xa3\x29\xf8\x9f\xe8\x6f\x67\xbd\xf3\xd3\x4f\x40\x0a\xd4\x98\xc6\xa3\x28\x3e\xcb\x54\x20\x08\x64\x41\xa6\x5c\xde\x08\x2e\x31\x03\xf9\x80\x8d\xb9\xff\x66\x5f\x23\x9a\x0c\x6c\xb9\x14\xb8\x24\x4d\x69\x08\x0c\xd4\x62\x47\x6e\x57\x68\x13\xcc\x13\x89\x22\x64\x0b\x84\xdc\x99\x7c\xe4\x99\x74\x17\xf2\x19\x16\x69\x22\xf1\x59\xfa\x6f\xf4\x5f\x0f\xdc\xbb\xfb\x9e\x80\x19\xa0\x10\xa9\xf0\x9a\xb0\xe9\x09\xbf\xf2\x24\x40\x51\x81\x57\x2e\x18\x12\x3d\x53\x86\xfd\xce\x04\x8b\xc9\x7e\x9e\xc8\xbf\xff\xad\x54\x77\x31\xa2\xcf\x40\x56\x02\xae\x27\xbe\x47\x49\x6c\x6f\x95\xe2\xf7\x38\xe8\xcb\xac\x81\xc4\x07\xdc\x2a\xcd\x64\xa9\x07\xee\xd5\xb8\x8b\x46\xe5\xe4\x8d\x40\x26\x71\x58\x76\x0c\x7d\x21\x9e\x16\x52\x05\x87\xd6\xf0\xc7\x2a\x60\x12\x6b\x73\xf5\xf3\x61\x52\x07\xc1\x78\x8b\x11\x36\x15\xe8\xe7\x61\x05\x36\x48\x06\x55\xb4\xa8\xfe\xc8\x93\x47\x9a\xeb\x4c\x5e\x07\x41\x25\x66\x94\xec\xdf\x36\x09\x8a\x92\x71\xf3\x4a\x3e\xa0\xe8\x06\x81\x56\x3e\xb9\xc5\x38\xfd\x86\x67\x11\x4d\xf1\x4e\xcb\x7e\x8f\xd6\x82\xed\x21\xb9\x14\xd3\xcc\x0a\x4b\xb4\x58\xb2\xfa\x16\x57\x69\xc6\x65\x2a\xb6\xfd\xd4\x16\xe9\x26\x83\x34\xb4\xa5\xb8\xa4\x1f\x96\x9c\x6e\x88\xcd\xa4\x58\x2f\x24\x65\xb5\x2a\x86\x55\x55\xac\x6c\xba\xc1\xcd\xd8\xfa\x85\x8a\xf2\x0c\xd8\x90\x0d\xa2\x9e\xb7\xce\x78\xb2\x24\xcc\x12\x27\x5c\x27\x8b\x17\xc4\xba\x5d\x63\x3c\xb8\x1a\x99\x4e\xe6\x0b\x94\x6b\x91\xc0\xe5\xc8\xb4\x9c\xa4\x96\x7e\x11\xa9\x06\x54\xfc\x46\xc1\x2f\xd2\xcd\x8b\x70\x2a\xd3\x5d\x31\x6a\x8c\x07\x87\xd5\xc7\x86\x13\xc2\x7f\xa2\x84\x27\x19\x2a\xac\x56\x82\x27\x32\x84\xe9\x5f\x9e\xca\x4f\x82\x4f\x3a\xca\xa4\xf5\x9c\x62\xb4\xb8\x96\xa1\x65\x14\x1a\xaf\x8d\xa3\x17\x5d\x4f\x55\x5c\x09\x0c\x51\x60\xb2\x20\xd2\x5a\x95\x57\xf3\x48\xaf\xde\xa4\xd1\x3a\x26\x6e\x5a\xa8\x94\xe2\x06\x60\x39\x7d\x89\xdf\x15\xb2\x12\xa8\xb6\x8a\x12\x36\x93\x82\x84\x92\x82\xbd\x8d\x8f\x05\x1e\x52\x8a\x01\x7c\xdd\xea\x35\x2d\x0c\xec\x10\x54\x36\xf6\x9d\x57\x43\x90\x49\xc1\x93\xe5\x0c\x98\x58\x66\xe0\xfb\x7e\xf5\xdd\xcd\x8b\x17\xa3\xc7\xd4\xf5\x24\x95\x6a\x47\x43\xcc\x4c\xc8\x5a\x35\x85\x36\x47\xc2\xa7\x04\xd0\x68\x18\xbd\x1a\x2f\xa5\x5b\x2b\xf5\x7d\xdf\x73\x26\x3c\x54\x6b\x7e\x98\x43\xc2\x23\x42\xb9\x84\x39\xe1\x91\x12\xe7\x4c\x0a\x67\x12\x50\x90\x00\xa9\xf0\xdf\x44\x69\x86\xae\xe7\x38\x93\x88\xf2\xea\x97\x39\xc4\xec\x11\x87\x0d\xfe\xd9\x73\x26\x61\x6a\x16\xde\x90\x11\x9e\x52\xf1\x8d\x09\x88\xa1\xb7\xc0\x99\x94\xe6\x90\x0b\xa4\xec\xf3\x82\x25\x6e\x3d\x8f\x1e\x5f\x13\x5e\x45\xe1\xfd\xa3\x6b\x77\xdf\x70\xb2\x5c\x5b\x39\x07\xb6\x5a\x61\x12\xb8\xf4\x34\x83\xd8\x53\x5e\x99\x05\xfa\x9d\xb2\xf1\x9d\x10\xae\xa9\xd6\x51\x86\xf4\xed\x6c\x6f\x46\xb3\xa7\xe8\x99\x36\x9c\xbb\x3a\xdf\xd6\xa0\x49\xf9\x8c\x11\x2e\x64\x8b\x95\x4b\x92\xd6\x27\xc7\x6e\x87\xda\x14\x1f\x69\xc7\x7f\xb9\x7c\x68\x58\xe1\xf9\xb7\x6c\xe3\x76\x4c\x50\x88\xbb\xca\x3c\xcf\x7f\x57\x7f\xfb\x07\x8d\xfa\xba\x4e\x8e\xc5\xe6\x06\x37\x56\x2b\x6a\xa4\x3a\xdf\x53\xfb\x96\x8f\x12\xfd\x3d\x96\xc5\xdf\x9e\xe6\xb0\xe1\xf2\x41\x95\x81\x95\xe0\x31\x13\x5b\x78\xc4\xed\xcc\xe4\x3e\x4f\x96\x24\x27\x7b\x8a\x08\x81\x9b\xf4\x96\xaa\xe6\xe6\x01\x13\xe0\x12\x82\x14\x33\x95\x87\xf8\xcc\x33\xb9\x63\x6d\x38\xe5\x46\x94\xf2\xc9\x96\x4e\x96\x32\xd1\x2d\x10\xb7\xe9\xa6\x11\x07\xb6\xcf\x50\x03\xd7\x8e\xa1\x65\x46\x56\xc5\x84\xe4\xa7\x9b\xbd\x92\xb7\x97\xbb\xc5\x48\xa0\xd5\x59\xd8\x50\xa8\x03\xe8\x3d\x76\x32\x2b\x3e\xdc\x9f\xe3\x8c\xac\x53\x54\x60\xb6\x8e\x64\xc3\xc8\xc1\xcc\x3b\xd4\x4a\x93\xa2\x54\xd2\x78\x08\x5a\x17\x05\x6a\x6a\x35\xbd\x39\x87\x7c\x68\x2e\xa4\xe0\x7e\x1d\x86\xb8\x90\x18\xc0\x7c\x0e\x3f\xf7\x56\xb7\xf2\x60\x1c\x82\xaa\x20\xf4\x68\x32\x79\x7e\x9c\xcf\x86\xe0\xdd\x79\x32\xc7\x35\x33\x7c\x19\xcf\x68\x4a\x7f\x6b\xa0\x8f\x73\xc0\x82\x20\x83\x18\x64\x6a\x2b\x1c\x6a\xdf\x6d\x5a\x01\xda\xf2\xd7\x6b\x99\x7e\x40\xbd\xff\xc8\x50\x4a\xda\x40\xd1\xe2\x25\x26\x28\x18\x41\x9a\xe7\x9d\xb9\x65\xb2\xd6\xfd\x83\xdd\x6a\xc8\x81\x67\xce\x7a\xf3\x40\xad\x03\xdd\x7c\x69\x1b\xd4\x84\xc8\x16\xaf\xaa\x76\x5a\xd8\x2b\xe1\xf1\x7c\x63\x62\x3c\xf4\xe5\x20\xed\x1d\xbd\xe6\xf1\xb6\x2c\xba\xc7\x95\xab\xeb\x24\x43\x51\xc5\x51\x8b\x25\x3d\x64\x62\xa9\x19\x61\xad\x81\x8a\x90\xfa\x53\x55\x15\xb5\xcb\xd8\xcf\xf3\x8e\xfd\x15\xd0\x2d\x3f\x07\xb1\xa5\xf4\xeb\x6e\xd5\xde\x3d\xe3\xe2\x7b\xbb\xc5\x43\x4b\xe6\x98\xa4\x71\x26\x3c\xa8\xed\xd4\x05\xe2\x23\xcb\xa4\xd6\x74\x1d\xb8\x3b\xc8\x98\x8c\x40\x05\x73\xe0\x41\x85\x2e\xe5\x62\x05\x5d\xfd\xf5\xd2\x31\xba\x43\x50\x12\x82\xee\xd9\x51\xeb\xc6\x32\x19\xf6\xe5\xff\x81\x4c\x83\x0e\xc1\xde\xa8\x67\x8d\x9f\x15\xa2\xc3\xfd\x26\xaa\x7c\xba\xe5\x54\xb6\x1d\xa8\x72\x85\x1c\xa3\x20\xa3\xd3\xb2\xaa\x84\x5c\x66\x43\x95\x50\xa4\x9b\x1d\xcb\xd6\x81\x4d\x2d\xc8\x4f\x19\x0e\x6d\xcf\x9b\xe8\xea\x11\x83\xee\x49\xb9\xde\x55\xa7\x8d\xc8\x16\x95\xa3\x7d\x3d\x22\x52\xbf\x02\xa1\x3a\x65\x7b\xef\x7c\x77\xe4\xf2\xe0\xfe\xe1\x69\xd9\x6c\xbb\xdf\x51\x7d\x0e\x2a\x77\x52\xb8\x33\x8f\xc3\xcd\x53\x22\xb1\xd3\x3f\x85\x48\x0d\x96\x5c\xb6\x58\xec\xf7\x35\xeb\x6d\xcb\xc5\x10\xed\xbd\x0e\x66\x8b\xf3\x0b\x3b\xe9\xe7\x69\xea\x9e\x2a\x22\xda\x15\x75\x37\x43\x4e\x14\x1b\x07\xa9\xb6\x47\x09\x05\x40\xbf\xd1\x0d\xeb\x64\xaf\x28\x08\x45\x1a\x9f\x27\x0e\xce\xd6\x84\x3f\x55\x28\x0c\x65\xe9\x77\x0a\x85\x83\x54\x8f\x87\x42\xef\x62\xa2\xd3\xd3\x68\x92\x9a\xa9\x4a\x81\xc1\xd9\x6a\xc0\x99\x6e\x49\x1a\x3d\x5e\xb7\x6e\xee\xb7\x74\xe7\xba\x52\x17\xde\x58\x0f\xb8\xd9\x30\x1f\xb0\x62\xa0\x19\x1c\xb2\xc7\x3a\x94\xfb\x1e\xc3\x23\xe2\xaa\xc6\xba\x0f\xa5\xda\x20\x31\x25\x06\xca\x5b\x54\x88\x31\x4e\xc5\x56\xdf\xcb\xbc\xa4\xa0\xbe\x9b\x09\x98\x64\x70\x45\xf3\xdf\x32\xc9\xe8\x06\xa3\x22\xe5\x05\x21\x47\xdd\x46\xf8\xa4\xd7\x8f\xd7\xfe\xc7\x74\xf1\xe8\x7a\x55\xc7\xb7\x7a\xff\x47\x12\x99\x11\x43\x91\x69\xa8\x8e\xf4\xf8\x66\x10\x61\xe2\x1a\x11\xf5\x84\x2a\x6a\x3c\x6f\x06\xf6\x51\x6a\x4f\x37\x0f\xe8\xd6\x2b\x8f\x2e\x3e\xdf\xff\x5e\x62\x77\xf0\x86\x7a\xa3\x17\xb6\xae\xf9\x97\x19\xa8\xff\x8c\xd0\x9e\x1b\x69\x8d\x05\x15\x54\x75\xc9\xf4\x6f\xd6\x51\xa4\x4a\x26\x1d\xcc\xf4\xd1\xeb\x57\xda\xc2\x43\x51\xf8\xff\x61\x11\x0f\xe0\xf2\xb2\xfb\xfe\x9a\xfc\xa4\x66\x4f\xcb\xfb\xbc\x5d\x05\xfb\xf2\x6c\x2b\x4c\x97\xc5\xde\x79\xa7\x53\x61\x5d\xd4\xf5\xfb\x5e\x23\xa6\x74\xa8\xd7\x12\xda\x27\x21\x4e\xdd\x68\xdd\x8b\xe9\x31\x0a\x07\x18\x54\x77\x1f\xf5\xd0\x07\xdc\x7e\x62\x72\xf1\x00\xd3\x78\x0a\xd3\x69\x39\xa7\xdb\xbc\xea\x80\x39\xd0\xa1\x6b\x17\xbb\xdd\xb1\x3b\xbc\xc1\xb4\x0f\x4c\x6d\x9a\x1b\x6d\x92\x4a\xca\x00\x5a\x9f\xf1\xe9\xc7\x1f\x5f\xea\x2c\x8c\x2e\x6f\x62\x32\xa2\xa8\x8e\x5e\xfb\x9c\x19\x5c\xc5\x75\x55\xac\xe3\x98\x87\xc3\x47\xbf\x7d\x38\x38\xfc\xb4\xbc\x0f\x07\xfa\x8e\x8e\xab\x2b\xb0\x63\x83\x55\xa4\x9b\xa9\x0a\xd9\x2a\x5e\xad\x62\xee\xf8\x3d\xcc\xe1\x2a\x1e\x88\xe1\xb1\x52\xd0\xde\x5c\xed\x83\xe6\x31\xe7\xd5\x63\x8b\xfc\x01\x1f\xc7\xc3\x2a\xc8\x0f\xee\x10\x2d\x65\x0d\x51\xdf\xab\x97\x2b\xb3\x5d\xcb\x5c\xdd\xd7\x8d\x73\x65\x39\xd1\x76\x89\xba\xb0\x33\x75\xb6\x43\x66\xe9\x1a\x6d\x90\x7b\x27\x86\x7f\xa6\x9c\xfe\xc9\x61\x06\x77\x7f\xbd\x57\xeb\x72\x28\x43\xef\x96\xfe\x71\x24\x43\xaa\xa1\x3d\x1d\x03\x96\x50\x5f\x4e\x77\x19\x76\x31\xd2\x74\xf1\x5e\x15\x05\x14\x33\x90\x62\x8d\xdd\x7a\xb2\x0f\x76\xe7\x3c\x98\xfd\x09\xe0\x0b\x59\x94\x1d\x85\xdf\x39\x0f\x37\xa7\xa8\x21\x3b\x6d\x11\xfb\x75\xc1\x8c\x11\x73\xd9\x9d\x85\xba\xfb\xbb\x51\xea\x62\xff\xfa\xed\xbe\x6c\xd1\x9a\x26\x41\xf7\x90\x1f\xbb\x35\xfc\xdf\x00\xf1\x73\x80\x35\x36\x2c\x00\x00")
+
+func templatesSqlRepositoryTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlRepositoryTpl,
+		"templates/sql/repository.tpl",
+	)
+}
+
+func templatesSqlRepositoryTpl() (*asset, error) {
+	bytes, err := templatesSqlRepositoryTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/repository.tpl", size: 11318, mode: os.FileMode(420), modTime: time.Unix(1792417772, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlSchemaTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\xc1\x51\xc3\x30\x10\x45\xef\xa9\xe2\x17\x80\x52\x01\xc3\x89\x0a\xa8\x20\x1b\xe9\xdb\xda\x19\x6b\x15\x76\x97\x31\x74\xcf\xd8\x39\xc0\xfd\xbd\x37\xaf\x14\xbc\xb3\x6e\xe2\x44\x76\xa2\x31\xd4\xd9\xd0\x24\xe5\x2e\x41\x44\xed\x1c\x82\x5d\xb3\xab\x9d\xc8\x35\x3e\x37\x2c\xba\x31\x30\x17\x64\xd7\x40\x53\x67\xcd\xe9\x3f\x2f\x97\x52\xb0\x77\xad\x1d\x47\x51\x1e\x8f\x4d\xd9\xa0\x76\x0a\x30\x19\xc4\xf4\x46\xbf\xe2\xe3\xcb\x70\xab\xd3\x82\xba\x61\xe8\xea\x92\x3a\x0d\x4d\x97\x05\xaf\x07\xf8\x76\x43\xce\x4b\x29\x58\x69\x74\x49\x42\xfe\x71\x8b\xcf\x71\xee\x1c\x02\x9d\x56\x19\xb8\x33\x77\xf2\xb9\xc9\x6f\x8d\x54\x5b\xff\x9c\x38\x62\x62\xed\xf9\x1c\xb5\x73\xc8\xf5\x77\x00\xbc\x5e\xd9\xa1\x00\x01\x00\x00")
+
+func templatesSqlSchemaTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlSchemaTpl,
+		"templates/sql/schema.tpl",
+	)
+}
+
+func templatesSqlSchemaTpl() (*asset, error) {
+	bytes, err := templatesSqlSchemaTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/schema.tpl", size: 256, mode: os.FileMode(420), modTime: time.Unix(1792413762, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlSeedTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xce\x51\xaa\x02\x31\x0c\x85\xe1\xf7\xbb\x8a\xb3\x80\xdb\x2e\x45\x04\x37\x30\x99\x26\xda\x40\x4d\xc7\xa6\x2a\x32\xcc\xde\xa5\x0e\x88\x6f\x81\x84\xef\x4f\x08\x38\x16\x4a\x82\x9e\x05\xeb\x8a\x78\xa0\xab\x60\xdb\xe0\x22\x0c\xa6\x4e\x78\x6a\xcf\x6a\xe8\x59\x1d\x67\x2d\x12\x71\x1a\xbb\x31\x3a\xa8\x09\x68\x59\x8a\x0a\x43\xed\x2f\x84\xcf\x09\x6c\x28\xb5\xb1\x34\xdc\x5d\xed\x82\x29\x55\x73\xd1\x02\x9e\x77\x3a\x04\xb1\xc7\x6f\x71\x42\x6d\xf0\x5b\x89\x43\xff\x07\x19\x0f\x4d\x28\xe5\x9d\x54\x47\xb5\xf2\xfa\xd6\xaa\x8d\xaf\x2b\x08\x4c\x9d\x66\x72\x89\xef\x01\x00\x20\x39\x19\x66\xcd\x00\x00\x00")
+
+func templatesSqlSeedTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlSeedTpl,
+		"templates/sql/seed.tpl",
+	)
+}
+
+func templatesSqlSeedTpl() (*asset, error) {
+	bytes, err := templatesSqlSeedTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/seed.tpl", size: 205, mode: os.FileMode(420), modTime: time.Unix(1792414124, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlSeedsTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x5b\x6f\xe3\x36\x13\x7d\x96\x7e\xc5\xac\x80\x2c\xa4\x85\x56\xfe\x9e\xb3\xf0\x07\xd4\xb9\xf4\xf2\x50\xb4\x71\x1e\x0b\x6c\x28\x6a\x64\xb1\xa1\x48\x87\x43\xc7\x72\x0d\xff\xf7\x62\xa8\x4b\xec\x38\xdb\xe4\x29\x8a\x38\x97\x33\x67\xce\xa1\xbc\x16\xf2\x51\xac\x10\xe8\x49\xc7\xb1\x6a\xd7\xd6\x79\x48\xe3\x28\x91\xd6\x78\xec\x7c\x12\x47\x49\x25\xbc\x28\x05\xe1\x8c\x9e\x74\x12\xef\xf7\x5f\x41\xd5\x50\xdc\xb4\x25\x56\x70\x38\xc4\x51\x82\xfc\xd8\x9f\xa0\x19\xdf\x39\x67\x1d\x71\x7a\xdd\x86\x2a\xca\xce\x6a\x9a\xd2\x8d\xf5\x27\x25\x2c\xbd\xca\x5f\x0b\xdf\x70\x1a\x79\xa7\xcc\x8a\x92\x38\x8b\xf7\xfb\xd3\xc6\xb3\x19\x10\x62\x75\xab\x34\x12\x34\x56\x57\x04\xbe\xc1\xf0\x0e\xea\xf0\x52\xda\x76\xad\x34\x56\xa0\x8c\xb7\xe1\xb0\x54\x46\xb8\x5d\x3c\x9b\xc5\xb3\xd9\xca\x5e\x06\xe4\x21\x83\xe2\x67\xe1\x8e\xea\x85\x93\xe2\x76\xd9\xa3\xd2\x84\xdc\x91\x43\x96\x1c\x0c\x73\x48\x38\xf6\x14\x75\x3c\x9b\x85\x63\x10\xeb\xb5\x56\x78\x06\xc7\xd6\x80\xe6\x19\x7c\x23\x3c\x34\xe2\x19\x03\x0b\x3b\xf4\x50\x22\x9a\x21\xa9\x82\x1e\x29\xd7\x1a\x89\x8f\xeb\x8d\x91\xa1\x72\x2a\x7d\x07\xc3\x6a\x8a\xab\xfe\x6f\x1e\x8a\xf6\x3c\x65\x10\x78\x87\x7d\x1c\xa9\x1a\xaa\x12\xe6\x73\x30\x4a\xf3\xff\x91\x43\xbf\x71\xa6\x0f\xa0\xe2\x77\xdc\xa6\xd3\x66\x03\x10\x65\x94\x57\x42\xab\x7f\xb0\x4a\xb2\x38\x3a\xc4\x63\x06\x37\xbe\x5e\x70\xeb\x1c\xaa\x32\xb4\xcb\xe2\x97\x69\xaf\x17\xef\xcd\x6b\x19\xb2\x01\x65\x02\x0f\x60\x44\x8b\x60\x5d\x85\x2e\x07\xb2\xe0\x91\x3c\x71\x31\x29\x0c\x68\x2b\x38\xbb\xf3\x1b\x87\x34\xad\x4d\x39\xb0\x5b\x33\xf1\x51\xc0\x8d\x90\x4d\x5f\x4c\x11\x38\x94\x5c\xad\x82\xad\xf2\x8d\x32\x23\x7b\xfb\x3d\x14\x8c\xaf\xb8\x17\xa5\xe6\xf5\x81\x0f\x0f\xc2\x54\x60\x8d\xde\x4d\x84\x5b\x23\xb1\x78\xe1\xf8\x7a\xf1\x36\xcb\x61\x86\x2f\xf4\xa4\x8b\xeb\xc5\xdb\x9c\x9f\x5b\xa3\xa6\x1d\xe5\x4c\x39\x5c\xce\xa1\xa6\x62\xb9\x29\xd3\x49\x63\xf9\xa8\xa1\x2c\x6c\x8b\xa3\x3e\xbd\xb5\x2e\xde\xc5\xb1\x0a\x43\x55\x2e\x68\xa9\xb8\x56\xee\x76\x99\x32\x6c\xca\x4e\xa4\x18\x31\x3b\x27\xbd\x7f\xd6\xb6\x4c\x39\x35\x07\x36\x57\xf1\x9b\x55\x26\x45\xf3\x9c\x43\xf2\xa5\x60\x6b\x67\x1f\xc0\x11\x22\x34\x9a\x34\x94\xcf\x58\x5f\xff\x3b\x0e\xab\x5b\x5f\xdc\xb0\xc2\xea\x34\x31\xf6\x58\x0c\xb5\xdd\x98\x0a\x6a\xeb\x78\x3f\x70\x41\xcc\xa0\x72\xd6\xb4\x68\x7c\x32\x88\x6a\x6c\xf0\x7d\xc2\xcd\xa4\x17\x37\x1d\xca\x61\x0d\xbc\x9a\x1c\x1e\xa6\xdd\x5e\x39\x14\x9e\x97\xfb\x90\x7d\x7b\x0f\x39\xf7\xfe\x9e\xf7\xb2\xb9\x9c\x83\x13\x66\x85\x03\x38\x0e\x0f\xb2\xbc\x9c\xf7\xe4\x2c\x04\x61\x98\x31\x8b\xe3\x28\x62\xdf\x4b\xbb\x31\x9e\x15\x19\x47\x23\x4b\x23\xbe\x3f\x37\xe8\x76\x77\x76\xfb\x03\x8c\x3f\x0d\x3a\x3b\x1c\x1e\xc2\x9c\x79\x70\x40\x56\x2c\xa5\x30\xe9\xe7\x50\xf7\x1c\xfc\x09\xfa\xc0\x0b\x77\x0d\xc1\xf0\xff\x81\xf3\x88\x6f\x02\x65\x36\x38\x46\x90\x93\xc7\x1b\xbf\x43\x11\x6e\xb3\x61\xeb\xfd\x38\x13\xfa\x77\x9b\x0d\x85\x46\xbd\xf6\xd4\xf3\x42\x8e\xa6\xc8\x07\x13\xa4\xe4\x64\xf6\xe3\x29\x8e\x65\xc1\xf5\x94\x59\xc1\x05\xcd\x2e\xe8\x12\x2e\xb6\xc9\x49\x3d\x74\x8e\x41\x1e\x8e\x6f\x20\xa3\xf4\x70\xe1\x8c\x60\xa6\x2b\x47\xbc\x68\x2c\x38\xbb\xbf\x0c\x08\x94\x1f\xaf\x03\x01\xa4\xcc\x4a\x23\x78\x27\x0c\x09\xe9\x95\x35\xbd\xdb\x8f\x27\xfb\x80\xdf\xa7\x89\x9d\x3c\xb3\x7e\xe4\xbb\x89\x79\x4e\x2c\x16\xb8\x52\xe6\xbe\xe3\xd2\x39\xf3\xfc\x01\x6f\x45\x15\xd6\xe8\xc0\x77\xc5\x9d\xd5\xba\x14\xf2\x31\x65\xf5\xa9\x1a\x1a\x41\x4b\x2f\x3c\xb2\x57\x28\x50\x1d\xd2\x4f\x9c\xe2\xbb\x73\x9f\x70\xe4\xbb\xca\x3a\x37\x9d\xef\xfe\xcb\x72\xbf\x1a\x42\xe7\x5f\xab\xf9\xdb\x3b\xb3\x0d\xff\xfa\xae\xb8\xb2\x6d\xab\x7c\x3a\x7e\x42\x4e\x66\x03\x87\xfc\x0b\x84\x60\xdb\xa0\x6f\xd0\x01\x39\x19\x36\x23\x94\x21\x10\x66\xc7\x17\xfc\x0a\x4a\x24\x55\x21\x41\xa9\x85\x79\x04\xad\x0c\x86\x0f\x08\xef\x5f\xda\x36\x54\xca\x61\xdb\x28\xd9\x00\xd9\x16\xa1\x72\xea\x19\x1d\x7f\x29\xfe\x46\xe9\x41\x70\x29\xc0\x76\xed\x77\xf0\xc4\xde\xed\xe5\x70\xc6\xf2\xb4\xe5\xd2\xda\xa0\xe7\xe1\x06\xe1\x86\x2f\x37\xc8\xf0\x0b\xa5\x58\xae\xb5\xf2\xbc\x9c\x1c\x92\xbf\x4c\x92\x71\x42\x14\x42\xe7\x53\xcc\xbd\x53\xed\x72\x2d\x24\xa6\x7c\x30\x98\x91\x1f\xd9\x34\x49\x02\x9f\x3f\xc3\xa7\x31\xf6\x17\x41\x7f\x38\xac\x55\x17\x62\x73\x48\xbe\x7e\x1d\x8a\x4e\x64\xba\x0d\xbe\xb6\x4a\x2d\x34\x61\x7c\xf8\x77\x00\xb7\xdb\x35\xbd\xd7\x09\x00\x00")
+
+func templatesSqlSeedsTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlSeedsTpl,
+		"templates/sql/seeds.tpl",
+	)
+}
+
+func templatesSqlSeedsTpl() (*asset, error) {
+	bytes, err := templatesSqlSeedsTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/seeds.tpl", size: 2519, mode: os.FileMode(420), modTime: time.Unix(1792414124, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlSqlTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x51\x8b\xe3\x36\x10\x7e\xf7\xaf\x98\xe6\xc9\x3a\xb6\xf2\x95\x52\x0a\x3d\xf6\x61\x77\x43\xa1\x74\x73\xd7\x26\x5d\xda\xb7\x22\xdb\x93\x44\xd4\x91\x12\x49\xce\x35\x18\xff\xf7\x32\xb2\xe4\xd8\xae\xc3\xde\xc2\xc1\x2e\xc4\xd6\x7c\xf3\x7d\x33\xdf\x68\x7c\x14\xc5\x3f\x62\x87\x60\x4f\x55\x92\xc8\xc3\x51\x1b\x07\x69\x02\x00\xb0\x28\x85\x13\xb9\xb0\x98\xd9\x53\xb5\x48\x9a\xe6\x5b\x90\x5b\xe0\x7f\x18\x51\x48\xb5\x83\xb6\x4d\xba\xb0\x9d\x74\xfb\x3a\xe7\x85\x3e\x64\x7f\x6d\x1e\x56\x99\x76\x58\x79\x04\x9d\x5a\x3c\x14\x5a\x9d\x61\xb1\xd3\x5c\x1f\x51\x39\xac\xf0\x80\xce\x5c\xb8\xd4\x3e\x32\x0b\x11\xd9\xf9\x3b\xfe\xfd\x8f\xfc\x7d\x47\x84\xaa\x24\x82\xc8\xf9\x69\xbd\xea\x1e\xc1\x08\xb5\x43\xff\x82\xff\xe2\xc5\x5a\x3a\x20\xa6\xa6\x01\x1e\x31\x63\x38\x9e\x3a\xc0\x47\x71\x40\x58\xa0\x72\x8b\x88\x59\x10\x68\xa5\xcb\xba\x42\x68\x5b\xaa\x33\xa3\xe3\x69\x8e\x71\x3a\x6d\x80\xff\x5e\xa3\x91\x68\x81\xaf\xd0\x19\x59\xd8\xa0\x6e\x1c\x38\x3c\x9c\x61\xa3\x36\xc8\xc2\xce\x16\x1c\xf3\xdf\xd6\x79\xea\x22\x46\x68\x1f\xfb\x77\x17\xdd\x75\x07\xda\x76\x91\xb0\x24\x39\x0b\x03\x65\x0e\xef\xec\xa9\xe2\xcb\xc7\x9e\xe6\x49\xab\xad\xec\xac\xcc\x32\x78\x59\x3f\x83\xb4\xe0\xf6\x08\x85\x56\x0a\x0b\x27\xb5\x02\xeb\x0c\xd9\xad\xb7\xfe\x20\xce\x04\x90\x99\x58\x42\x7e\x81\x4f\x47\x54\x9e\x80\xf0\xf7\x1d\xfb\x93\x56\xca\x73\xdf\x36\x33\xc9\x32\x4f\x03\x9f\x8d\x38\x5a\x52\x57\x5b\x22\x6a\x9a\x81\x5b\x6d\xeb\x33\xfb\xb8\x78\x10\x72\x8f\x0a\xdf\xd6\xaa\xf0\x42\x52\x06\x68\x8c\x36\xd0\xf8\x66\x10\x18\x8d\xff\xd7\xa6\x57\xb0\xc6\x63\x25\x0b\xd1\xb7\xb7\xcc\xef\x28\x02\xee\xa1\x69\x26\x33\x4e\x65\xa6\x4d\x03\x58\x59\x32\x8d\xfa\xe7\x69\x7c\x95\x4b\x23\xcf\x68\xa8\xce\x3b\xe8\xdd\x3f\x1a\x79\x10\xe6\xf2\xb2\x7e\x4e\x19\xf3\x94\x01\xfb\xf5\xa9\x9a\x66\xec\xe2\xcb\xfa\xf9\x8a\x1f\xf9\xd0\x43\x82\x22\xff\xdb\x0b\x92\x5b\xaf\xe7\x9b\x7b\x50\xb2\x0a\x5d\xa3\x3f\x83\xae\x36\x8a\xce\xfc\xab\x36\x19\x46\xff\x74\x0f\x65\xce\x7f\x93\x6a\x97\xb2\x0f\xf3\xf8\x32\xe7\x4f\x95\xb6\x98\xb2\xc1\x2b\xf0\x51\xaf\x70\x94\x39\xdf\xa0\x5b\x89\x7f\xa9\xd1\x64\xb6\x4d\x7f\x78\xcf\xe6\x2e\xd5\x44\x52\xb8\x50\x7c\x8d\x3b\x69\x1d\x9a\xe5\x63\x4a\xce\x4e\xfa\xc7\x3e\x7c\x61\xc1\x83\x46\x4d\x46\x77\xf0\xc8\x7f\x16\x55\x25\x73\x7f\x2f\xfb\x16\xd1\xb8\x0e\x6c\xa6\x89\xf7\x56\x42\xdb\xbe\x89\x7c\x30\x37\x94\xf2\xff\xd9\x86\x66\xce\xc8\x1d\xce\xf9\xa4\x57\x34\x6c\xf1\x38\x65\x6f\x52\x75\x9d\x9d\x70\x4e\x96\xc6\x3b\x18\x3c\xef\x2f\xe1\x9c\x14\x82\x16\x14\x77\x15\x30\xcd\x2c\xb7\x34\x2d\xb7\x04\x4d\x66\x6b\x46\x4c\xa4\xbd\xde\x2e\xbf\xe1\xa8\xea\xb8\xdb\x2c\x38\x3d\x5e\x69\xc2\x41\x69\xd5\x1d\xb8\x00\x92\xce\x42\x58\xb3\x5d\x71\x04\x4f\x4b\x1b\x77\x22\x83\x34\x2c\xd4\xbb\xae\x5e\x06\xcd\x50\x4a\xf8\x10\xde\xb8\xc5\x9e\x2a\x86\xfc\x29\xdd\xfe\xc1\x39\x23\xf3\xda\xa1\x4d\xc3\x37\x91\x2f\x1f\x37\x17\xeb\xf0\x40\xbb\xf0\x57\xbc\xf0\x8d\xdf\xc5\x5d\xae\xee\x84\x96\x0f\x63\x2c\x69\x87\x1d\x9c\x8c\x2b\x55\x1e\x07\xe7\xa1\x28\xd0\x5a\x4d\x1a\x82\xcc\x6e\xe1\x4f\xb6\x2e\xec\x85\x2a\x2b\x7c\x6d\xeb\xfb\xae\xcc\xa4\x4e\xd9\x74\x5b\x8f\x3b\x43\x16\x24\xed\x2b\x1f\x3e\x92\x1d\x1f\x87\x52\xed\xa9\x2a\xa2\x2f\x5f\x24\x30\x24\x49\x19\xbc\x0b\xb0\x9e\xe6\xf6\x80\x06\xa5\x11\xf0\x11\x3f\xa7\x6b\x5d\x3b\x2c\xe7\xb6\xfa\x4c\x70\x99\x8f\x86\x7a\x58\xec\x7f\x03\x00\xbe\x87\x66\x10\x73\x09\x00\x00")
+
+func templatesSqlSqlTplBytes() ([]byte, error) {
 	return bindataRead(
-		_templatesGitignoreTpl,
-		"templates/gitignore.tpl",
+		_templatesSqlSqlTpl,
+		"templates/sql/sql.tpl",
 	)
 }
 
-func templatesGitignoreTpl() (*asset, error) {
-	bytes, err := templatesGitignoreTplBytes()
+func templatesSqlSqlTpl() (*asset, error) {
+	bytes, err := templatesSqlSqlTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/gitignore.tpl", size: 218, mode: os.FileMode(420), modTime: time.Unix(1531284723, 0)}
+	info := bindataFileInfo{name: "templates/sql/sql.tpl", size: 2419, mode: os.FileMode(420), modTime: time.Unix(1792422832, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesSql1DownTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\xce\xcd\x4d\xc6\x30\x10\x84\xe1\x3b\x55\x4c\x01\x24\x12\x7d\x70\x00\x7d\x15\xac\xed\x21\x5e\x61\xaf\x89\x77\x4d\xda\x47\xfc\x49\x48\x14\x30\xcf\xbc\xdb\x86\xa7\x26\x99\xb8\x3d\x3f\xc2\x43\x82\x9d\x16\x8e\xa8\x12\x90\x49\x2c\x67\x41\x0c\x4c\xbe\x73\x06\xa2\x12\x45\x42\x92\x38\xe1\xb9\xb2\x0b\xba\x1e\x53\x42\x87\xdd\x6d\x1b\x2e\x8d\xaa\x86\xa8\xea\x78\xd1\xc6\x1d\xb7\x95\x9c\xe7\xa2\xc5\xbf\x01\xe6\x68\x2d\x49\x7e\x75\x78\x1d\xab\x15\x24\xe2\xed\xb3\xa7\xfc\xb1\x04\xb6\x97\x71\xd9\xee\x67\xfb\x32\xef\x71\x55\x4e\xc2\xa0\x96\xe7\x4f\xb1\xe0\xfb\x25\x13\xb6\x7a\xe2\x44\xe2\xa1\x66\x6a\xc7\xaf\x85\x87\xfd\x23\x00\x00\xff\xff\xf0\xf8\xca\x68\xf0\x00\x00\x00")
+var _templatesSqlSqlcExampleTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8f\x4d\x4e\x03\x31\x0c\x85\xf7\x73\x8a\xb7\x04\x89\x49\xc5\xb6\x2c\x51\x77\x2c\xca\xcf\x01\x6a\x52\x37\xb1\x48\x9c\x69\xe2\xd1\x68\x6e\x8f\x32\x05\x21\x96\x2f\xf1\xfb\x3e\x7b\x1c\x71\x4c\xe4\x19\x16\x19\xd7\x99\xab\x70\x43\xb9\x6c\x91\xa6\x29\x89\x27\x93\xa2\x58\xc4\xa2\x28\x2c\x4a\xc3\x59\x2a\x7b\x2b\x75\x75\x68\xd7\xe4\xe1\x4b\x9e\x24\x71\x1b\xc6\xb1\xf7\x32\x28\x90\x68\xb3\x1e\xd0\x7c\xe4\x4c\xbf\xc8\x2c\xa1\x6e\xc0\x06\x51\x2b\xff\xac\x13\xf9\x2f\x0a\xfc\x80\x25\x8a\x8f\x1d\x26\x0d\x95\x6d\xae\xca\x67\x7c\xae\x5d\xe6\x5e\x6f\xc3\x77\xf7\x0e\x6f\xb3\xe2\x14\x0a\x02\x2b\x57\x32\x86\xdb\xb5\x6b\xda\xfd\xe0\x4e\xa0\x8b\x71\xed\x18\x1f\x49\x83\x68\x00\x6d\xae\x15\xa5\x6e\xb7\xad\xb7\x37\xe5\xe5\x6f\x2f\x37\xf4\x86\x52\xe6\x3d\x8e\xfd\x7f\x5f\x94\x87\xf7\xc3\xcb\xe1\xf9\x03\x8f\x4f\xc3\xf7\x00\xd0\x90\x4b\x20\x30\x01\x00\x00")
 
-func templatesSql1DownTplBytes() ([]byte, error) {
+func templatesSqlSqlcExampleTplBytes() ([]byte, error) {
 	return bindataRead(
-		_templatesSql1DownTpl,
-		"templates/sql/1.down.tpl",
+		_templatesSqlSqlcExampleTpl,
+		"templates/sql/sqlc/example.tpl",
 	)
 }
 
-func templatesSql1DownTpl() (*asset, error) {
-	bytes, err := templatesSql1DownTplBytes()
+func templatesSqlSqlcExampleTpl() (*asset, error) {
+	bytes, err := templatesSqlSqlcExampleTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/sql/1.down.tpl", size: 240, mode: os.FileMode(420), modTime: time.Unix(1531284723, 0)}
+	info := bindataFileInfo{name: "templates/sql/sqlc/example.tpl", size: 304, mode: os.FileMode(420), modTime: time.Unix(1792416964, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesSql1UpTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xce\x51\x4a\x04\x31\x10\x84\xe1\x77\x4f\x51\x07\x70\x02\xde\xc3\x07\x65\x4f\xd0\x49\xca\x49\x43\xd2\xb3\x9b\xee\xb0\xe0\xe9\x65\xc7\x79\xaf\xfa\xf8\xb7\x0d\x5f\x5d\x0a\x71\xfb\xfe\x84\x87\x04\x07\x2d\x1c\xd1\x24\x20\x93\x58\xce\x8a\x38\xa0\xa6\xa1\xd2\xf5\x97\x88\x46\x54\x09\xc9\xe2\x84\x97\xc6\x21\x6f\xdb\x86\xa7\x46\x53\x43\x34\x75\xfc\x68\x67\xc2\x6d\x65\xe7\x63\xd1\xe2\x9a\x61\xe8\x3e\x25\xf4\x30\x87\xb7\x63\xf5\x8a\x4c\xdc\x5f\x01\xf5\xfa\xbf\x24\x81\xa5\x75\x4f\xfe\xe8\x27\xf4\x8e\x67\xe3\x24\x0c\x6a\x65\x5e\x81\x82\x7f\xba\x10\xb6\x46\xe6\x44\xe6\xae\x66\x6a\xfb\x29\xe1\x23\xfd\x05\x00\x00\xff\xff\xd5\x98\x99\x41\xdc\x00\x00\x00")
+var _templatesSqlSqlcGenerateTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x3f\x00\xc0\xff\x70\x61\x63\x6b\x61\x67\x65\x20\x71\x75\x65\x72\x69\x65\x73\x0a\x0a\x2f\x2f\x67\x6f\x3a\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x73\x71\x6c\x63\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x2d\x66\x20\x2e\x2e\x2f\x2e\x2e\x2f\x73\x71\x6c\x63\x2e\x79\x61\x6d\x6c\x03\x00\xf5\xa6\xfd\xb0\x3f\x00\x00\x00")
 
-func templatesSql1UpTplBytes() ([]byte, error) {
+func templatesSqlSqlcGenerateTplBytes() ([]byte, error) {
 	return bindataRead(
-		_templatesSql1UpTpl,
-		"templates/sql/1.up.tpl",
+		_templatesSqlSqlcGenerateTpl,
+		"templates/sql/sqlc/generate.tpl",
 	)
 }
 
-func templatesSql1UpTpl() (*asset, error) {
-	bytes, err := templatesSql1UpTplBytes()
+func templatesSqlSqlcGenerateTpl() (*asset, error) {
+	bytes, err := templatesSqlSqlcGenerateTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/sql/1.up.tpl", size: 220, mode: os.FileMode(420), modTime: time.Unix(1531284723, 0)}
+	info := bindataFileInfo{name: "templates/sql/sqlc/generate.tpl", size: 63, mode: os.FileMode(420), modTime: time.Unix(1792416964, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesSqlMigrationsTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x51\x4d\x6f\x9c\x30\x10\x3d\x7b\x7e\xc5\xd4\x52\x2b\x90\x28\xbe\xf4\x94\x8a\x53\xd2\x43\x0f\x8d\xa2\x7e\xa8\xc7\xca\xc0\xc0\x8e\x0a\x36\x19\x9b\xad\xd2\x15\xff\xbd\x82\x65\xd9\x5d\x35\x8a\x72\xb2\x3c\xf3\xde\x9b\x79\x6f\x06\x5b\xfd\xb6\x2d\x61\x78\xec\x00\xb8\x1f\xbc\x44\x4c\x40\x69\x12\xf1\x12\x34\x28\xdd\xf4\x51\x03\xa8\x9e\x5b\xb1\x91\x50\xb7\x1c\x77\x63\x99\x57\xbe\x37\xad\xef\xac\x6b\xdf\xaf\x2d\x73\x7a\xf7\x1f\x66\xde\x6b\x70\xa6\xb6\xd1\x96\x36\x90\x39\x1c\x30\xbf\x13\xde\x93\xe0\x34\xcd\xf3\x8c\xc1\x86\x3b\xc2\x7a\x29\x82\xfa\xf5\xba\xc9\x26\xf8\x51\x2a\x32\x33\x57\x43\x0a\xb0\xb7\x82\x5f\x96\x36\x7b\x17\xb0\x40\xdd\x6f\x3f\x0d\x60\x0c\x7e\x1d\xdd\x05\x60\x20\x69\xbc\xf4\x01\xad\x7b\x42\xa1\xc7\x91\x85\x6a\x3c\xed\x89\x67\x32\x34\xa3\xab\xae\xc9\x49\x8a\x4b\x6e\x78\x00\xc5\x0d\xd6\x25\x16\x05\x3a\xee\xe6\xbf\x12\x8a\xa3\xb8\x23\x20\xe4\xf7\xf4\x27\xd1\x9b\xaa\xf3\x11\xd9\x71\x64\xdb\xf1\x5f\xaa\x75\x0a\x6a\x82\x45\x83\x44\xf0\xa6\xc0\xba\xcc\x1f\xd8\xb5\x49\xfa\x71\xa9\xbc\x79\x4e\xf6\xc8\xd9\x16\x7c\xb0\x71\x37\x53\x9b\x3e\xe6\xdf\x06\x61\x17\x9b\x44\xcf\xb1\xdc\x18\xf3\x36\xe8\xec\x22\x95\x14\xd4\x31\xe6\xec\x34\xef\xea\x1c\xf9\x4f\x8e\xbb\xcf\x2e\x44\xeb\x2a\x4a\xea\x32\xc3\x77\xd7\xfd\x5b\xef\x1a\x6e\x0f\x53\x0a\xaa\xdf\x24\xd6\x9b\xcc\x4e\x67\xfe\xdd\xea\x75\xd3\xb9\x5a\x34\xc3\xba\xfc\xfe\x34\x50\xb6\xde\x3b\xdd\xcc\xbf\x60\xf5\x1c\x4f\x9f\xff\x18\x9e\xc9\x66\x05\x14\xe7\x65\x3e\x89\xdc\xfb\xdb\x9d\x75\x2d\x2d\x88\x93\xa6\xe3\x0e\x94\x9a\xfe\x1b\x72\xd9\x9f\xfe\x05\x00\x00\xff\xff\xd5\xda\x12\x95\x2c\x03\x00\x00")
+var _templatesSqlSqlcQueriesTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x4d\x6e\xc3\x20\x10\x85\xf7\x3e\xc5\x5c\x80\x1c\xc0\x5d\x55\xad\x14\x55\xfd\x91\x5a\xb5\x07\x20\xe6\x25\x45\xc5\xe0\x98\xb1\x54\x0b\x71\xf7\x0a\x3b\x89\xa1\xb1\x94\x9d\xcd\x9b\xf9\xbe\x07\x42\xd0\xfb\x80\x5e\xc3\x93\xdb\x13\x7f\x83\x42\xa0\xcd\xab\x53\x30\x9b\x4f\xb9\x33\xa0\x18\x89\xa7\x8f\xc6\xb5\x9d\x36\x50\xa4\x2d\xbb\x69\xf4\x78\xda\xec\x64\xf3\x23\x0f\xa0\xdd\x48\xfe\x68\x9a\xaa\x12\x82\xac\x6c\x51\xd3\x8b\xf6\x9c\x80\x6f\xb2\x9d\x48\x75\x2b\xed\x58\x2d\x8a\x94\x27\xff\x48\x31\xde\x55\x21\x08\xd2\xfb\x73\xb6\xc5\x25\xca\x88\x5b\xf0\xb2\x7e\xc1\x3a\x8b\x8c\x9a\x6d\xce\x50\x58\x55\x52\x1e\x7a\x48\xc6\x35\x28\x84\xac\xc0\x07\x78\xe8\xad\xb6\x07\x8a\xb1\x76\x36\x8d\xc3\x78\x64\x13\xf7\x03\xbb\x67\xa4\xf2\x35\x7e\xd1\x18\xe9\x59\xab\xf3\xd8\xe9\x30\xfd\xce\xfa\x45\xf7\x64\x3d\xfa\x7f\x1d\x17\xea\x57\xa7\x24\x63\xe5\xee\x73\x70\xdd\x7a\xf2\x64\xf8\x12\x50\x3c\x41\x69\x7a\x84\xc1\xaa\x69\x0e\x6e\x9b\x4a\x40\x61\xfa\x1b\x00\xdc\xfb\x17\x07\x5a\x02\x00\x00")
 
-func templatesSqlMigrationsTplBytes() ([]byte, error) {
+func templatesSqlSqlcQueriesTplBytes() ([]byte, error) {
 	return bindataRead(
-		_templatesSqlMigrationsTpl,
-		"templates/sql/migrations.tpl",
+		_templatesSqlSqlcQueriesTpl,
+		"templates/sql/sqlc/queries.tpl",
 	)
 }
 
-func templatesSqlMigrationsTpl() (*asset, error) {
-	bytes, err := templatesSqlMigrationsTplBytes()
+func templatesSqlSqlcQueriesTpl() (*asset, error) {
+	bytes, err := templatesSqlSqlcQueriesTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/sql/migrations.tpl", size: 812, mode: os.FileMode(420), modTime: time.Unix(1546198340, 0)}
+	info := bindataFileInfo{name: "templates/sql/sqlc/queries.tpl", size: 602, mode: os.FileMode(420), modTime: time.Unix(1792416964, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatesSqlSqlTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xb1\x4e\xf4\x30\x10\x84\xfb\x7d\x8a\xfd\x53\x25\xbf\x4e\x86\x86\x06\x94\x86\xbb\x86\x02\x81\xc4\x03\x20\xfb\xbc\x39\x59\x18\x27\x59\xe7\x4e\x48\x91\xdf\x1d\xed\xc6\x05\x20\x51\x90\x2e\xa3\xfd\x66\xc6\x33\xd9\xe3\x9b\x3d\x11\xe6\x39\x02\x84\xf7\x69\xe4\x05\x5b\x40\x44\x6c\xbc\x5d\xac\xb3\x99\xae\xf2\x1c\x1b\x50\xed\x15\x9b\x75\x45\xf3\xb0\xdd\x95\xd2\x40\x07\x70\xb1\x8c\xde\xe1\xff\x3c\x47\x73\xb8\x07\x18\xce\xe9\x88\x4f\x13\xa5\xb6\x43\x62\x1e\x19\x57\x85\xe5\x8e\x98\x37\x4d\x15\xef\x76\xaa\xf4\x12\x6f\x14\x51\xff\x03\x87\x0b\xb1\xf8\xef\xb6\xc0\xfd\x98\x92\xfc\x76\x8a\x85\x41\xa9\x7f\x3d\xa6\x10\xab\xb9\x7c\x4c\xcb\x99\x93\x88\x6a\xab\x7a\x81\xaf\xc8\x6d\x8f\xde\x99\xe7\x90\x4e\x6d\x77\xf7\x67\x13\xef\xcc\x0b\x2d\x8f\xf6\x43\x9a\x4a\xa5\xdc\xde\x5c\x6f\x95\x2a\x25\x0f\x4a\x21\x42\xa9\x2b\xec\xe3\x98\xe9\xc7\x0c\x61\x90\xb5\x7e\xcb\xf5\xce\x54\xa8\x26\x7f\xef\x04\xe5\x33\x00\x00\xff\xff\x20\xa5\x2b\x90\xb1\x01\x00\x00")
+var _templatesSqlSqlcSqlcTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\xcd\xb1\x0e\xc2\x30\x0c\x04\xd0\x3d\x5f\x71\xf2\x0e\x03\x63\x66\x66\x16\xbe\xc0\x8a\x4e\x21\xa2\x4d\x68\xdd\xb2\x54\xf9\x77\x14\x14\x32\x20\x2f\xb6\x9e\x7c\xf7\xe6\x6a\xa9\x64\x0f\xb9\x88\xb3\x65\xf2\x0e\x38\x81\x39\xa6\x4c\x0f\x39\x0e\x9c\xaf\x49\x27\x86\x0d\xb5\x8a\x03\x00\x0b\x0f\xce\xda\xf1\xfe\x3d\x86\x2d\x3b\xd7\x44\xeb\x78\xd3\x99\x83\x22\x73\x0b\x6f\x13\xcb\x6f\x03\x5e\x1a\x9e\x1a\x5b\x57\xff\x95\x41\x65\xdf\xfe\x83\x3e\x03\x00\x87\xba\x67\x95\xaf\x00\x00\x00")
 
-func templatesSqlSqlTplBytes() ([]byte, error) {
+func templatesSqlSqlcSqlcTplBytes() ([]byte, error) {
 	return bindataRead(
-		_templatesSqlSqlTpl,
-		"templates/sql/sql.tpl",
+		_templatesSqlSqlcSqlcTpl,
+		"templates/sql/sqlc/sqlc.tpl",
 	)
 }
 
-func templatesSqlSqlTpl() (*asset, error) {
-	bytes, err := templatesSqlSqlTplBytes()
+func templatesSqlSqlcSqlcTpl() (*asset, error) {
+	bytes, err := templatesSqlSqlcSqlcTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/sqlc/sqlc.tpl", size: 175, mode: os.FileMode(420), modTime: time.Unix(1792416964, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlSqlserver1DownTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\xce\x4d\x4a\x03\x41\x14\xc4\xf1\xfd\x9c\xa2\x2e\xd0\xb9\x80\x22\x28\x46\x08\x04\x8c\x4e\x16\x2e\xf3\xa6\xbb\x4c\x37\xf6\x47\xec\xf7\xc6\xf1\xf8\x92\xc1\x85\xe0\x01\xea\xf7\x2f\xe7\x70\xc8\xe2\x89\xf1\x65\x0f\x35\x31\x16\x56\x53\x58\x14\x83\x74\x62\x56\x06\x58\x43\xe7\x17\xbb\xc1\x22\x11\xc4\x64\x12\x25\xd4\x47\x16\x41\x49\xe7\x2e\x96\x5a\x1d\x9c\xc3\x92\x2c\xa6\x0a\x8b\x49\xf1\x9e\x32\x37\x18\xe7\x49\xf9\x39\xb3\xda\xbf\x01\x7a\xcb\x79\x12\xff\xa1\x6b\xeb\x72\x7d\x12\x7e\x89\x2b\xb6\xd6\xda\x52\x57\x09\xbe\x53\x8c\x01\x92\x5b\x3d\x6b\x0a\x04\xc5\x47\x9c\x7c\xab\xca\x94\xff\xb0\x95\x0b\x6e\xab\x14\xde\x9d\x36\x83\x73\x83\x73\x78\x7c\x7d\x3e\xe0\x78\xff\xb0\xdf\x62\xf7\x84\xed\xdb\x6e\x3c\x8e\xe0\xb7\x94\x4b\xe6\xcd\xcf\x00\xe0\xb2\x9f\xb4\x06\x01\x00\x00")
+
+func templatesSqlSqlserver1DownTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlSqlserver1DownTpl,
+		"templates/sql/sqlserver/1.down.tpl",
+	)
+}
+
+func templatesSqlSqlserver1DownTpl() (*asset, error) {
+	bytes, err := templatesSqlSqlserver1DownTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/sqlserver/1.down.tpl", size: 262, mode: os.FileMode(420), modTime: time.Unix(1792412527, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlSqlserver1UpTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\x90\xc1\x6e\xdb\x30\x10\x44\xef\xfe\x8a\x39\xda\x80\x19\x20\xbd\xb6\x28\xa0\xc4\x6c\x20\x54\x76\x52\x8b\x3e\xe8\xd4\xac\xc4\xad\x45\x80\x22\x13\xed\xaa\x49\xfb\xf5\x81\x1c\x1b\xbe\x92\x33\x0f\xb3\xcf\x18\x3c\x45\xea\x18\xf5\xaf\x0a\xa2\xa4\x3c\x70\x52\x81\xf6\xa4\xa0\x91\x31\x09\x7b\x68\x46\x48\x41\x03\xc5\xf0\x9f\xa1\x3d\xc3\x93\x52\x4b\xc2\x90\xae\xe7\x81\x16\xc6\xe0\x2d\x68\x1f\x12\xb4\x0f\x82\x3f\x21\xf2\x0d\xea\xa9\x15\x7e\x9d\x38\xe9\x39\x86\x21\x1c\x47\xd2\x90\x93\x40\xfa\x3c\x45\x8f\x96\xd1\x8d\x4c\xca\x1e\x93\x84\x74\x9c\x49\xcf\x5d\x4e\xc2\x21\x5e\xe3\x48\xfc\x86\x6f\x89\x06\xfe\xfe\x7c\xb3\x30\x66\x61\x0c\xb6\x57\xd6\x3c\x94\xdf\xb9\x9b\x66\x0c\x09\x08\x33\x2a\x32\x5a\xd2\xae\x5f\x43\x32\xf2\x10\xf4\xb4\xfc\xe1\xf1\xf3\x75\x46\x08\xbf\xd0\x48\x9a\x47\xf9\xbc\xb3\xfd\x07\x79\x8d\xdd\xe0\x41\xc9\x9f\x94\xd4\x3c\xfe\xe5\x11\x5b\x4a\x74\x3c\xa9\x41\xad\x93\x0f\xf9\x32\xe2\x7e\x6f\x0b\x67\xe1\x8a\xbb\xca\x82\xdf\x69\x78\x89\x8c\xe5\xfc\x03\x00\xc1\xe3\xae\x7c\x28\x77\x0e\xe5\xc6\xee\x5c\xe9\x9a\xe5\xed\xfa\x76\x85\xdd\xa3\xc3\xee\x50\x55\x78\xda\x97\xdb\x62\xdf\xe0\xa7\x6d\xd6\x97\xd2\x59\xc7\x6f\x52\x6c\x0a\x67\x5d\xb9\xb5\x5f\xae\x8d\x8d\xfd\x51\x1c\x2a\x87\xba\xa9\x0f\xee\xfe\x12\x58\xae\x16\xc6\x60\xf5\xf5\x63\x00\xfc\xe4\xd7\xe6\xcf\x01\x00\x00")
+
+func templatesSqlSqlserver1UpTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlSqlserver1UpTpl,
+		"templates/sql/sqlserver/1.up.tpl",
+	)
+}
+
+func templatesSqlSqlserver1UpTpl() (*asset, error) {
+	bytes, err := templatesSqlSqlserver1UpTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/sqlserver/1.up.tpl", size: 463, mode: os.FileMode(420), modTime: time.Unix(1792412527, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlStoreTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\xcf\x6f\xdc\xba\x11\x3e\x4b\x7f\xc5\xd4\x28\x1e\xa4\x40\xa1\x81\xa2\xe8\xc1\xc1\x5e\x1c\xb7\xc5\x03\x9a\xb4\xb5\x5d\xf4\x60\xf8\x40\x53\xa3\x5d\x76\xb9\xa4\x4c\x52\x6f\xb5\x10\xf4\xbf\x17\x43\x51\x5a\xc9\x56\xf2\xdc\xe4\xe5\xd0\x8b\xbd\xfc\xf5\xcd\xcc\x37\x33\xdf\xa8\xeb\xde\xc3\xef\xad\x31\x1e\xae\x36\x70\xf1\xce\x3d\x2b\x76\x73\x7d\x01\x7d\xdf\x75\x20\x2b\x60\x7f\xbf\xfd\x34\x2c\x86\x4b\x9b\xb0\xc3\x3e\x1a\xad\x87\x6d\x54\x0e\xc3\xc5\x5b\xac\x95\x14\xdc\x2d\x6f\x5f\xdc\x9a\xc6\xa3\x8d\x80\xa8\x4b\x78\xdf\xf7\x69\x30\x2a\x08\x83\x8c\xfe\xb3\x41\x2b\xa7\x3b\xb2\x02\xae\xcb\x60\x26\xfc\x61\xf1\x38\xe2\x86\x57\x9b\x95\x93\x05\xb6\xe2\x27\xb4\x21\xa2\xd5\x50\x86\xe3\x88\xf2\x99\x1f\x70\x09\x51\x73\xb1\xe7\x5b\x04\xf7\xac\xd2\x54\x1e\x6a\x63\x3d\x64\xc1\x69\xcb\xf5\x16\x81\xfd\x1c\xf6\x28\xd4\x34\x89\xe0\xd0\xf7\x17\x5d\x37\xfd\x27\xa8\xe8\x4c\xfc\x99\x87\x85\xac\x00\x9f\x63\x14\xf3\xc0\xe7\x87\x83\x77\x17\xee\x59\xb5\xe1\x28\xbd\xbc\x84\x31\x54\xe9\x40\x1e\x6a\x85\x07\xd4\x1e\x4b\x78\x3a\x01\xa5\xac\x65\x37\xd7\x81\xb5\x61\x71\xdf\x16\x61\x25\x1d\x34\x6e\xb8\xe5\x77\x48\x30\x5b\xd4\x68\x39\xbd\xb4\x58\x1b\x27\xbd\xb1\x12\x5d\xea\x4f\x35\x9e\x4d\x68\x8f\xb6\xe2\x02\xa1\x4b\x93\x3b\x54\x28\xfc\x47\xa3\x3d\xb6\x3e\x13\xbe\x05\x31\xfc\x66\x71\xaf\x80\x12\x9d\x3f\x3f\xea\xfa\x02\x9e\x1b\xb4\x27\x70\xde\x4a\xbd\x2d\x80\xdb\xad\x03\xc6\xd8\xec\x4a\x0e\x68\xad\xb1\x69\xf2\x57\xfc\x71\xd8\x14\xcf\xe9\xd6\x1c\xbf\x6a\xe0\x0d\x70\xc4\x29\xbb\x35\xc7\x34\xf9\x73\x8b\xe2\x7b\xd1\xb2\x80\x86\xae\x51\xbe\x18\x3c\xcd\xd3\x58\x27\xd4\x49\x6f\x4a\x37\xbb\xb9\xee\xba\x97\x4d\x57\x80\xdf\x21\x0c\xed\x56\x4c\x15\x38\x55\xc5\x6f\x59\x14\x81\xd9\xef\x26\x62\xe4\xd5\x4d\x3c\xfc\xff\xa5\xec\x55\x97\x53\x93\xdd\x79\x63\x11\xb6\xd6\x34\xb5\x0b\x49\x99\xd3\x0a\xa6\x02\xfc\x85\x6c\xf2\xed\xd6\xe2\x96\x7b\x2c\xe0\xb8\x93\x62\x07\x6e\xc7\x2d\x02\x27\x08\x6f\xb9\x76\x5c\x78\x69\x34\x1c\xa5\xdf\x49\x0d\xff\x96\x7e\x77\xdf\x0e\xad\x3a\x58\x70\xde\x36\xc2\x43\x37\x57\xa6\x4f\xa6\x44\x35\x09\x13\xfb\x87\x6a\x2c\x57\x54\x07\xb4\x8a\x4a\x17\x5e\x2f\x9c\x4e\xc8\xc6\x7d\x0b\x55\xa3\xc5\x3a\x4d\x95\x1e\x0e\x7d\x0b\xe1\x79\xec\xb2\xb1\xd9\x86\xaa\xfd\x8c\xc7\x70\x08\xc2\x22\xf7\xe8\x80\x47\x4f\x4d\xf5\x9a\x87\x40\xbc\xd4\x5b\xb2\xa5\x53\x02\x9f\xde\x67\xb4\x05\xd3\x14\xe9\xfb\x3c\xe2\x74\x69\xe2\x48\xd5\xf5\xfc\x62\x9e\x26\x8e\xc5\x00\x36\xdf\x1c\x02\x74\x2b\x12\xbc\x35\xf6\x10\x24\x38\x49\x2c\xfa\xc6\xea\xe0\x2c\xa3\x54\x44\x58\x62\x2b\x67\xf7\xe7\x74\x65\xa3\x8d\x77\xf4\x98\xdd\x5c\x9f\xf1\x93\x09\xa5\xd2\xd9\x14\x82\x6f\xf3\x3c\x4d\x92\x3e\x3f\x8b\xc0\xd2\x89\xa7\x46\xaf\xf8\x70\xdb\xe8\x9f\xf5\x7d\x4b\xf6\x0b\xd0\x52\x15\x5f\x89\xdc\xb7\xf0\xd4\x68\x76\xdf\xfe\x9a\x2f\x3f\xad\x38\x13\x2c\x93\x11\xb4\x61\xa2\x06\xeb\x5d\xf7\xc2\xc9\x71\x58\x5d\xe3\x56\xea\xfb\xb6\x1d\xbf\x0c\xa6\x9d\x49\x91\x26\x8f\x29\x6a\x8a\xd4\x5a\xf8\xdd\x86\x42\x58\xb8\x85\xd6\x92\x23\x69\x92\x5c\x5e\x82\x35\x4a\x39\x78\xe2\x62\x0f\xc7\x1d\x92\xcf\x50\x71\xa9\x1c\x18\x0b\x35\xd7\x52\xb8\x0f\xc0\x41\x9b\xf7\xa6\x06\xa3\x05\x82\x30\x87\x83\xf4\x1e\xcb\x34\x49\x4a\xac\xd0\x82\x6f\xd9\xad\x51\x8a\x30\xb2\x3c\x3d\x9b\xbe\xda\x2c\x18\x20\x02\x3e\xfc\xba\x4f\x71\xc3\xb7\xec\x63\xb0\x94\x45\xc2\x42\x84\x29\xb9\x1d\x6f\xb8\xd8\x1a\xfa\x9b\x5b\x83\xd4\xc1\x38\x1c\xfb\x9f\xd8\xc0\xc6\xa1\x9b\xf5\x8d\x7e\xd5\x37\x74\xb6\xd2\x37\x61\xf5\x25\xbd\x58\x0a\xc6\x15\x75\xe3\x4c\x34\x6e\x47\x07\x4f\xc1\x4a\x5e\xbc\x0c\xf8\xb7\xe9\xc1\x89\xda\x4a\x67\x2e\x5f\x63\x72\xe0\x01\x6c\xa3\x1d\xc1\x91\xd1\x89\xcc\x81\xa9\x05\x97\x51\x54\xe7\x8a\x1a\xf5\x96\xc0\xa4\x3b\x97\xca\x54\x5a\xae\x11\x02\xb1\x74\x61\x5c\x52\xe9\xd1\xb4\xa4\xda\x33\x7e\x87\xf6\x28\x1d\x0e\x72\x95\xb9\x31\x88\xc1\xa7\x6f\x0d\x79\x0c\x30\x12\x48\x30\xf4\x2c\x8f\x01\x57\x7c\x8f\x37\xdc\x73\xd8\x19\x55\xc6\x81\x62\x8e\x8e\x2a\x87\x87\xc3\x01\x77\x98\x0c\xd3\xe5\x69\x38\x24\x87\x06\xdc\x49\x0b\xf6\xa9\xf1\xd8\xbe\x69\x56\x00\xc0\xc3\xe3\x2c\xf7\x2f\xce\xef\xf0\x99\xbe\x07\xfe\xf4\xc7\x45\x09\x5c\x5e\x82\x92\x7a\xef\x66\x6e\xd2\x1a\x4b\xd8\xe3\x69\x36\xf6\xfe\x63\xa4\x06\xcf\x9f\x14\xa6\x09\x5d\x70\x70\xe0\xf5\xc3\x30\x80\x1f\xe9\xe7\xc3\x1f\x1e\x03\xfa\xe3\x93\x31\x2a\x72\xf0\x19\x8f\x7f\xe1\x7b\x5c\x6f\xa1\x3d\x62\x4d\xdd\x22\xbd\x1b\x98\x91\x1a\x0e\x78\x30\xf6\x54\x00\xb2\x2d\x03\x6f\xc0\xa3\xf3\x44\xe6\x8e\xeb\x52\xa1\x75\x61\xaa\x9a\xc6\x03\x87\x92\x7b\xfe\xc4\x1d\x7e\x08\x00\xb3\x3a\x71\x10\x4a\xa7\xae\x95\x1c\xab\xc3\xef\xf0\x44\x30\xb1\x44\x0a\x78\x22\x08\x8b\xa0\x8d\x07\xe9\x8c\x0a\x9f\x51\x95\x35\x07\x2a\x04\xd1\x58\x8b\xda\xc3\xd1\x4a\x8f\x6e\x9a\x71\x53\x24\xd9\xac\x3f\x63\x11\xe8\xf9\xf1\x4f\x63\x36\xbb\x40\xd4\x15\x1c\xf8\x1e\xb3\xaf\xd1\x95\xf7\xa1\x6a\x46\x55\x38\x43\x51\x90\xf0\x6e\xc4\xfb\x2e\x5d\x08\x5e\xad\x2a\x43\x47\x56\xfa\x1f\xa6\x0c\xbe\x25\x5f\xc9\x06\x13\xca\x68\xcc\xf2\x15\x19\x3f\x87\xfc\x46\x29\x0f\x78\x16\xdd\xf8\xe6\x2c\x41\x5a\xaa\x35\x01\xa2\x5c\x00\x2f\xcb\x30\x7d\x2c\x1e\xcc\x2f\xe8\xa8\xc6\x87\xb4\x8f\x75\xef\x46\x65\x9f\xd5\x3b\x45\x0f\x59\x39\xcf\x03\x5d\xcd\xc2\x95\xf1\x13\x94\x90\xc6\x9c\x16\x63\x0b\x85\xdc\x52\x00\x25\x3b\x34\xec\x6f\x26\x0c\xb1\x38\xd9\xc2\xd6\xbf\xb4\x32\x71\xb2\xc9\x0a\x4a\x46\xef\xdc\x03\x01\x3f\xc2\xe6\x1c\xfe\x8b\x83\x73\x41\x2d\xab\x88\xc2\x0e\x40\xd1\xfc\xab\xa7\x0f\x7b\x3c\xd1\x7b\x6f\x1b\x4c\x93\x7e\x98\xf5\xe1\x16\x2a\xf4\x98\x2d\x2e\x87\x98\x82\x96\x0f\x04\x86\xe4\x81\x30\xb5\xc4\xa5\x9c\x95\x6b\x0c\xc5\x54\x9f\xb7\xde\x48\x83\xa0\x9a\xf8\xdf\x3b\xa8\x00\x85\x7a\xf4\x3f\xcf\xfb\x2f\x34\x85\x60\x8b\xae\x28\xe0\xc5\x06\x69\xe4\x06\x78\x5d\xa3\x2e\xb3\x85\x98\x66\xf4\xe9\x53\x40\xb9\xbc\xcf\x18\x7b\xbd\x79\x87\xcf\x8b\x5e\xaa\x8c\x05\x62\xb4\x88\x15\x76\xb5\x89\x9e\x45\x77\x89\x99\x44\xbc\x29\xc5\x43\x98\x31\xc8\x34\x09\xd0\x54\x79\x13\xe4\x19\x30\x11\x5f\xce\x7c\xd2\xcf\x3b\x44\xc4\x0e\x89\xdd\x44\xdf\xfb\x8a\x8b\x97\x49\x0e\xd2\x0b\x3e\x8c\x6a\x53\x81\x58\x4b\xfa\xd8\x8f\x62\xbe\xf9\x96\xbc\xaf\x67\xeb\x05\xaf\x6b\x44\xc3\x06\xde\x90\xd3\x45\x3a\x46\xd6\x37\x20\x98\x92\x7a\xef\xd2\xfe\xbf\x03\x00\x41\xa3\x56\x2e\x4e\x13\x00\x00")
+
+func templatesSqlStoreTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlStoreTpl,
+		"templates/sql/store.tpl",
+	)
+}
+
+func templatesSqlStoreTpl() (*asset, error) {
+	bytes, err := templatesSqlStoreTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/store.tpl", size: 4942, mode: os.FileMode(420), modTime: time.Unix(1792417772, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesSqlTernMigrationsTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x5f\x6f\xdb\xb6\x17\x7d\x16\x3f\xc5\xfd\x11\xbf\x02\x52\xaa\x4a\x49\x86\xbd\xb8\x71\x81\x2e\x8d\xf7\xd2\x15\x45\x9d\xee\x25\x0b\x0a\x5a\xa2\x14\x2e\xd2\xa5\x4b\x52\x8e\x0b\xdb\xdf\x7d\x20\x45\x5a\x96\xeb\xf4\x61\x1b\xb0\x87\xc0\xc2\xfd\x73\x78\xee\xe1\xe5\xbd\x59\xb2\xe2\x91\xd5\x1c\xf4\xd7\x86\x10\xd1\x2e\xa5\x32\x10\x93\x88\x16\x12\x0d\x5f\x1b\x4a\x36\x9b\x57\x20\x2a\xc8\x6e\xda\x05\x2f\x61\xb7\x23\x11\xe5\xf6\xb3\xf7\x70\x0c\x36\xa5\xa4\xd2\x94\x44\xb4\x6a\x8d\xfd\x11\x32\xaf\xf4\x3e\x1d\xa5\x19\x41\x48\x7d\x94\xaf\x8d\x2a\x24\xae\x28\x21\x11\xad\x85\x79\xe8\x16\x59\x21\xdb\xfc\x4f\x56\x3c\x16\xf9\xb2\x5e\xe7\xab\x9f\xe9\x29\x97\xe1\x0a\xf3\xd5\x65\xde\x8a\x5a\x31\xc3\x29\x49\x08\x29\x24\x6a\x03\x2b\xae\xb4\x90\x78\xcb\x16\x0d\x87\x29\x50\x5d\x3c\xf0\x96\x7d\xf1\x66\x7b\xfc\xb8\xae\x3c\x87\x1e\x44\x48\x9c\xcd\xe1\x41\x36\xa5\x06\xf3\xc0\x07\xab\x86\x42\xb6\x4b\xd1\xf0\x12\x04\x1a\xe9\x9c\x0b\x81\x4c\x7d\x23\x79\x4e\xf2\xbc\x96\x13\x27\xcd\x41\x46\x7e\x96\x59\x65\x57\x4c\x0d\xc6\xd9\x1c\x5c\x58\x36\x9b\xf7\x1a\x34\x9a\x5b\x02\x36\xe8\xb7\x7d\xa6\xa5\x3c\xe0\x8c\xd4\x22\x79\x0e\x9f\x3a\x3c\x88\x5d\x72\x55\x49\xd5\x6a\x60\xf8\x0d\x14\xff\xda\x09\xc5\x4b\x28\x99\x61\x0b\xa6\x0f\x2b\x20\x55\x87\xc5\x38\x39\x4e\xc0\x5d\x1e\x6c\x48\x54\x98\x35\x4c\xa6\xe0\x2f\x3f\xfb\x85\x15\x8f\xb5\x92\x1d\x96\x71\x42\xa2\x36\xb5\x0e\x4c\x6d\xb8\x8d\x42\xfe\xd4\xa3\x48\x15\x17\x66\x9d\x90\x48\x54\xce\xf7\xbf\x29\xa0\x68\x2c\x5e\xa4\xb8\xe9\x14\x5a\x2b\x89\x76\x24\x2a\x79\xc5\x95\x43\xc9\xae\x1b\xa9\x79\x9f\x47\x42\x58\x9b\xf5\x80\xde\xde\x57\xea\x4d\xa0\x3a\xd4\xc0\x86\x5a\x40\x77\x8b\x42\xb6\x2d\xc3\x72\x02\xdd\x32\x85\x52\x3e\x21\xdc\xe1\x7d\x1a\xae\x3e\x05\xa9\xa0\x92\xaa\xe0\x70\xe5\x4d\x6f\x7a\x01\xc2\x31\x4c\xd5\x1a\xee\xee\xb5\x51\x02\xeb\x03\x1d\x44\x05\x0d\x47\xe7\x4e\x60\x3a\x85\xf3\xa3\x62\xa4\xd2\xd9\x07\xfe\x14\xd3\x4e\xb3\x9a\x4f\x3c\x29\x0e\xdd\x72\x1b\x58\x6c\xfd\x89\xdb\x23\x02\x34\xb1\x4a\xfc\x77\x52\xeb\x27\x61\x8a\x07\xb0\xa5\xdd\x9d\xdf\xdb\xcc\xc2\xb6\x08\xed\x96\x74\x42\xa2\x67\x6e\xc2\xc7\xd8\xda\x5c\x14\xda\xfb\xbf\x20\xd1\x58\xa8\x37\x70\x61\xf1\x9c\xd5\x73\x9f\x82\x7f\xd8\xd9\x5b\x23\x85\x13\xf4\xee\xe2\x3e\x79\x7d\x48\x7e\xbb\x05\x84\xab\x90\x1b\x18\x54\xad\xc9\x6e\x6c\x5f\x56\x31\x15\xb8\x62\x8d\x28\x01\xbb\x76\xc1\x15\xc8\x6a\xe8\x02\x3d\x81\x17\x9a\xa6\x10\x90\x2d\xc4\x8e\xd8\x3f\x12\x45\x5e\xf3\xbd\x8c\x6d\xf6\x2b\x37\xd7\x9d\x52\x1c\xcd\xef\xbd\xcf\xd7\x77\x42\xcf\x40\xc4\x09\xda\xe3\x19\xa6\x6a\x6e\x6c\xed\x1e\x19\x5e\x81\x40\xf3\xd3\x65\x8c\x1e\xc3\x47\x5c\xf9\x8e\x09\x19\x53\x38\x77\x9c\xbe\xd7\xf7\x56\x5a\x06\x29\xf4\x81\x7b\xa5\x3d\xbe\x13\xfb\x5f\xad\x22\x8a\xac\xb0\x1f\x95\x40\x53\xc5\xd4\x43\xc3\x8b\xf2\x0f\xa4\xfb\x77\x93\x0c\x7d\x80\xa2\x09\x94\x5c\x1f\xd3\xc9\xf1\xa5\x5f\xc1\xe5\xf1\x41\xcf\xbd\x8e\x13\x2f\xc1\x31\x3a\x2e\xf0\x64\xcb\xfc\xb0\xba\x53\xcd\x32\x0c\x0a\x8f\xff\x7d\xa7\xd8\xc3\xbf\x84\x46\x75\x4f\xe5\x66\xcd\x8b\xfe\x42\xe8\xe7\x8f\xef\xde\xde\xde\x00\x7d\xe9\xd3\xdd\x22\x79\x49\x61\x7e\x73\x1b\x10\x61\x0a\xff\xbf\x38\xa9\x9b\x6b\x9a\x92\x57\xac\x6b\xcc\x84\x9c\xe4\xd9\xe1\x23\xda\x59\x11\xd4\xd9\xcf\xb2\x81\xe5\xf9\xbd\x1b\x16\x3b\xd2\x4f\xad\xa3\x29\xb0\x9f\x1d\xd7\xfd\x6f\x02\xf1\x99\x07\xf3\xdd\x25\x55\x0a\x67\xcb\x7a\x9d\x5d\x87\x61\x22\x55\x62\x85\x1b\x0d\x97\x10\xc0\x0b\xd3\x97\xee\x37\xe3\xb5\xc4\x4a\xd4\xb0\xdb\x7d\xfe\xf4\x7e\xb3\x09\x6b\x8a\x6e\x36\xe0\xf0\xfc\x77\xbf\x93\x7e\x34\x91\x50\x34\xa9\xb5\xa5\x61\x36\xb9\x55\xe2\x0f\x0f\x84\x3f\x8c\x6b\x0b\xf3\xcf\x2b\xeb\xb4\x3f\x79\xc6\xf1\x80\x7b\xfe\xd4\xe3\x7d\x1f\x0d\x33\x64\xcf\xa6\xd2\xd9\xbc\x5b\xc4\x7b\xcf\x6c\x9e\x8e\xb6\xf0\x3f\xa6\x30\x6c\xfb\x68\xf4\x3f\x81\x86\x4a\x67\xb3\x39\x4c\x41\xea\xec\x9d\x50\xb3\x79\x3c\x6c\xe8\x64\xb4\xfc\x03\x03\x2b\x5e\xf6\x5e\xb2\xf2\x60\x95\x0f\x78\xc9\xeb\xbf\x4f\x33\x38\xf6\x5b\x08\x45\x43\x76\x7f\x0d\x00\x77\xc0\x3e\x1b\x2a\x0a\x00\x00")
+
+func templatesSqlTernMigrationsTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesSqlTernMigrationsTpl,
+		"templates/sql/tern/migrations.tpl",
+	)
+}
+
+func templatesSqlTernMigrationsTpl() (*asset, error) {
+	bytes, err := templatesSqlTernMigrationsTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/sql/tern/migrations.tpl", size: 2602, mode: os.FileMode(420), modTime: time.Unix(1792417993, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesStoreBadgerTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4f\x8f\xdb\xb6\x13\x3d\x93\x9f\x62\xa2\xcb\x4f\xfa\x45\x95\xd3\xa0\xa7\x5d\xf8\x92\x3f\x2d\x16\x49\x37\x41\xd3\x6e\x0e\xc1\xa2\xa0\xc4\x91\x4c\xac\x4c\x0a\x24\x65\x5b\x08\xfc\xdd\x8b\x21\x29\xad\xbd\x71\xda\x1e\x7a\xb0\x20\x52\x33\x6f\xde\xbc\x99\x21\x3d\x88\xe6\x41\x74\x08\xce\x1b\x8b\x9c\xab\xed\x60\xac\x87\x9c\xb3\x0c\xad\x35\xd6\x65\x9c\x65\xca\xd0\xd3\x79\xab\x74\xe7\x32\xce\x59\xd6\x29\xbf\x19\xeb\xaa\x31\xdb\x95\xec\xac\x18\x36\x3f\x28\xb3\xaa\x85\xec\xd0\xae\x76\x3f\x65\xbc\xe0\x7c\xb5\x82\x8f\xc2\x6f\x40\x39\xf0\x1b\x04\x29\xbc\xa8\x85\x43\x90\xca\x62\xe3\x8d\x9d\xc0\x0c\xa8\x51\x42\x3d\xc1\x87\x01\x35\xdf\x09\x1b\x3d\xd6\x90\x91\x75\xc6\xc3\x96\xac\xe1\xff\x11\xb9\x7a\xf3\x2a\xc0\x92\x75\x70\x8e\xc8\xf1\xe3\x63\x00\xe1\x23\x8c\xd0\x12\xc4\x30\xf4\x0a\xa3\xdd\x80\x5a\x2a\xdd\x05\x43\x82\xd9\xaa\xce\x0a\xaf\x8c\x76\xbc\x1d\x75\x13\x48\xe4\x05\x84\xb4\xe1\x2b\x67\x14\x1c\x6d\xf8\x19\xcb\x99\xac\x4b\x7a\x85\x75\x0a\x58\x05\xfb\x99\x19\xb6\x62\xec\xfd\x87\x21\xe0\xe5\x14\xbf\xa8\x3e\x2b\xbf\x79\x6f\xba\x0e\x6d\xae\x55\x5f\x14\x9c\xa9\x36\x40\x3c\x5b\x83\x56\x3d\xc5\x60\x16\xfd\x68\x35\xed\x72\x76\xe4\xf3\xf2\xd7\x40\x0d\xf3\x82\x1f\x43\xc6\xaf\x7b\xe3\x10\x1a\x7a\x3e\x51\xf3\x89\x86\x21\x91\x60\x7d\x9a\x89\x6a\x41\xd6\x17\xa2\xca\xba\x4a\xb6\xa7\xc1\xb5\xea\x53\xd8\x57\xa2\x79\x18\x07\xd8\x5b\xe5\xd1\x81\x80\x76\xec\x7b\xa8\xe3\xa6\x69\xcf\x89\x78\x03\xfb\x12\xf6\x1b\xd5\x84\x92\x5b\x0c\x0d\x25\x89\xfd\xe8\x48\xf6\xa8\xd4\xff\x1c\xbc\x37\x42\x46\xc5\x23\x7e\xbe\x07\x65\xaa\xcf\x14\xc4\x9e\x90\xfe\x33\xca\x7d\xb5\x06\x59\x57\xb3\x65\x09\x2f\x8a\x85\x28\x89\x16\x89\xd6\x63\xf3\x80\xfe\x1d\x4e\x30\x58\x6c\xd5\x01\x1d\x3c\xe0\x04\x7b\x45\xfd\xe7\x5d\xfa\x5e\x82\x70\x89\x06\x6c\x04\x25\x44\xc4\x7a\x0c\xb6\x6e\x10\x0d\x46\x5a\x0b\x5a\x3e\xfb\x05\x83\xd0\xfe\x05\x7c\xb9\xaf\x27\x8f\xf0\x75\xa1\x11\x37\x92\x2d\x3c\x87\x6c\x95\xc1\x73\xc2\x9c\xab\xd7\xa1\x07\x8b\x42\xc6\xd2\xed\x44\x3f\x22\x98\x76\x61\xa8\xf4\xc2\x2f\x22\x92\x5a\x6f\xad\xbd\x35\xfe\x67\x33\x6a\x09\xfb\x0d\x6a\x50\x9e\xa0\xa4\x41\x07\xda\x78\xc0\x83\x72\x3e\xd2\xed\xd0\x5f\x24\x9a\x47\x62\x41\x46\x63\x8b\xb9\xa5\x63\xfc\xf8\x8d\xb3\x47\x89\xef\x14\xee\x73\x02\xcc\xfd\x41\x2f\x23\xf7\xfb\x41\x9f\xd4\x84\x29\x8f\xdb\xa5\x2e\xfe\xa0\xab\x5f\x96\xe0\x4f\xf4\xa2\x76\x4f\xfd\x6e\xac\xab\x6e\x5c\x8e\xd6\x96\x49\xfe\xea\xad\xb5\xef\x70\x9a\x33\x0c\xdc\x96\xae\x3c\x49\x9d\x33\x76\x04\xec\x1d\xc2\xb7\x93\x33\x9b\x53\x17\x30\x76\xe4\x9c\xb1\x90\xda\x3c\xa5\x44\xb5\xba\xa3\x9d\xd7\x66\x98\xc2\x04\x3e\x9d\xb7\xc7\x56\x7a\xf4\x4c\x35\x1b\x46\x3f\xf7\xfd\xdf\x17\x2d\xd6\x60\x18\x2f\xd5\xa0\x3c\x13\xfb\x44\xc7\x14\x55\xd6\xd5\x1f\x83\x14\x1e\xff\x51\xf7\xe4\x40\x8a\x7f\xfa\xae\xe2\x29\x5c\x11\x32\x8b\x69\x58\xdc\x9a\x1d\x82\xc4\x1e\x7d\x1a\x8a\xd6\x9a\xed\x19\xf7\x68\x73\x81\xfe\x7f\x44\xf8\x4d\x08\xfe\x1d\xce\xa7\x64\x51\x34\x1b\x68\x44\xdf\x3b\x68\x75\x50\x39\x0c\xcc\x03\x4e\x2e\x1c\xe6\x21\x3b\x47\xa3\x93\x66\x4d\x69\xc2\x00\x63\x25\xda\x12\x8c\x05\xd3\x06\x9c\x1d\xda\x69\xb6\x09\xc3\x33\xdb\x3b\xc0\xed\xe0\xa7\xeb\x54\x17\xe5\xc0\xe8\x7e\xa2\x95\x92\x20\x47\xaa\x59\x08\x49\x24\x62\x61\x89\x53\x22\xbc\x14\xb5\xd5\x40\xdf\x2e\x28\x76\xb1\xe0\x17\x65\xfc\x57\xd3\x66\x06\xef\xe0\x6a\x0d\xe7\x97\xcc\x8d\x47\x2b\xbc\xb1\xe9\xb2\x89\x63\x96\x28\x3e\x5b\x43\x96\x05\xdf\xe0\x5c\x7d\x0c\xc7\x21\xac\x13\xa3\x39\x93\x70\x48\x15\xf3\xd8\x28\x3f\x4f\xf3\x2d\xee\x67\xf4\x9c\xfc\xc9\x44\x62\x8b\x16\x94\x5f\xae\x0a\xce\x58\x6b\xc2\xce\x6f\xb8\x57\x5a\xe6\xc5\x35\x2d\xee\x48\xc4\xf4\x7e\x8b\x07\x9f\xa7\xa9\xa6\x39\x24\x7c\xe5\xab\x1b\x8f\xdb\x9c\x30\xd9\x20\x6c\x4c\x2d\xfd\xa9\xa8\x3e\x0d\xbd\xf2\xb7\x79\x5c\xe6\xe4\x53\xd1\x81\x52\x14\x25\x51\x2d\xe1\x65\x70\x53\x2d\xf4\xa8\xf3\xe0\x5d\xd0\x61\xf0\x32\xc6\x60\x8d\xd1\x5e\xe9\x11\x69\x11\x72\x9a\xaf\xda\xab\xd3\x83\x20\x0a\xbe\x3b\x2f\x4f\x42\x48\xa5\x69\x13\xfa\x97\x17\xf7\x25\xc4\xb7\x1f\xef\x4b\xd8\x85\xf0\xc7\xe2\xfa\x9b\x53\x68\xae\x69\x38\x51\x28\x78\xfc\xa5\x5d\xad\x7a\xce\x8e\x05\x3f\xfe\x35\x00\x59\x24\xdd\xbb\x67\x09\x00\x00")
+
+func templatesStoreBadgerTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesStoreBadgerTpl,
+		"templates/store/badger.tpl",
+	)
+}
+
+func templatesStoreBadgerTpl() (*asset, error) {
+	bytes, err := templatesStoreBadgerTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/store/badger.tpl", size: 2407, mode: os.FileMode(420), modTime: time.Unix(1792417135, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesStoreBboltTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\xc1\x8e\xdb\x36\x10\x3d\x8b\x5f\x31\xd5\xa1\x90\x0a\x41\xbb\xa7\x1e\x12\xf8\xb2\xc9\xa6\xe8\xa1\x69\x80\x6e\xdb\x43\x51\x14\x94\x38\xb2\x09\xcb\xa4\x40\x8e\x64\x1b\xc1\xfe\x7b\x31\x24\xb5\x96\x0d\x35\x4d\xf6\x60\xc3\xa2\x86\x6f\xde\xbc\xf7\x48\x0f\xb2\xdd\xcb\x2d\x82\x27\xeb\x50\x08\x7d\x18\xac\x23\x28\x44\x96\x6b\x9b\x8b\x2c\x27\x7d\xc0\x5c\x88\xac\xb1\x3d\x41\xbe\xb5\x35\x52\xab\x6a\x6d\xef\x1a\x5e\xc9\x45\x29\xc4\xdd\x1d\x7c\x92\xb4\x03\xed\x81\x76\x08\x4a\x92\x6c\xa4\x47\xe8\x74\x8f\x60\x07\x34\xa8\xa0\x39\xc3\xaf\x03\x1a\x31\x49\x17\x8b\x37\x90\x73\x61\xad\x9a\x5c\x84\x55\xd5\xc0\x0f\x0c\x59\xbf\x7f\x08\x90\x5c\x1e\x76\x47\xd4\xd0\xee\x82\x2d\x29\xc2\x48\xa3\x40\x0e\x43\xaf\x31\x96\x0d\x68\x94\x36\xdb\x50\xc8\x28\x07\xbd\x75\x92\xb4\x35\x5e\x74\xa3\x69\x03\x89\xa2\x04\x74\xce\x3a\xf8\x2c\x32\xee\x8c\x2e\x7c\xac\x13\x99\x6a\x2a\xfe\x09\x1b\xe0\x76\x75\xa8\xe6\x3e\x15\xdc\xff\x78\x7f\x5f\xc1\xf7\x69\x39\x20\x7e\x7e\xd2\x07\xb4\x23\xbd\x01\x16\xa9\xfe\x0d\x5b\x6b\xd4\x73\x29\x32\xdd\x05\x90\xef\x36\x60\x74\xcf\x5d\x32\x87\x34\x3a\xc3\xab\x22\x7b\x16\xf3\xe3\x2f\x81\x1c\x16\xa5\x78\x0e\x23\xbf\xeb\xad\x47\x68\xf9\xfb\x46\xca\x1b\x15\xc3\x28\xa1\x7a\x39\x8b\xee\x40\x35\x2b\x5d\x55\x53\xa7\xda\x65\x73\xa3\xfb\xd4\xf6\x41\xb6\xfb\x71\x80\xa3\xd3\x84\x1e\x24\xb4\xd6\x78\xed\x09\x0d\x81\x37\x72\xf0\x3b\x4b\x60\xbb\x15\x6f\xc9\xc2\x31\xca\x1a\x21\x8a\x23\x68\x5b\xff\xc9\x38\x6e\xc1\xeb\x42\xe3\x0f\x8d\xc7\x82\x37\x14\x74\x4a\x6e\x3f\x9d\x16\x95\xd9\x3f\x51\xfe\x37\x1b\xa0\x53\x04\x7a\xb2\xc5\xb1\xbc\x55\x70\x16\x6c\x8b\x04\x0e\xa5\x8a\x6a\x4d\xb2\x1f\x91\x99\xee\xf1\x0c\x47\x4d\x3b\x6d\xa0\x19\xdb\x3d\x52\x05\x91\x03\x27\xe3\xd1\xb9\x8f\x96\x3e\xd8\xd1\x28\x38\xee\xd0\x30\x0e\x6a\xda\xa1\x03\x65\xd1\x83\xb1\x04\x78\xd2\x9e\xe2\x64\x5b\xa4\x62\x06\x61\x5c\x4f\x4e\x9b\x6d\x09\xc5\x5f\x7f\x37\x67\xc2\xc0\xd7\xba\x72\xce\x52\xe4\x10\xdf\x89\x2c\xcd\xf2\x35\x93\x37\x69\xe8\x87\xd0\x2b\xa1\xa7\xce\x25\x0b\xa0\x3b\x68\x60\x73\x31\x77\x56\x64\x31\x8f\xc8\xb2\x67\x21\xb2\x6c\x62\xac\xa6\xfe\xe9\x82\xb3\xc7\xf3\x0c\x32\x7d\x0d\x48\x96\xc5\x41\x36\x7c\xb8\xd0\xa8\x19\xc7\xe8\xbe\xac\x60\xaa\xeb\x7a\xe1\x09\x27\x89\x3d\x99\x9f\xc3\xd6\x20\x4c\xb2\x69\x18\x69\x4e\xd7\xff\xf9\xd4\x3a\x94\xc4\x36\x71\x61\x5c\x0c\x2e\x81\x26\x36\x6a\xcd\xa1\x61\x5c\x73\xa8\xba\xb2\x62\xa1\x74\x22\xa9\x9a\xfa\xf7\x41\x49\xc2\x2f\xbb\xb2\xcc\xe3\x3b\xe6\x86\xd1\xa0\x9f\xbb\x8f\x96\x1e\x99\x84\x5f\xf7\xea\xe6\x02\x98\xb5\x61\x51\xa2\xc0\x69\xa1\xa9\x3f\x8d\x57\x36\x25\xe2\xe5\x22\xe6\x0e\x0f\x76\x42\x50\xd8\x23\x6b\xc8\x43\x76\xce\x1e\x92\x3e\x51\x85\x58\xb3\x22\xc4\x6b\x47\x0f\x79\xfb\x52\x26\xdf\xc2\xd5\x65\x73\x19\xe8\x7d\xe0\xb9\x9c\xa9\xbc\x9e\x79\x0e\x4c\x4c\x07\xca\x76\x07\xad\xec\x7b\x0f\x9d\x09\x79\x08\xa7\x79\x8f\x67\x1f\xae\xf7\x20\x87\xe7\x73\x9d\xf2\xa0\x4d\x18\xcf\x3a\x85\xae\x02\xeb\xc0\x76\x01\x67\x42\x77\x9e\x6b\x42\x66\xe6\x7a\x0f\x78\x18\xe8\xfc\x36\x45\x42\x7b\xb0\xa6\x3f\xf3\x93\x56\xa0\x46\x37\xe7\x8d\x49\x44\x35\x99\x53\x1a\xf4\x25\x4f\x9d\x01\x7e\xb7\x22\xf1\x6a\xd6\x16\x5a\x7e\xc3\x05\x38\x69\xaf\x89\x8f\x6f\x68\x65\xe4\x61\x46\xad\x60\xfe\x7f\x8c\x6e\x2c\x37\x5d\xa4\xff\x60\xdd\x23\x53\x0f\xbb\xf7\x15\x4c\xd7\x9c\x42\x8b\x95\x5b\xe0\xda\x99\x2c\x9a\xf5\xb2\xd8\x99\x22\x6a\x10\xf8\x94\x55\x9a\xba\xd8\xf3\x55\xc0\xd6\xb2\x97\xe9\xea\xd1\x2f\x2e\x6d\x36\x90\xe7\x57\xf4\xe8\xf4\xc2\x2f\x8c\x79\xb5\xe9\x35\x51\x0b\x28\x37\xb5\x15\x34\xff\x95\xb6\x7f\x07\x00\xff\xaf\xb4\xa1\xeb\x08\x00\x00")
+
+func templatesStoreBboltTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesStoreBboltTpl,
+		"templates/store/bbolt.tpl",
+	)
+}
+
+func templatesStoreBboltTpl() (*asset, error) {
+	bytes, err := templatesStoreBboltTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/store/bbolt.tpl", size: 2283, mode: os.FileMode(420), modTime: time.Unix(1792417135, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesStoreBucketTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\x41\x8f\x9b\x30\x10\x85\xcf\xf8\x57\x4c\x73\xa8\x8c\x84\x76\x7b\xce\x8a\x4b\xd5\x76\xa5\xaa\x4d\x57\x2a\x3d\x45\xd1\xca\x81\x49\xe2\x02\x36\xb2\x0d\x29\x5a\xe5\xbf\x57\x63\x43\x80\x34\xea\x65\x37\xd8\x9e\x37\xdf\x9b\x37\x8d\xc8\x4b\x71\x44\xb0\x4e\x1b\x64\x4c\xd6\x8d\x36\x0e\x38\x8b\x56\xa8\x72\x5d\x48\x75\x7c\xfc\x6d\xb5\x5a\xd1\x81\x31\xda\xd8\x15\x8b\x19\x7b\x7c\x84\xcf\xc6\x6c\xb4\xfb\xa2\x5b\x55\x80\xb4\x60\xd0\xb5\x46\x61\x01\xe7\x13\x2a\x10\x50\x62\x0f\x85\x46\x0b\x4a\x3b\xc0\x3f\xd2\x3a\xd6\x09\xb3\xa8\x4a\x21\x28\x3e\x6c\xf0\xcc\x57\x1e\x60\xed\xeb\xa8\xe4\x40\x4f\x56\xa1\xd5\xc7\x36\x2f\xd1\x05\x44\x0b\xee\x84\xf0\xf5\xe7\x8f\x0d\x8c\x80\xa0\x0f\xd0\x89\xaa\x45\x4b\xbf\x5c\xdf\x20\x64\xd0\xaa\x02\x8d\x7f\x5b\x62\xef\x2f\x04\x49\x29\x51\x63\x01\x7b\x2f\xf8\x34\xfc\xf7\x87\x16\xea\xd6\x3a\x4f\x9b\x6b\xe5\x84\x24\x13\xb6\x12\xf6\xc4\xbc\x62\x60\xd8\x66\x20\x54\xbf\x03\xeb\x4c\x9b\x3b\x78\x63\x11\xd5\xd2\xa7\x54\x47\x76\xf1\xb4\x1b\x3c\x0f\xc0\x61\x26\x81\x78\xd6\xaa\xf0\x7f\xd9\xa1\x55\xf9\xf4\x78\x50\xe6\x33\xbd\x78\x30\xbe\xcd\x76\xd4\x29\xa8\x4d\x67\x6f\xf4\xf4\x32\x34\x7d\xf6\xed\x44\x11\x9a\xf9\x69\x90\xe7\x12\xfb\x64\x88\x46\xaa\xe3\x62\xfa\x3e\x27\xe9\x6e\x43\xf2\x54\x7c\x3f\xb5\x89\xe1\x19\x1d\xa7\x58\x46\x2a\x9e\x25\x21\xb9\x98\xb0\x28\xd5\x0e\x32\x16\x15\xc2\x09\x7f\x01\xeb\x14\x8e\xe8\xf8\xfe\x81\x08\x13\x8a\x34\x66\x91\x3c\xf8\xbb\x77\x29\x28\x59\x51\xe1\x68\xa8\xf3\x45\x2c\xba\x5c\x2d\x76\x09\xd0\xce\x3d\xfc\x52\xb5\x30\xf6\x24\x2a\x1e\xb4\xdf\x77\xf1\x60\xf7\xa5\x75\x70\x36\xd2\xa1\x85\x0e\xc4\xbf\xa6\xef\xd9\x78\x69\xe7\x36\x12\xe8\x20\x8b\xa9\xb3\x36\xf0\x76\x43\xef\xbb\x7f\x1f\x7a\x77\xff\x83\xbf\x21\x6f\xda\x85\xed\x04\x48\x76\x84\xfe\x84\x15\x3a\x04\x83\xb5\xee\xd0\x12\x26\x1c\x8c\xae\x67\xeb\x71\x0f\x3b\x54\x2d\x02\xb8\x42\x0f\x5d\x83\xe2\x72\xde\xa1\xe5\x37\x69\xc7\xbd\xc0\x0e\x4d\x3f\x0d\x69\xb6\x93\x52\x51\x09\x68\x53\xa0\xb9\x47\x40\x22\x3c\x06\xbe\xdd\x2d\x82\xaf\x48\x7b\x9d\x42\x2d\x4a\x0c\x77\x1f\x62\x16\x0d\x13\x44\x91\x9f\xae\x40\xa4\xc9\x5f\x13\x78\x9d\x66\xef\x31\xb6\xbb\x7d\xef\x70\xe6\x67\x5a\xa6\x71\xe2\xeb\xf4\x76\x15\xbc\x03\xbf\x0b\x4f\xb7\x99\x2c\x42\xa1\x54\x02\x63\x0a\xa2\x69\x50\x15\x9c\xbe\x12\xa0\x3c\xc7\x97\x4a\x56\x2c\xba\xc4\xd7\x49\x56\xd2\xba\x04\xd0\x18\x76\xf9\x3b\x00\x81\x30\x3d\xd4\x19\x05\x00\x00")
+
+func templatesStoreBucketTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesStoreBucketTpl,
+		"templates/store/bucket.tpl",
+	)
+}
+
+func templatesStoreBucketTpl() (*asset, error) {
+	bytes, err := templatesStoreBucketTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/store/bucket.tpl", size: 1305, mode: os.FileMode(420), modTime: time.Unix(1792417135, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesStoreCommandsTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xc1\x6e\xdb\x3a\x10\x3c\x8b\x5f\xb1\x11\x10\x80\x02\x04\x25\x79\x47\xbf\xe7\x1c\x9e\x91\x02\x2d\xd0\xa4\x68\x80\xf6\xa0\xfa\x40\x4b\x4b\x87\x91\x4c\x1a\x24\x15\xd5\x68\xfc\xef\xc5\x92\x92\x63\xcb\x39\xb4\x17\x5b\xa4\xb8\x33\xc3\xd9\x59\x6d\x45\xd5\x88\x35\x82\xf3\xc6\x22\x63\x6a\xb3\x35\xd6\x03\x67\x49\x8a\xba\x32\xb5\xd2\xeb\xab\x67\x67\x74\x4a\x1b\xd6\x1a\xeb\xe8\x49\x6e\x3c\xfd\x29\x43\xbf\xc6\xa5\x2c\x63\xec\xea\x0a\x16\x66\xb3\x11\xba\x06\xdb\x69\x07\x22\x42\x82\xeb\x56\x55\xdc\x9f\xc1\x4a\x54\x4d\xb7\x85\xff\xa4\x6a\xf1\x36\x07\xfc\x19\xc8\x4a\x5a\x2e\x73\x30\x16\x5e\xd0\x3a\x65\x34\x93\x9d\xae\x46\x38\x2e\xec\xda\x41\xb9\x74\xde\x2a\xbd\xce\x20\xc8\x80\x5f\x2c\x51\x12\x5a\xd4\xe1\x75\x06\xf3\x39\x5c\xd3\x66\x62\xd1\x77\x56\xc7\x53\xae\xb8\xc7\x9e\xa7\x9d\x13\x6b\x9c\x0d\x7a\x4e\x34\xbc\x9e\x48\x78\x1d\xe8\xd3\x8c\x25\x7b\x16\x08\xd0\x5a\x98\xcd\xe1\x61\x8b\x9a\x67\xff\x12\x2a\x5c\xcc\x41\xab\x76\xc2\x45\x05\x49\x8d\x12\x2d\x2c\x5a\xe3\x90\x67\x8c\x25\xae\x57\xbe\x7a\x02\x12\x58\x5e\x2f\xa9\xa2\x12\x0e\x21\x8d\x12\xd2\x19\x4b\x4e\xef\x70\x31\x87\x7f\xe8\xd4\x5f\x5e\x82\xe4\x26\xfb\x37\x39\xbd\x55\x1e\x3f\xa8\x16\x83\x35\xe5\xcd\x32\x87\xff\x03\x63\x36\x0a\x88\xb7\x3e\x17\x70\x0b\x37\x27\xfc\xef\x20\xdd\x85\xd2\x09\x63\xdc\xe4\xc6\x15\x8f\xbe\x36\x9d\x3f\x10\x8d\x86\x12\xd3\xf0\x9c\x8f\x9e\x7e\x8b\x6b\x4e\x58\x4a\x4e\xad\x1d\xa1\x83\xb7\xe1\x76\x72\xe3\x8b\x2f\x56\x69\x2f\x79\x3a\x60\xc1\x65\xfd\x43\xa7\xf9\x18\x9b\xec\x4d\x91\x56\x6d\x68\xc9\xb0\xa4\xda\x3b\x32\x53\xf2\xb4\xd3\x8d\x36\xbd\x1e\x9c\x3c\x64\xf3\xd2\xa5\xf9\xd8\xaa\x8c\xed\x43\xa0\xe3\xbd\xa2\x0d\x0e\xfc\x13\xc2\x8b\x68\x3b\x74\x60\x24\xe0\x0b\xda\x1d\xac\xba\xaa\x41\x0f\xde\x40\x0f\x82\x42\xff\xe9\xf1\xe1\x1e\xcc\xea\x19\x2b\x0f\x0d\xee\xb0\x86\xd5\x8e\xa0\x86\x83\x34\x1e\x0d\xee\x62\xc0\x07\xdb\x7a\x50\xa6\xf8\x4e\x1c\xf6\x28\xdd\xb1\xc0\x91\x53\x1b\xd1\x20\xdf\x88\x6d\x19\x47\x60\x79\xf4\x48\xb3\x59\x7c\x15\xfd\x67\x74\x94\x8f\x8c\x25\x83\xbb\x28\xaa\x27\x9e\xa6\x39\x10\x13\x8f\x60\x39\x29\x82\x58\x99\xc7\xab\x40\xb9\x5c\xed\x3c\x1e\xf1\x52\x22\x06\xee\x32\xfe\x2f\x61\x7e\xd4\x97\xb3\x77\x67\xf2\xce\x35\x51\x27\xa6\x85\x65\x83\x3b\xaa\x16\xdb\x2d\xea\x9a\x4f\x8a\xb8\x56\x6d\x36\x68\x2c\x8a\xe2\xac\xb3\x19\x7b\x27\x33\xa7\xe3\xc8\x12\xd4\x15\xd9\x17\xa0\xef\xb1\xbf\xa3\x0f\x1a\x5a\xde\x93\x4b\xba\x2a\x1e\xd1\x7f\xd4\x35\x6a\x1f\x7c\x4a\x01\x68\x90\x46\x0c\x5d\x15\xf1\xfc\xe0\x9d\x1b\x33\x71\x98\x09\xa8\x2c\x0a\x8f\x0e\x68\x08\x43\x63\xc7\x9c\x18\x50\x1e\x3a\xa7\xf4\x3a\x46\x27\x76\xfb\x6d\x98\x42\xc1\xd8\x86\xb0\x1d\xbb\x34\x8d\xc1\x51\x57\xe4\x61\x6c\x8c\x2b\x16\x81\x38\xc0\xfc\x91\x0f\xc3\x89\xd9\x3c\xca\xe1\xf2\xfc\x4b\x26\x8b\xf1\xb3\x35\x2d\x1f\x97\xb2\x58\xb4\xc6\x21\xcf\xd8\xfe\xf7\x00\x8b\x36\x4d\xea\x34\x06\x00\x00")
+
+func templatesStoreCommandsTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesStoreCommandsTpl,
+		"templates/store/commands.tpl",
+	)
+}
+
+func templatesStoreCommandsTpl() (*asset, error) {
+	bytes, err := templatesStoreCommandsTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/store/commands.tpl", size: 1588, mode: os.FileMode(420), modTime: time.Unix(1792417135, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesStoreMigrationTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\x8f\xc1\x6e\x83\x30\x10\x44\xcf\xf6\x57\x8c\x72\x02\x29\x22\x77\xfe\xa1\x55\xa5\xaa\xbd\x3b\xb0\xc0\xaa\xb0\x4e\xd7\x4b\x53\x15\xf9\xdf\x2b\x87\x70\x1c\xed\xec\x9b\x99\x5b\xe8\xbe\xc2\x48\x48\x16\x95\xbc\x1f\x56\xe9\xc0\xc2\x56\xd5\xd8\xbc\x53\x1a\x39\x19\x69\xf5\xc2\xa3\x06\xe3\x28\x9b\x77\xee\x93\x34\x71\x94\x16\xdb\x86\xe6\x29\x90\xf3\xd9\x3b\xf7\x1a\x16\x6a\x01\xe0\x54\x6e\x45\x21\xe7\x53\xb9\x7c\xdc\x5a\x14\x7a\x55\x83\x54\xa3\x16\xbc\x73\x97\x0b\xde\xe6\xd0\x11\x6c\x2a\x1d\x82\xd1\x42\x62\xe9\x51\x81\xc3\xcc\x7f\x2c\x23\xa2\xc2\x34\x48\x1a\xa2\x2e\x45\xef\xde\xa8\xd4\x3f\x11\x7d\xb0\x80\x3b\xdb\xc4\x02\x9b\x38\x3d\x82\x4a\xdb\x06\xef\xeb\x35\xd1\xf7\x4a\x62\xbb\x6b\x39\x86\x24\xa4\x29\xae\xf3\x81\xb8\x12\x42\xdf\x53\x0f\x8b\x10\xba\x63\xe0\x99\x12\x8e\xfd\x47\xaa\xd0\xaf\xe1\x67\x5f\xdc\x94\x4f\x25\x5b\x55\x20\x3c\x7b\xe7\xf2\xd9\xbb\x5c\xfb\xfc\x3f\x00\xb4\xaa\x5e\xda\x54\x01\x00\x00")
+
+func templatesStoreMigrationTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesStoreMigrationTpl,
+		"templates/store/migration.tpl",
+	)
+}
+
+func templatesStoreMigrationTpl() (*asset, error) {
+	bytes, err := templatesStoreMigrationTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/store/migration.tpl", size: 340, mode: os.FileMode(420), modTime: time.Unix(1792417135, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesStoreMigrationsTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x53\xcf\x6a\xf4\x36\x10\x3f\x4b\x4f\x31\x35\x04\x64\x30\xde\x9e\xd3\xee\xa1\x85\x14\x72\x68\x28\x94\xf4\xb2\x84\xa0\xd8\x63\x67\x12\x5b\x32\xa3\xf1\x86\xa5\xec\xbb\x7f\x48\x2b\xaf\x77\x43\x72\xf8\x4e\xb6\x84\xf4\xfb\x3b\x9a\x6c\xf3\x6e\x7b\x84\x20\x9e\x51\x6b\x1a\x27\xcf\x02\x46\xab\x02\x99\x3d\x87\x42\xab\xa2\x1b\x25\x7e\x06\xdf\xc7\x4f\xf0\x9c\x96\x41\xb8\xf1\x6e\x5f\xe8\x52\xeb\xcd\x06\x46\x14\xfb\xe7\xdc\xbc\xa3\x00\x63\xe3\xb9\x0d\x20\xaf\x08\x7b\xe4\x40\xde\x81\xef\xd2\x72\xb0\x41\xc0\x4e\xd3\x40\xd8\xc2\x48\x3d\x5b\x21\xef\x74\xe3\x5d\x90\x4b\x88\x2d\x14\xcf\x71\x59\x24\xec\xbf\x97\x83\x20\x6c\x5d\xe8\x3c\x8f\x27\xf4\xa4\xba\x85\xd6\x8a\x5d\x18\x26\xc6\x3d\xf9\x39\x2c\xcc\x5a\x0e\x13\x5e\x20\x04\xe1\xb9\x11\xf8\x5f\xab\xff\xb2\x34\x72\xa2\xd5\x83\x1d\x11\x00\x20\x08\x93\xeb\xb5\x7a\x9c\xe2\x0a\xa0\x9b\x5d\x63\x4a\x48\x61\xe8\xa3\xd6\x7b\xcb\xab\xee\x00\xbb\xa7\x33\x72\x52\xca\xd8\x53\x10\x64\xb0\x6d\x1b\xc0\xae\x47\xcf\xa6\x5f\x0e\x59\x0c\x56\x20\x87\x89\x1a\x3b\x0c\x07\xe8\xd8\x8f\xc9\x11\x39\x92\x08\x14\x79\x25\x07\x47\x12\xa0\xa3\x01\x75\xdc\x3c\x53\x98\x71\x75\x55\x46\x3f\x17\xb2\xb6\x91\x0e\x5d\x6b\xd6\xbd\x0a\xc6\x32\x1a\xd8\x6c\x60\x31\xce\x68\x7f\xaa\xa5\x44\x9f\x2f\x9b\x12\x0c\x39\xa9\x4e\xc9\x24\xfe\xd8\x42\x5a\xc3\xed\x16\x7a\x14\xb3\xf6\x59\x41\x91\x29\x8a\x52\x2b\xea\xe2\x29\xcf\xa1\xbe\x0f\x06\x99\x2b\xb8\x63\x7e\xf0\xf2\x97\x9f\x5d\x9b\xa0\x14\xa3\xcc\xec\xe0\xd7\x0a\x1c\x0d\x5a\x1d\x01\x87\x80\x70\xba\x08\xbf\x6c\xe3\xee\xa7\x73\xc8\xac\xd5\x51\x2f\x3b\x79\x3a\xeb\x3f\xc4\x93\x39\x95\x6a\xa2\xc0\x72\x09\x21\x97\x90\x6d\x9e\x62\x58\xd3\x02\x87\x1f\xc8\x20\xaf\xd6\x5d\x0e\xda\x92\x13\xb9\xe5\x37\x42\x79\x6e\x91\xab\x3c\xf6\xe4\xfa\xab\x48\x6d\x17\xc7\x01\x6d\xf3\x0a\xde\xe5\x0a\x33\xf7\x32\x57\xd1\x49\x33\x33\x63\xce\x33\xe6\x77\x8e\x59\xab\x6f\x5d\x67\xcb\x5a\xc5\x27\x59\xff\x3b\x50\x83\x57\x85\x47\x2e\x43\x15\xbc\x01\x39\x29\xe1\xc5\xfb\xab\xeb\xeb\xd1\x1d\x3d\xd5\x99\x10\x7e\xbf\x48\x61\xf7\x76\xde\xd7\xea\x58\x6a\xad\x3a\xcf\xf0\x5c\xc1\x18\x25\xb2\x75\xfd\x55\x66\x11\x9b\x3a\x18\x57\xac\x2d\x64\x5f\x89\x57\x35\xde\x09\xb9\x19\xb5\x4a\xb2\xd5\xe0\xfb\xfa\x1f\x26\x27\x9d\x29\x62\x0f\x87\x18\x5e\x7a\xd3\x2b\x2c\xdc\xb4\xcf\x37\xa1\xae\xeb\xa2\x5a\x91\xe3\x6f\x7c\xb1\xe5\x89\x31\x67\x36\xd6\x8f\x93\x29\x7f\xfb\x9c\xd6\xe2\xb7\x1b\xa5\xbe\x8b\x79\x77\xa6\xf8\x92\x04\x3a\x4b\x03\xb6\xb7\x70\xf3\xf1\x15\x59\xea\xa6\x5c\xb4\xaf\xb4\xd3\xfc\xcd\xa8\x57\xb0\x7b\x7a\x39\x08\x9a\x65\x18\xef\xc5\x5b\x73\xc6\x2d\xcb\xef\xb5\xa6\x6a\xd5\xf1\x72\xa2\x1d\x0d\xfa\xf8\x63\x00\xeb\x80\x1e\x68\xaf\x05\x00\x00")
+
+func templatesStoreMigrationsTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesStoreMigrationsTpl,
+		"templates/store/migrations.tpl",
+	)
+}
+
+func templatesStoreMigrationsTpl() (*asset, error) {
+	bytes, err := templatesStoreMigrationsTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/store/migrations.tpl", size: 1455, mode: os.FileMode(420), modTime: time.Unix(1792417135, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesTracingEchoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6f\xdb\x3e\x0c\xc5\xcf\xd6\xa7\x20\x7c\x92\x80\xfc\xed\xfb\x1f\xe8\x69\x58\xb1\x4b\x8a\xa1\x1b\xb0\xe3\xc0\xc8\x74\x24\xd4\xa6\x3c\x89\x5e\x5b\x04\xfe\xee\x83\x2c\xbb\x49\xb6\x9b\x45\xfe\xf4\xc8\xf7\xe4\x09\xed\x0b\x9e\x09\x46\xf4\xac\x94\x1f\xa7\x10\x05\xb4\xaa\x6a\x26\x69\x9d\xc8\x54\x2b\x55\xd5\x67\x2f\x6e\x3e\x35\x36\x8c\xed\x80\xa7\x24\x68\x5f\x5a\xb2\x2e\xd4\xb9\x17\x9a\x30\x11\x0b\x0d\x34\x92\xc4\xf7\xc6\x87\xd6\x06\x96\xe8\x4f\xad\xe7\x24\x71\x1e\x89\x05\xc5\x07\x6e\x77\xd1\x36\x08\x0d\x1f\xea\x97\x0b\x34\xc7\xd0\xcd\x03\xc1\xb2\xb4\x12\xd1\x7a\x3e\xd7\xca\x28\xd5\xb6\x90\x8f\xf4\x4c\xbf\x66\x4a\x92\xca\x29\x01\xa1\x75\x10\x4b\x11\x12\xc5\xdf\xd4\xc1\xe9\x1d\xdc\x01\xf2\x64\xcf\xb3\xe7\x33\x88\xa3\xc2\x67\x99\x29\x86\x09\xcf\x28\x05\xcc\x2d\x3b\x78\x62\x51\xfd\xcc\xf6\x7e\x88\x76\x90\x57\x6b\xbe\x20\x77\x03\x45\x73\x77\x82\x8b\xaa\x22\xc9\x1c\x19\x76\x0f\xcd\x13\xbd\x6e\x5d\xed\x0e\x50\xaf\xb5\x75\xa9\x58\x1f\xae\xd4\x0f\x2f\xee\xdb\x84\xfc\x84\x23\x3d\x86\x38\xa2\x08\x45\xbd\xb9\x6d\xf6\x8e\x31\x6a\x59\x7d\xc7\x30\x0b\xe5\x6a\x02\xc6\x91\xd2\x6a\x27\x4d\xc8\x10\xfa\x7b\xff\xd8\x0b\x45\xf0\x92\xca\x1d\x10\x1a\xa7\x01\x85\x8a\xb5\xab\x8e\x36\x90\xdf\xac\x39\xfa\xae\x1b\xe8\x15\x23\x3d\x66\xe0\x6a\x28\xf3\x9a\xe9\x4d\x0a\xb7\x79\xca\x90\xf9\xa7\x92\x73\xb8\xbb\x67\x0b\xf2\x29\xb0\xd0\x9b\x18\xa0\x18\x43\x5c\xa9\x6a\xf7\xf8\x9c\x57\xd1\xb6\xd9\x82\xd6\x66\xa7\xb5\x39\xc0\x6d\xf9\x48\xe2\x42\x77\x28\x7e\xbe\x6f\x76\xb4\x35\x46\x55\x1f\x43\xf3\x9e\xda\xe6\xca\xa2\xaa\x45\x2d\xea\x72\xf9\x0f\x7c\x0f\x1c\x04\x9a\x23\x49\xf4\x36\xc1\x72\x93\xe5\xae\x03\x45\xa0\x04\x3a\xa1\xb8\x1c\x68\xfe\x5e\x29\x18\x51\xac\xcb\xbf\x0f\xee\x01\x1f\x20\x44\x40\x06\x1a\x27\x79\xcf\x72\x49\x62\x06\x5e\x1d\xf1\x2a\xb2\x3f\xc4\x2a\xe6\x25\xd1\xd0\x43\x12\xe4\x2e\x81\x67\xe8\xcb\xed\x99\x57\xfd\x6e\x57\xbd\x79\x9d\xab\xc3\xbf\x32\xdc\x06\x5d\x54\x95\x45\x7e\x6e\x81\xc0\xff\x0f\x10\x91\xcf\x04\xb6\xf9\x6c\x5d\xd0\xa6\x24\x9b\xb4\x59\xf3\xf6\x7d\xe1\x9a\xaf\x79\x9f\x87\x07\xb0\xeb\xd7\xd6\xdd\xf3\xbb\x22\x7b\x84\x7b\xa7\xae\xb7\x34\x89\x3b\x58\x96\x3f\x03\x00\x4d\x26\xdc\xcd\x22\x04\x00\x00")
+
+func templatesTracingEchoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTracingEchoTpl,
+		"templates/tracing/echo.tpl",
+	)
+}
+
+func templatesTracingEchoTpl() (*asset, error) {
+	bytes, err := templatesTracingEchoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/tracing/echo.tpl", size: 1058, mode: os.FileMode(420), modTime: time.Unix(1792420141, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesTracingGinTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8f\x3d\x8e\x22\x31\x10\x85\x63\x7c\x8a\x52\x47\x20\xed\xb6\x6f\xb1\xda\x84\x64\xf7\x04\x85\xfb\x61\x4a\x63\x97\x3d\xd5\xe5\x19\x21\xc4\xdd\x47\x0d\x24\x13\x4c\xf6\x7e\xa4\x4f\xef\x75\x4e\x6f\x9c\x41\x95\x45\x43\x90\xda\x9b\x39\xed\xc3\x6e\xca\xe2\x97\x71\x9a\x53\xab\x31\x8b\xfe\xce\x4d\x25\x6d\x6a\xda\xba\x36\xb7\x0e\x75\x14\x54\xb8\x5d\x67\x69\x31\x35\x75\x93\x53\x14\x5d\xdd\x46\x85\x3a\xbb\x34\x8d\x3f\x71\x62\x73\x94\x07\x2f\xec\xa6\xdb\x8d\xe6\x63\x5b\x46\x01\xdd\xef\xd1\x8d\x93\x68\x9e\xc2\x21\x84\x18\x69\xb3\xf8\x87\xf7\x81\xd5\xd7\xa7\x5b\x09\x9c\x2e\x64\xcf\x90\x44\x89\x69\xed\xac\xa4\x5c\xb1\x10\x9f\x1d\x46\xe2\x2b\x59\x1b\x0e\x72\xd4\x5e\xd8\xf1\x6b\xc3\x6d\x43\x45\x87\x68\x26\xbf\xe0\xc9\xa3\x6e\xad\x73\x66\xc7\x42\xa7\xeb\x23\x4f\x45\xa0\x1e\xce\x43\xd3\xf7\x05\xfb\x03\x65\xd1\xf9\x2f\xeb\x52\x60\x7f\xb6\xfe\x16\x76\x06\x1f\xa6\xf4\x3a\x35\x1f\x65\x59\x0a\x3e\xd9\xb0\x7f\xbd\x99\xff\xc3\x3e\x24\xe1\x10\xee\x5f\x03\x00\x21\xc8\xab\xd9\x74\x01\x00\x00")
+
+func templatesTracingGinTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTracingGinTpl,
+		"templates/tracing/gin.tpl",
+	)
+}
+
+func templatesTracingGinTpl() (*asset, error) {
+	bytes, err := templatesTracingGinTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/tracing/gin.tpl", size: 372, mode: os.FileMode(420), modTime: time.Unix(1792420141, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesTracingGrpcTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\x3f\x4e\x2c\x31\x0c\xc6\xeb\xcd\x29\xac\xa9\x66\xa4\xa7\xe4\x10\xaf\xa1\x82\x82\x13\x78\x33\xde\x8c\x45\x62\x47\x8e\x07\xb4\x42\xdc\x1d\x0d\xcb\x22\x0a\xba\x4f\xfa\xd9\xdf\x9f\x8e\xf9\x05\x0b\x41\x43\x96\x10\xb8\x75\x35\x87\x39\x9c\xa6\xa2\x51\x3b\x89\x53\xa5\x46\x6e\xd7\xc8\x9a\xb2\x8a\x1b\x9f\x13\xcb\x70\xdb\x1b\x89\xa3\xb3\x4a\x2a\xaa\xa5\x52\x2c\x5a\x51\x4a\x54\x2b\xa9\x58\xcf\x49\x9d\xea\x21\xa6\x2f\xbb\xbf\x4e\xa6\xb0\x84\x90\x12\xb8\x61\xa6\xff\x58\xeb\xb8\xc9\x01\x84\x79\x83\x8c\xb5\x02\x0b\x20\x8c\x8e\x02\x82\x8d\x56\xc0\x8b\x93\x01\xfb\x80\x46\xbe\xe9\xfa\x0f\x8e\x5a\x2c\x3b\x4b\x01\xdf\xe8\xc7\x0f\xba\x69\xc7\x82\x4e\x2b\x9c\xaf\x07\x82\x5c\x99\xc4\xc3\x65\x97\xfc\x2b\x73\x5e\xe0\x28\x13\x9f\xc9\x5e\xc9\x9e\xfa\xb1\x09\xde\xc3\xc9\xc8\x77\x93\x6f\xe6\xe8\xe3\x01\x65\xad\x64\xf3\x7d\x58\x7c\xa4\xb7\xdb\xd3\x9d\x2c\x4b\xf8\xf8\x1c\x00\x77\x73\xc7\x30\x53\x01\x00\x00")
+
+func templatesTracingGrpcTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTracingGrpcTpl,
+		"templates/tracing/grpc.tpl",
+	)
+}
+
+func templatesTracingGrpcTpl() (*asset, error) {
+	bytes, err := templatesTracingGrpcTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/tracing/grpc.tpl", size: 339, mode: os.FileMode(420), modTime: time.Unix(1792420141, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesTracingIrisTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\x31\x8f\xdb\x30\x0c\x85\x67\xeb\x57\xb0\x9e\x24\x20\x90\xf6\x16\x37\x1d\xd0\x76\xb9\xa0\xb8\x0e\x9d\x19\x87\xb1\x84\xb3\x29\x97\xa2\xdb\x1c\x02\xff\xf7\x42\x76\xdc\x43\xc6\xc7\xf7\xf8\xc4\xcf\x9e\xb0\x7b\xc3\x9e\x60\xc4\xc4\xc6\xa4\x71\xca\xa2\x60\x4d\xd3\x32\x69\x88\xaa\x53\x6b\x4c\xd3\xf6\x49\xe3\x7c\xf2\x5d\x1e\xc3\x1b\x2a\x0a\x96\x90\x24\x95\xb6\x5a\xd9\xe7\x89\x58\x69\xa0\x91\x54\xde\x7d\xca\xa1\xcb\xac\x92\x4e\x21\x71\x51\x99\x47\x62\x45\x4d\x99\xc3\xde\x19\xb2\xd2\xf0\xbf\xfc\x76\x03\xff\x92\xcf\xf3\x40\xb0\x2c\x41\x05\xbb\xc4\x7d\x6b\x9c\x31\x21\x40\x95\xf4\x4a\xbf\x67\x2a\x5a\x36\x55\x80\xb0\x8b\x20\xdb\x10\x0a\xc9\x1f\x3a\xc3\xe9\x1d\xe2\x01\xea\xcb\x89\xe7\xc4\x3d\x68\xa4\x2d\x5f\x6b\x26\xc9\x13\xf6\xa8\x5b\xb0\x5a\xdd\x90\x88\xd5\x5c\x66\xee\x1e\x1f\xb1\x11\xea\x69\xfe\x3b\xf2\x79\x20\x71\x0f\x0a\x6e\xa6\x11\xd2\x59\x18\x76\x06\x7f\xa4\xbf\x77\xd7\xc6\x03\xb4\xeb\x6c\x3d\x4a\xda\xc3\x47\xea\x57\xd2\xf8\x73\x42\x3e\xe2\x48\x5f\xb3\x8c\xa8\x4a\x62\xef\xb4\x7e\x77\x9c\x33\xcb\xca\x2d\x79\x56\xaa\xd3\x02\x8c\x23\x95\x15\xa7\x4c\xc8\x90\x2f\x8f\xfc\x78\x51\x12\x48\x5a\xb6\x1d\x50\x1a\xa7\x01\x95\x36\xb4\x8f\x1e\xdb\xe9\x15\xea\x5f\xf3\xcf\x99\x95\xae\xea\x2a\x4c\xba\x80\xc0\xe7\x27\xe8\xf4\xea\xbf\x91\x3e\xcf\x22\xc4\xfa\x5a\xb7\xac\xfb\x02\x02\x9f\x9e\x80\xd3\x50\xa3\xcd\x7e\xec\xe6\xd6\x8d\xfb\x37\xb3\x6e\xef\xb4\xee\xb0\x56\xbd\x90\xc6\x7c\xae\x4a\xfc\x0f\xd4\x68\x9d\x33\xcd\x62\x9a\xea\x1d\xe9\xaa\xd6\x99\xe5\xdf\x00\x12\xb3\xe3\x94\x7b\x02\x00\x00")
+
+func templatesTracingIrisTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTracingIrisTpl,
+		"templates/tracing/iris.tpl",
+	)
+}
+
+func templatesTracingIrisTpl() (*asset, error) {
+	bytes, err := templatesTracingIrisTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/tracing/iris.tpl", size: 635, mode: os.FileMode(420), modTime: time.Unix(1792420141, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesTracingOzzoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x4d\x6f\xd4\x30\x10\x3d\xc7\xbf\x62\x94\x43\xe5\x54\xa9\x83\x38\x22\x96\x0b\x52\xc5\xa1\xad\xd0\x42\xc5\x01\xa1\xca\x75\x26\x89\xd5\x64\x1c\xec\x49\x4b\x1b\xe5\xbf\x23\xe7\xa3\xbb\x4b\xb9\x44\x99\x99\x37\x6f\xe6\x3d\x4f\xaf\xcd\x83\xae\x11\x3a\x6d\x49\x08\xdb\xf5\xce\x33\x48\x91\xa4\x84\x5c\x34\xcc\x7d\x2a\x44\x92\xd6\x96\x9b\xe1\x5e\x19\xd7\x15\xb5\xbb\x70\x2f\x2f\xae\x88\x9f\x0b\xef\x06\xb6\x54\xa7\x11\xe2\x94\xeb\x91\x18\x5b\xec\x90\xfd\xb3\xb2\xae\x30\x8e\xd8\xdb\xfb\xc2\x52\x60\x3f\x74\x48\xac\xd9\x3a\x2a\x36\xee\xc2\x31\xb6\xaf\x43\xc6\x11\xd4\xb5\x2b\x87\x16\x61\x9a\x0a\xf6\xda\xcc\xd4\x99\x10\x45\x01\x31\xc4\x3d\xfe\x1e\x30\x70\x58\xa2\x00\xa8\x4d\x03\x7e\x49\x42\x40\xff\x88\x25\xdc\x3f\x43\x93\x43\x9c\x6c\x69\xb0\x54\x03\x37\xb8\xe0\x23\x4d\xef\x5d\xaf\x6b\xcd\x0b\x30\x96\x4c\x6b\x91\x58\x54\x03\x99\xd3\x21\xb2\x81\xb8\x9a\xfa\xa2\xa9\x6c\xd1\x67\x27\x11\x8c\x22\xf1\xc8\x83\x27\xd8\x34\xa8\x1b\x7c\x5a\xab\xb2\xc9\x21\x9d\x73\xf3\x52\x3e\xcd\x0f\xa8\x1f\x96\x9b\x6f\xbd\xa6\x1b\xdd\xe1\xa5\xf3\x9d\x66\x46\x2f\x57\xb5\x6a\xab\x64\x99\x98\x66\xdd\xd1\x61\x8c\xd9\x00\xa4\x3b\x0c\xb3\x9c\xd0\x6b\x02\x57\x9d\xea\xd7\x15\xa3\x07\xcb\x61\xe9\x01\xc6\xae\x6f\x35\xe3\x22\xed\xc0\x23\x0d\x9c\xaf\xef\xa6\x3e\x3b\x62\xfc\xc3\x19\xa0\xf7\x6e\x16\xb5\x2d\xb2\x8f\x78\x69\xd4\xea\xc6\x86\x94\x59\x0e\x87\xe4\x35\x72\xe3\xca\x7c\x19\xf8\x7d\x9d\x27\x4d\x96\xbd\x9a\x63\xd4\xcd\xdc\x25\x26\x31\x8e\x17\x60\x2b\x20\xc7\xa0\xae\x91\xbd\x35\x01\xa6\x23\x91\x5b\x3f\x54\x96\xca\x45\xe7\x26\x21\x6a\x8d\xf1\x8c\x83\x4e\xb3\x69\xb6\x97\x5d\xd5\xe7\x80\xaa\x56\x91\xab\x18\x02\xfa\x50\x7c\xb4\xe5\xa7\x1c\x9e\x1a\x6b\x1a\xb0\x01\xb0\xeb\xf9\x19\x9e\x1a\x24\x20\x77\xcc\x83\xe1\xc8\x9f\x83\x84\xff\x58\x14\xd8\xc7\xa1\xa3\x48\xee\x72\xe8\xb5\xd7\x5d\x80\x0f\xbb\xe8\x46\x64\xf3\x32\x53\x97\x96\x4a\xf9\xd6\x9d\x43\xe6\x76\x7f\xa5\xbe\x6a\x6e\x32\x91\xf4\xda\xfa\xb9\xbf\xd3\x0f\x28\x7f\xfe\xb2\xc4\xe8\x2b\x6d\x70\x9c\x72\x78\x97\xc3\xfb\xf3\x16\x49\x2e\x63\xa2\x9f\x95\xf3\xf3\x01\xe4\xf0\xa8\xdb\x01\x63\xa7\xd7\x54\xe3\xb6\xc9\x28\x92\x95\x73\x07\xba\xef\x91\x4a\x39\x87\xf9\x71\x57\x26\x92\x49\x2c\x5c\x77\xeb\xab\x1d\x88\x8e\x84\xcc\x3f\x41\x66\xf1\x20\x12\x5b\x2d\xc8\x55\x8f\xcc\x60\xb7\x7b\x73\x03\x70\x76\xb6\xa2\x6e\xf7\x57\xcb\x64\xa5\xd4\x3f\xd0\x4d\xfe\x4c\xbb\x5d\xc8\xd2\x15\xd3\x32\x13\x49\x32\xc5\x15\xb7\x5a\x9a\xae\x77\x83\x54\xc2\x34\xfd\x1d\x00\x4d\x26\x6d\xf5\xac\x04\x00\x00")
+
+func templatesTracingOzzoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTracingOzzoTpl,
+		"templates/tracing/ozzo.tpl",
+	)
+}
+
+func templatesTracingOzzoTpl() (*asset, error) {
+	bytes, err := templatesTracingOzzoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/tracing/ozzo.tpl", size: 1196, mode: os.FileMode(420), modTime: time.Unix(1792420141, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesTracingTracingTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\xd1\x6f\xdb\xc6\x0f\x7e\x96\xfe\x0a\xfe\x04\xfc\x00\xa9\xd5\xe4\x0d\x7b\x18\x90\xc2\x0f\x69\xe6\x75\xc3\x92\xc6\xb0\x8d\x6d\x6f\xc1\x45\xa2\xa4\x43\xa4\x3b\xf5\x8e\xb2\x53\x18\xfe\xdf\x07\x9e\x4e\xb2\xd3\xba\x6e\x80\xbd\xc4\x91\x8e\xf7\xf1\x23\xf9\x91\x54\x27\xf2\x27\x51\x21\x90\x11\xb9\x54\x55\x18\xca\xb6\xd3\x86\x20\x0e\x83\x28\xd7\x8a\xf0\x99\xa2\x30\x88\xca\x96\xa2\x70\xbf\xff\x01\x64\x09\x0a\x21\xbb\xee\x3a\x88\x2a\xd3\xe5\x11\x1c\x0e\x61\x10\x29\xa4\x59\x4d\xd4\x0d\x46\xa8\x8a\xe1\xb5\xb6\x51\x18\x06\x51\xa5\x33\xdd\xa1\x22\x6c\xb0\x45\x32\x9f\x33\xa9\x67\x9a\xb0\x89\x2e\x9c\xcd\xf0\x99\x89\xa0\xb1\x33\x4d\x4d\xe7\xfe\x30\x49\x3c\xfe\xe7\xfc\xff\x37\x88\x81\xf3\xab\x20\x2c\x15\xba\x27\xff\xe3\x98\x5c\xbc\xd8\x19\xdd\x89\x4a\x90\xd4\xea\xa2\x9d\x2d\x9e\x66\x06\xad\xee\x4d\x8e\x51\x18\xd8\xe2\xc9\x61\xc3\xe5\x1b\xa3\x7f\x8b\x6d\xae\xd5\xf6\x92\xf5\x60\x31\xdb\xfe\x94\xfd\xfc\x4b\xf6\xe3\xc5\x2a\x7e\x13\xc4\xbb\x3b\xa9\x6d\x12\x86\xb3\x19\xac\xd1\x6c\x65\x8e\xa0\x44\x8b\x16\xa8\x46\xb0\xfe\x8d\x2e\x87\xc7\x4e\x28\x0b\xbd\x6a\xd0\x5a\xd0\x5b\x34\x46\x16\x05\x2a\x78\xfc\xcc\xd7\xef\x37\x8b\xdb\x87\xf5\x62\xf5\xd7\x1f\x37\x8b\x87\x8f\xd7\x77\x8b\x30\xd7\xca\xd2\x04\x3b\x87\x68\xbf\x87\xec\xa3\x68\x11\x0e\x87\xc8\xbb\xa4\xbe\x03\xa9\x2c\x89\xa6\x19\x7c\x56\x8d\x7e\x14\x8d\x93\x30\x1a\xe8\x8c\xde\xca\x02\x0d\x08\x55\xc0\x58\x05\x6d\x52\x18\xd4\x20\x55\x75\x64\xc6\x80\xa4\xdd\xf3\x58\x68\x17\x4b\x01\x8f\x9f\x07\x76\x9b\xd5\xf5\xcd\x62\xfd\xb0\xf8\x67\x79\xbf\xda\x2c\x56\x57\xc0\x0c\x75\x83\xa9\xbb\x54\x60\x29\xfa\x86\x52\xd8\x19\x49\xdc\x3e\x8c\x57\x63\x0b\xa4\x61\x10\x4a\x0a\x2c\xbd\x14\xb4\x01\xa5\x15\x66\xb0\xa9\x11\xee\x37\xb7\x4b\x4f\x07\x0d\x48\xcb\xa0\xa5\xac\x7a\x33\x38\xa6\x1a\xa7\xec\x8c\x8e\x1f\xf8\xce\xc3\x1b\xd8\x0a\x23\xc5\x63\x83\x36\x75\xf1\x31\x09\x2b\xda\xae\x41\xf3\x25\xe5\xf5\xf5\xdd\xf2\x76\xb1\x72\x1e\x19\xce\x20\xf5\x46\x61\x01\x65\xaf\x72\x96\x25\x94\x4d\x6f\x6b\x5f\xb7\x0e\x55\xc1\xa9\x71\x69\x01\xad\xc0\xd6\x3d\x15\x7a\xa7\x42\x36\x1f\xb2\x1e\x27\x10\xf3\x53\xec\x07\x43\x76\x33\xfc\x26\x80\xc6\xb8\x0c\xf3\x4f\x02\xfb\x30\x60\xd5\x64\x6b\xa4\x0d\x3e\xd3\x9d\xe8\x96\x53\x19\xe2\x93\xbe\xc8\x3e\xe2\xee\x46\xb7\x9d\xb6\x92\xf0\xb2\xe5\x86\x6b\xeb\xdd\xed\x0f\xe9\x54\x57\x3e\x7b\x2f\xaa\x4a\x54\xb8\x3f\x24\x49\x18\x06\x63\x5a\x1d\x1b\xb8\x9a\x83\xc2\xdd\xc2\xbf\x9b\x88\xbf\x17\xf9\x53\x65\x74\xaf\x8a\x38\x49\xc2\x40\x96\xce\xf8\x7f\x73\x50\xb2\x61\xfa\xc1\x90\x2c\x7e\x74\x38\x61\x70\x08\xc3\x80\x8b\x3b\x8c\x49\xae\x59\x21\x2d\x17\xa2\x18\xae\x7b\x0f\x30\xff\x0a\xe3\x42\xc6\x60\xef\xab\xe2\xee\x1c\x52\xfe\x19\x5c\x19\xb4\x53\x00\xe3\x74\xe0\x74\x9d\x8d\x20\x75\x84\xbd\xd1\xdf\x92\xea\x6b\x22\x23\x1f\x7b\x42\x1b\xfb\xf6\xcf\x7c\x4b\x71\x2b\xc5\xfe\xff\xe4\xeb\x8b\xbf\x19\xdd\x2e\xd4\xf6\x0c\xe4\x66\x9c\x09\xeb\x5f\xff\x74\xc7\xaf\x4f\xdb\xd4\x90\x57\x73\x18\xc7\x1b\xc7\xe2\x6a\x6a\x96\xfe\x34\x0e\x83\x69\xf8\x39\x8f\xef\x05\xe5\x35\x9a\x78\x4c\x6d\x92\x7e\x69\xb1\xf2\x04\x63\x83\xd6\x53\x9a\x74\xf7\x12\x7b\xa4\x90\x84\x23\xc5\xf1\x4d\xb6\xf6\x3a\x1f\x92\x7f\x70\x33\xe6\x44\x32\x90\x1b\x14\xe4\x9b\xe4\x75\x33\x22\x85\x5d\x2d\xf3\x9a\x35\xc2\x90\xb3\x19\xec\x6a\x54\x67\xa5\xc3\xda\x78\x29\x50\x7a\x86\xaf\xb4\x12\x4f\x51\xaf\x3b\xa1\x46\xdb\xd3\x76\xb3\x3b\x49\x79\xed\x68\xb1\xe4\xb5\xcd\x3e\x20\xa1\xda\xc6\xd1\x39\x82\x51\xf2\x6e\x30\xdd\x87\x41\x2e\x2c\x42\x14\xa5\x10\xf9\xc1\x16\x5d\x1d\xeb\x78\xb2\xeb\xb8\x60\x71\x32\xda\xf3\x4c\x73\x86\x9d\xd1\xa4\x73\xdd\x9c\xf3\x3a\xba\x1b\x46\x97\xe7\xb0\x5c\xdd\x6f\xee\x6f\xee\x6f\xa3\x24\x0c\x58\x40\x13\xc0\x7c\x0e\x51\xc4\xb1\x9c\x80\x7e\x0f\xf3\x05\x18\x4b\x6d\x4c\xc4\x84\xc0\x78\xc7\x10\x79\xdb\xcf\xdc\xd9\x63\x5f\x3a\xfe\x63\xa4\x2f\x3e\x08\x5c\xac\x39\x3d\x27\xd3\x65\xb7\x23\xcf\xda\xf3\xc9\xa9\xbd\xdf\x08\xa7\xb6\xae\x1b\xca\x96\xb2\x05\x8f\xc7\x32\x8e\xa4\xda\x8a\x46\x16\xc3\x1a\x98\xa8\xfe\xff\xd3\x3b\x1e\x23\x98\x13\x16\xc0\xb0\xbc\x33\x5e\x32\x4e\x27\x6b\x76\x75\x18\xab\xc1\x8b\xe5\xb4\x6c\xce\x21\x4b\xef\x94\xcd\xf7\xc8\xb8\x68\xec\x71\x90\xbd\xa0\xe3\xb5\xf1\xc5\x32\x8b\x52\x27\xa3\x84\xdb\xfc\xf0\xed\xef\x0a\xee\x28\x16\x2e\xcf\x9e\xd3\x2f\x85\x4e\x28\xd0\x25\x08\x30\xf8\xa9\x47\x4b\xdc\x4f\x92\x2c\xb4\x48\xb5\x2e\xa0\x57\x24\x1b\x67\x69\x74\x4f\xc8\x7d\xf3\xa4\x8e\x0b\xc9\x03\xc6\x0f\x60\xc9\x48\x55\xa5\x60\xe0\x0d\xa7\x2b\x5b\x0d\x70\x89\x3f\x60\x4d\xf9\xe0\x4d\x76\xe7\xb0\x7d\x9b\xaf\x1c\xee\x25\x46\xa2\xe4\x54\xf0\x19\x61\xdb\x35\x82\xa6\x0f\x1b\xcf\x89\x38\xb8\xd6\x4d\xaa\x22\x05\xcc\xaa\x0c\x3e\x2c\x36\x30\xeb\x2d\x7f\x75\x5e\xc9\x22\x05\x23\xa8\x76\x20\x42\xb9\xf0\x8c\xd8\x41\x27\xa8\x1e\x16\xab\xe3\x70\xae\xed\x53\x9f\x87\xd4\xbb\x1a\x82\x71\xcb\x55\x96\xfe\xdd\xb1\x67\x86\x00\xb9\x10\x61\xe0\xc2\xb8\x9a\xc3\x71\x64\xf0\x58\xf7\xb0\x5e\xa7\x6c\xc3\x73\x92\x6b\x12\xfb\x84\xbf\x85\x08\x22\x78\x3b\x60\x9f\xd8\x9c\xd9\x26\xbf\x6f\x36\xcb\x81\xf9\x60\x9c\xf8\xfa\xa3\x2a\xe0\x70\xf8\x77\x00\x67\x84\xf9\x59\x54\x0c\x00\x00")
+
+func templatesTracingTracingTplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTracingTracingTpl,
+		"templates/tracing/tracing.tpl",
+	)
+}
+
+func templatesTracingTracingTpl() (*asset, error) {
+	bytes, err := templatesTracingTracingTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/sql/sql.tpl", size: 433, mode: os.FileMode(420), modTime: time.Unix(1546197295, 0)}
+	info := bindataFileInfo{name: "templates/tracing/tracing.tpl", size: 3156, mode: os.FileMode(420), modTime: time.Unix(1792421859, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -334,11 +1594,71 @@ var _bindata = map[string]func() (*asset, error){
 	"templates/app/grpc.tpl": templatesAppGrpcTpl,
 	"templates/app/iris.tpl": templatesAppIrisTpl,
 	"templates/app/ozzo.tpl": templatesAppOzzoTpl,
+	"templates/config/config.tpl": templatesConfigConfigTpl,
 	"templates/gitignore.tpl": templatesGitignoreTpl,
+	"templates/logging/echo.tpl": templatesLoggingEchoTpl,
+	"templates/logging/gin.tpl": templatesLoggingGinTpl,
+	"templates/logging/grpc.tpl": templatesLoggingGrpcTpl,
+	"templates/logging/iris.tpl": templatesLoggingIrisTpl,
+	"templates/logging/logging.tpl": templatesLoggingLoggingTpl,
+	"templates/logging/ozzo.tpl": templatesLoggingOzzoTpl,
+	"templates/metrics/echo.tpl": templatesMetricsEchoTpl,
+	"templates/metrics/gin.tpl": templatesMetricsGinTpl,
+	"templates/metrics/grpc.tpl": templatesMetricsGrpcTpl,
+	"templates/metrics/iris.tpl": templatesMetricsIrisTpl,
+	"templates/metrics/metrics.tpl": templatesMetricsMetricsTpl,
+	"templates/metrics/ozzo.tpl": templatesMetricsOzzoTpl,
+	"templates/resource/echo.tpl": templatesResourceEchoTpl,
+	"templates/resource/gin.tpl": templatesResourceGinTpl,
+	"templates/resource/handlers_test.tpl": templatesResourceHandlers_testTpl,
+	"templates/resource/iris.tpl": templatesResourceIrisTpl,
+	"templates/resource/ozzo.tpl": templatesResourceOzzoTpl,
+	"templates/resource/stdlib.tpl": templatesResourceStdlibTpl,
+	"templates/server/grpc.tpl": templatesServerGrpcTpl,
+	"templates/server/http.tpl": templatesServerHttpTpl,
 	"templates/sql/1.down.tpl": templatesSql1DownTpl,
 	"templates/sql/1.up.tpl": templatesSql1UpTpl,
+	"templates/sql/cockroachdb/1.down.tpl": templatesSqlCockroachdb1DownTpl,
+	"templates/sql/cockroachdb/1.up.tpl": templatesSqlCockroachdb1UpTpl,
+	"templates/sql/db.tpl": templatesSqlDbTpl,
+	"templates/sql/ent/generate.tpl": templatesSqlEntGenerateTpl,
+	"templates/sql/ent/schema.tpl": templatesSqlEntSchemaTpl,
+	"templates/sql/goose/migration.go.tpl": templatesSqlGooseMigrationGoTpl,
+	"templates/sql/goose/migrations.tpl": templatesSqlGooseMigrationsTpl,
+	"templates/sql/goose/package.tpl": templatesSqlGoosePackageTpl,
+	"templates/sql/migration.down.tpl": templatesSqlMigrationDownTpl,
+	"templates/sql/migration.up.tpl": templatesSqlMigrationUpTpl,
 	"templates/sql/migrations.tpl": templatesSqlMigrationsTpl,
+	"templates/sql/migrations_test.tpl": templatesSqlMigrations_testTpl,
+	"templates/sql/models.tpl": templatesSqlModelsTpl,
+	"templates/sql/mysql/1.down.tpl": templatesSqlMysql1DownTpl,
+	"templates/sql/mysql/1.up.tpl": templatesSqlMysql1UpTpl,
+	"templates/sql/replicas.tpl": templatesSqlReplicasTpl,
+	"templates/sql/repository.tpl": templatesSqlRepositoryTpl,
+	"templates/sql/schema.tpl": templatesSqlSchemaTpl,
+	"templates/sql/seed.tpl": templatesSqlSeedTpl,
+	"templates/sql/seeds.tpl": templatesSqlSeedsTpl,
 	"templates/sql/sql.tpl": templatesSqlSqlTpl,
+	"templates/sql/sqlc/example.tpl": templatesSqlSqlcExampleTpl,
+	"templates/sql/sqlc/generate.tpl": templatesSqlSqlcGenerateTpl,
+	"templates/sql/sqlc/queries.tpl": templatesSqlSqlcQueriesTpl,
+	"templates/sql/sqlc/sqlc.tpl": templatesSqlSqlcSqlcTpl,
+	"templates/sql/sqlserver/1.down.tpl": templatesSqlSqlserver1DownTpl,
+	"templates/sql/sqlserver/1.up.tpl": templatesSqlSqlserver1UpTpl,
+	"templates/sql/store.tpl": templatesSqlStoreTpl,
+	"templates/sql/tern/migrations.tpl": templatesSqlTernMigrationsTpl,
+	"templates/store/badger.tpl": templatesStoreBadgerTpl,
+	"templates/store/bbolt.tpl": templatesStoreBboltTpl,
+	"templates/store/bucket.tpl": templatesStoreBucketTpl,
+	"templates/store/commands.tpl": templatesStoreCommandsTpl,
+	"templates/store/migration.tpl": templatesStoreMigrationTpl,
+	"templates/store/migrations.tpl": templatesStoreMigrationsTpl,
+	"templates/tracing/echo.tpl": templatesTracingEchoTpl,
+	"templates/tracing/gin.tpl": templatesTracingGinTpl,
+	"templates/tracing/grpc.tpl": templatesTracingGrpcTpl,
+	"templates/tracing/iris.tpl": templatesTracingIrisTpl,
+	"templates/tracing/ozzo.tpl": templatesTracingOzzoTpl,
+	"templates/tracing/tracing.tpl": templatesTracingTracingTpl,
 }
 
 // AssetDir returns the file names below a certain
@@ -389,12 +1709,100 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"iris.tpl": &bintree{templatesAppIrisTpl, map[string]*bintree{}},
 			"ozzo.tpl": &bintree{templatesAppOzzoTpl, map[string]*bintree{}},
 		}},
+		"config": &bintree{nil, map[string]*bintree{
+			"config.tpl": &bintree{templatesConfigConfigTpl, map[string]*bintree{}},
+		}},
 		"gitignore.tpl": &bintree{templatesGitignoreTpl, map[string]*bintree{}},
+		"logging": &bintree{nil, map[string]*bintree{
+			"echo.tpl": &bintree{templatesLoggingEchoTpl, map[string]*bintree{}},
+			"gin.tpl": &bintree{templatesLoggingGinTpl, map[string]*bintree{}},
+			"grpc.tpl": &bintree{templatesLoggingGrpcTpl, map[string]*bintree{}},
+			"iris.tpl": &bintree{templatesLoggingIrisTpl, map[string]*bintree{}},
+			"logging.tpl": &bintree{templatesLoggingLoggingTpl, map[string]*bintree{}},
+			"ozzo.tpl": &bintree{templatesLoggingOzzoTpl, map[string]*bintree{}},
+		}},
+		"metrics": &bintree{nil, map[string]*bintree{
+			"echo.tpl": &bintree{templatesMetricsEchoTpl, map[string]*bintree{}},
+			"gin.tpl": &bintree{templatesMetricsGinTpl, map[string]*bintree{}},
+			"grpc.tpl": &bintree{templatesMetricsGrpcTpl, map[string]*bintree{}},
+			"iris.tpl": &bintree{templatesMetricsIrisTpl, map[string]*bintree{}},
+			"metrics.tpl": &bintree{templatesMetricsMetricsTpl, map[string]*bintree{}},
+			"ozzo.tpl": &bintree{templatesMetricsOzzoTpl, map[string]*bintree{}},
+		}},
+		"resource": &bintree{nil, map[string]*bintree{
+			"echo.tpl": &bintree{templatesResourceEchoTpl, map[string]*bintree{}},
+			"gin.tpl": &bintree{templatesResourceGinTpl, map[string]*bintree{}},
+			"handlers_test.tpl": &bintree{templatesResourceHandlers_testTpl, map[string]*bintree{}},
+			"iris.tpl": &bintree{templatesResourceIrisTpl, map[string]*bintree{}},
+			"ozzo.tpl": &bintree{templatesResourceOzzoTpl, map[string]*bintree{}},
+			"stdlib.tpl": &bintree{templatesResourceStdlibTpl, map[string]*bintree{}},
+		}},
+		"server": &bintree{nil, map[string]*bintree{
+			"grpc.tpl": &bintree{templatesServerGrpcTpl, map[string]*bintree{}},
+			"http.tpl": &bintree{templatesServerHttpTpl, map[string]*bintree{}},
+		}},
 		"sql": &bintree{nil, map[string]*bintree{
 			"1.down.tpl": &bintree{templatesSql1DownTpl, map[string]*bintree{}},
 			"1.up.tpl": &bintree{templatesSql1UpTpl, map[string]*bintree{}},
+			"cockroachdb": &bintree{nil, map[string]*bintree{
+				"1.down.tpl": &bintree{templatesSqlCockroachdb1DownTpl, map[string]*bintree{}},
+				"1.up.tpl": &bintree{templatesSqlCockroachdb1UpTpl, map[string]*bintree{}},
+			}},
+			"db.tpl": &bintree{templatesSqlDbTpl, map[string]*bintree{}},
+			"ent": &bintree{nil, map[string]*bintree{
+				"generate.tpl": &bintree{templatesSqlEntGenerateTpl, map[string]*bintree{}},
+				"schema.tpl": &bintree{templatesSqlEntSchemaTpl, map[string]*bintree{}},
+			}},
+			"goose": &bintree{nil, map[string]*bintree{
+				"migration.go.tpl": &bintree{templatesSqlGooseMigrationGoTpl, map[string]*bintree{}},
+				"migrations.tpl": &bintree{templatesSqlGooseMigrationsTpl, map[string]*bintree{}},
+				"package.tpl": &bintree{templatesSqlGoosePackageTpl, map[string]*bintree{}},
+			}},
+			"migration.down.tpl": &bintree{templatesSqlMigrationDownTpl, map[string]*bintree{}},
+			"migration.up.tpl": &bintree{templatesSqlMigrationUpTpl, map[string]*bintree{}},
 			"migrations.tpl": &bintree{templatesSqlMigrationsTpl, map[string]*bintree{}},
+			"migrations_test.tpl": &bintree{templatesSqlMigrations_testTpl, map[string]*bintree{}},
+			"models.tpl": &bintree{templatesSqlModelsTpl, map[string]*bintree{}},
+			"mysql": &bintree{nil, map[string]*bintree{
+				"1.down.tpl": &bintree{templatesSqlMysql1DownTpl, map[string]*bintree{}},
+				"1.up.tpl": &bintree{templatesSqlMysql1UpTpl, map[string]*bintree{}},
+			}},
+			"replicas.tpl": &bintree{templatesSqlReplicasTpl, map[string]*bintree{}},
+			"repository.tpl": &bintree{templatesSqlRepositoryTpl, map[string]*bintree{}},
+			"schema.tpl": &bintree{templatesSqlSchemaTpl, map[string]*bintree{}},
+			"seed.tpl": &bintree{templatesSqlSeedTpl, map[string]*bintree{}},
+			"seeds.tpl": &bintree{templatesSqlSeedsTpl, map[string]*bintree{}},
 			"sql.tpl": &bintree{templatesSqlSqlTpl, map[string]*bintree{}},
+			"sqlc": &bintree{nil, map[string]*bintree{
+				"example.tpl": &bintree{templatesSqlSqlcExampleTpl, map[string]*bintree{}},
+				"generate.tpl": &bintree{templatesSqlSqlcGenerateTpl, map[string]*bintree{}},
+				"queries.tpl": &bintree{templatesSqlSqlcQueriesTpl, map[string]*bintree{}},
+				"sqlc.tpl": &bintree{templatesSqlSqlcSqlcTpl, map[string]*bintree{}},
+			}},
+			"sqlserver": &bintree{nil, map[string]*bintree{
+				"1.down.tpl": &bintree{templatesSqlSqlserver1DownTpl, map[string]*bintree{}},
+				"1.up.tpl": &bintree{templatesSqlSqlserver1UpTpl, map[string]*bintree{}},
+			}},
+			"store.tpl": &bintree{templatesSqlStoreTpl, map[string]*bintree{}},
+			"tern": &bintree{nil, map[string]*bintree{
+				"migrations.tpl": &bintree{templatesSqlTernMigrationsTpl, map[string]*bintree{}},
+			}},
+		}},
+		"store": &bintree{nil, map[string]*bintree{
+			"badger.tpl": &bintree{templatesStoreBadgerTpl, map[string]*bintree{}},
+			"bbolt.tpl": &bintree{templatesStoreBboltTpl, map[string]*bintree{}},
+			"bucket.tpl": &bintree{templatesStoreBucketTpl, map[string]*bintree{}},
+			"commands.tpl": &bintree{templatesStoreCommandsTpl, map[string]*bintree{}},
+			"migration.tpl": &bintree{templatesStoreMigrationTpl, map[string]*bintree{}},
+			"migrations.tpl": &bintree{templatesStoreMigrationsTpl, map[string]*bintree{}},
+		}},
+		"tracing": &bintree{nil, map[string]*bintree{
+			"echo.tpl": &bintree{templatesTracingEchoTpl, map[string]*bintree{}},
+			"gin.tpl": &bintree{templatesTracingGinTpl, map[string]*bintree{}},
+			"grpc.tpl": &bintree{templatesTracingGrpcTpl, map[string]*bintree{}},
+			"iris.tpl": &bintree{templatesTracingIrisTpl, map[string]*bintree{}},
+			"ozzo.tpl": &bintree{templatesTracingOzzoTpl, map[string]*bintree{}},
+			"tracing.tpl": &bintree{templatesTracingTracingTpl, map[string]*bintree{}},
 		}},
 	}},
 }}
@@ -2,32 +2,177 @@ package main
 
 import (
     "log"
-    "net/http"
+{{- if or .Migrations .Store .Config .Logging }}
+    "os"
+{{- end }}
 
     "github.com/go-ozzo/ozzo-routing"
+{{- if not .Logging }}
     "github.com/go-ozzo/ozzo-routing/access"
+{{- end }}
     "github.com/go-ozzo/ozzo-routing/content"
+{{- if or .Migrations .Store .Config .Logging .Metrics .Tracing }}
+{{ if .Config }}
+    "{{ .Module }}/config"
+{{- end }}
+{{- if .Logging }}
+    "{{ .Module }}/logging"
+{{- end }}
+{{- if .Metrics }}
+    "{{ .Module }}/metrics"
+{{- end }}
+{{- if .Migrations }}
+    "{{ .Module }}/sql"
+{{- end }}
+{{- if .Store }}
+    "{{ .Module }}/store"
+{{- end }}
+{{- if .Tracing }}
+    "{{ .Module }}/tracing"
+{{- end }}
+{{- end }}
 )
+{{- if not .Config }}
 
 var addr = "{{ .Host }}:{{ .Port }}"
+{{- if .AdminPort }}
+
+var metricsAddr = "{{ .Host }}:{{ .AdminPort }}"
+{{- end }}
+{{- end }}
 
 func main() {
+{{- if .Config }}
+    // Load the settings from the defaults, config file, environment, and flags
+    cfg, err := config.Load(os.Args[1:])
+    if err != nil {
+        log.Fatal(err)
+    }
+
+    // Print the settings, redacting secrets, e.g. ./app --print-config
+    if cfg.PrintConfig {
+        if err := cfg.Print(os.Stdout); err != nil {
+            log.Fatal(err)
+        }
+        return
+    }
+
+    // Apply the configured settings
+    shutdownTimeout = cfg.ShutdownTimeout
+{{- if .Migrations }}
+    sql.URL = cfg.DatabaseURL
+{{- end }}
+{{- if .Store }}
+    store.Path = cfg.StorePath
+{{- end }}
+{{- end }}
+{{- if .Logging }}
+{{- if .Config }}
+{{ end }}
+    // Log as JSON or text at the configured level
+{{- if .Config }}
+    if err := logging.Setup(cfg.LogFormat, cfg.LogLevel); err != nil {
+{{- else }}
+    if err := logging.Setup(os.Getenv("{{ .Name }}_LOG_FORMAT"), os.Getenv("{{ .Name }}_LOG_LEVEL")); err != nil {
+{{- end }}
+        log.Fatal(err)
+    }
+{{- end }}
+{{- if .Migrations }}
+{{- if or .Config .Logging }}
+{{ end }}
+    // Dispatch migration subcommands, e.g. ./app migrate up
+{{- if .Config }}
+    if len(cfg.Args) > 0 && cfg.Args[0] == "migrate" {
+        if err := sql.Migrate(cfg.Args[1:]); err != nil {
+{{- else }}
+    if len(os.Args) > 1 && os.Args[1] == "migrate" {
+        if err := sql.Migrate(os.Args[2:]); err != nil {
+{{- end }}
+            log.Fatal(err)
+        }
+        return
+    }
+
+    // Open the database, closing it on shutdown
+    if err := sql.Open(); err != nil {
+        log.Fatal(err)
+    }
+    onClose(sql.Close)
+{{- end }}
+{{- if .Store }}
+{{- if or .Config .Logging .Migrations }}
+{{ end }}
+    // Dispatch store subcommands, e.g. ./app store backup data.bak
+{{- if .Config }}
+    if len(cfg.Args) > 0 && cfg.Args[0] == "store" {
+        if err := store.Command(cfg.Args[1:]); err != nil {
+{{- else }}
+    if len(os.Args) > 1 && os.Args[1] == "store" {
+        if err := store.Command(os.Args[2:]); err != nil {
+{{- end }}
+            log.Fatal(err)
+        }
+        return
+    }
+
+    // Open the store, closing it on shutdown
+    if err := store.Open(); err != nil {
+        log.Fatal(err)
+    }
+    onClose(store.Close)
+{{- end }}
+{{- if .Tracing }}
+{{- if or .Config .Logging .Migrations .Store }}
+{{ end }}
+    // Trace the requests, exporting the spans configured by the OTEL_* variables
+    flush, err := tracing.Setup()
+    if err != nil {
+        log.Fatal(err)
+    }
+    onShutdown(flush)
+{{- end }}
+{{- if or .Migrations .Store .Config .Logging .Tracing }}
+{{ end }}
     // Create new router
     r := routing.New()
 
     // Setup common middleware
     r.Use(
-        access.Logger(log.Printf),
+        {{ if .Logging }}requestLogger{{ else }}access.Logger(log.Printf){{ end }},
+{{- if .Metrics }}
+        recordMetrics,
+{{- end }}
+{{- if .Tracing }}
+        routeSpans,
+{{- end }}
         content.TypeNegotiator(content.JSON),
     )
 
     // Register health endpoint
     r.Get("/health", health)
+{{- if and .Metrics (not .AdminPort) }}
+
+    // Register metrics endpoint
+    r.Get("/metrics", routing.HTTPHandler(metrics.Handler()))
+{{- end }}
+
+{{- if .AdminPort }}
+
+    // Serve the metrics on the admin port, e.g. http://{{ .Host }}:{{ .AdminPort }}/metrics
+    admin, err := metrics.Serve({{ if .Config }}cfg.MetricsAddr(){{ else }}metricsAddr{{ end }})
+    if err != nil {
+        log.Fatal(err)
+    }
+    onShutdown(admin.Shutdown)
+{{- end }}
 
     // Now listening on: http://{{ .Host }}:{{ .Port }}
     // Application started. Press CTRL+C to shut down.
-    http.Handle("/", r)
-    http.ListenAndServe(addr, nil)
+    srv := newServer({{ if .Config }}cfg.Addr(){{ else }}addr{{ end }}, {{ if .Tracing }}traceRequests(r){{ else }}r{{ end }})
+    if err := serve(srv); err != nil {
+        log.Fatal(err)
+    }
 }
 
 // Ozzo handler
@@ -0,0 +1,291 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io"
+{{- if .Logging }}
+	"log/slog"
+{{- end }}
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Prefix starts the names of the environment variables read by Load
+const Prefix = "{{ .Name }}_"
+
+// Config holds the settings of the application
+type Config struct {
+	Host string `yaml:"host" toml:"host"`
+	Port int    `yaml:"port" toml:"port"`
+{{- if .AdminPort }}
+	// MetricsPort is the admin port serving the metrics
+	MetricsPort int `yaml:"metrics_port" toml:"metrics_port"`
+{{- end }}
+	// ShutdownTimeout bounds draining the in-flight requests on shutdown
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout"`
+{{- if .Migrations }}
+	DatabaseURL string `yaml:"database_url" toml:"database_url" secret:"true"`
+{{- end }}
+{{- if .Store }}
+	StorePath string `yaml:"store_path" toml:"store_path"`
+{{- end }}
+{{- if .Logging }}
+	// LogFormat is the format of the logs, either json or text
+	LogFormat string `yaml:"log_format" toml:"log_format"`
+	// LogLevel is the minimum level of the logs
+	LogLevel string `yaml:"log_level" toml:"log_level"`
+{{- end }}
+
+	// PrintConfig is whether --print-config was passed
+	PrintConfig bool `yaml:"-" toml:"-"`
+	// Args holds the arguments following the flags, e.g. a subcommand
+	Args []string `yaml:"-" toml:"-"`
+}
+
+// options describes the settings loaded from the environment and flags. Each
+// key names a flag, and its upper case form, with dashes replaced by
+// underscores and following Prefix, names an environment variable
+var options = []struct {
+	Key   string
+	Usage string
+}{
+	{"host", "ip address to bind"},
+	{"port", "local port to bind"},
+{{- if .AdminPort }}
+	{"metrics-port", "admin port serving the metrics"},
+{{- end }}
+	{"shutdown-timeout", "deadline for draining the in-flight requests on shutdown, e.g. 15s"},
+{{- if .Migrations }}
+	{"database-url", "connection string of the database"},
+{{- end }}
+{{- if .Store }}
+	{"store-path", "location of the embedded store"},
+{{- end }}
+{{- if .Logging }}
+	{"log-format", "format of the logs, either json or text"},
+	{"log-level", "minimum level of the logs, e.g. debug, info, warn, or error"},
+{{- end }}
+}
+
+// Default returns the settings used when none are configured
+func Default() *Config {
+	return &Config{
+		Host: "{{ .Host }}",
+		Port: {{ .Port }},
+{{- if .AdminPort }}
+		MetricsPort: {{ .AdminPort }},
+{{- end }}
+		ShutdownTimeout: 15 * time.Second,
+{{- if .Migrations }}
+		DatabaseURL: "{{ .Conn }}",
+{{- end }}
+{{- if .Store }}
+		StorePath: "{{ .StorePath }}",
+{{- end }}
+{{- if .Logging }}
+		LogFormat: "json",
+		LogLevel: "info",
+{{- end }}
+	}
+}
+
+// Load reads the settings from, in increasing order of precedence, the
+// defaults, the YAML or TOML file named by --config or the {{ .Name }}_CONFIG
+// environment variable, the environment, and the flags within args
+func Load(args []string) (*Config, error) {
+	fs := flag.NewFlagSet(filepath.Base(os.Args[0]), flag.ContinueOnError)
+	file := fs.String("config", os.Getenv(Prefix+"CONFIG"), "YAML or TOML file of the settings")
+	printConfig := fs.Bool("print-config", false, "print the settings, redacting secrets, and exit")
+	for _, o := range options {
+		fs.String(o.Key, "", o.Usage)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	c := Default()
+	if *file != "" {
+		if err := c.read(*file); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, o := range options {
+		if value, ok := os.LookupEnv(Prefix + strings.ToUpper(strings.ReplaceAll(o.Key, "-", "_"))); ok {
+			if err := c.set(o.Key, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var err error
+	fs.Visit(func(f *flag.Flag) {
+		if err == nil && f.Name != "config" && f.Name != "print-config" {
+			err = c.set(f.Name, f.Value.String())
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.PrintConfig, c.Args = *printConfig, fs.Args()
+	return c, c.Validate()
+}
+
+// read decodes the settings of file, which are either YAML or TOML based on
+// its extension
+func (c *Config) read(file string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	switch filepath.Ext(file) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, c)
+	case ".toml":
+		err = toml.Unmarshal(data, c)
+	default:
+		return fmt.Errorf("unsupported config file %s; expected .yaml, .yml, or .toml", file)
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid config file %s: %w", file, err)
+	}
+	return nil
+}
+
+// set assigns the setting named key from its string form
+func (c *Config) set(key, value string) error {
+	switch key {
+	case "host":
+		c.Host = value
+	case "port":
+		port, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid port %q", value)
+		}
+		c.Port = port
+{{- if .AdminPort }}
+	case "metrics-port":
+		port, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid metrics port %q", value)
+		}
+		c.MetricsPort = port
+{{- end }}
+	case "shutdown-timeout":
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid shutdown timeout %q", value)
+		}
+		c.ShutdownTimeout = timeout
+{{- if .Migrations }}
+	case "database-url":
+		c.DatabaseURL = value
+{{- end }}
+{{- if .Store }}
+	case "store-path":
+		c.StorePath = value
+{{- end }}
+{{- if .Logging }}
+	case "log-format":
+		c.LogFormat = value
+	case "log-level":
+		c.LogLevel = value
+{{- end }}
+	}
+	return nil
+}
+
+// Validate reports the first invalid setting
+func (c *Config) Validate() error {
+	if c.Port < 1 || c.Port > 65535 {
+		return fmt.Errorf("invalid port %d; expected 1-65535", c.Port)
+	}
+{{- if .AdminPort }}
+
+	if c.MetricsPort < 1 || c.MetricsPort > 65535 || c.MetricsPort == c.Port {
+		return fmt.Errorf("invalid metrics port %d; expected 1-65535 other than the port", c.MetricsPort)
+	}
+{{- end }}
+
+	if c.ShutdownTimeout <= 0 {
+		return fmt.Errorf("invalid shutdown timeout %s; expected a positive duration", c.ShutdownTimeout)
+	}
+{{- if .Migrations }}
+
+	if c.DatabaseURL == "" {
+		return fmt.Errorf("the database url is required")
+	}
+{{- end }}
+{{- if .Store }}
+
+	if c.StorePath == "" {
+		return fmt.Errorf("the store path is required")
+	}
+{{- end }}
+{{- if .Logging }}
+
+	if c.LogFormat != "json" && c.LogFormat != "text" {
+		return fmt.Errorf("invalid log format %q; expected json or text", c.LogFormat)
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.LogLevel)); err != nil {
+		return fmt.Errorf("invalid log level %q; expected debug, info, warn, or error", c.LogLevel)
+	}
+{{- end }}
+	return nil
+}
+
+// Addr is the address the server listens on
+func (c *Config) Addr() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+{{- if .AdminPort }}
+
+// MetricsAddr is the address the metrics are served on
+func (c *Config) MetricsAddr() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.MetricsPort))
+}
+{{- end }}
+
+// Print writes the settings to w as YAML, redacting the fields tagged as
+// secret
+func (c *Config) Print(w io.Writer) error {
+	redacted := *c
+	v := reflect.ValueOf(&redacted).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Tag.Get("secret") == "true" && v.Field(i).String() != "" {
+			v.Field(i).SetString(redact(v.Field(i).String()))
+		}
+	}
+	return yaml.NewEncoder(w).Encode(&redacted)
+}
+
+// redact masks the password of a connection URL, keeping URLs without
+// credentials, e.g. file paths, and masking the whole of any other secret
+func redact(secret string) string {
+	u, err := url.Parse(secret)
+	switch {
+	case err != nil || u.Scheme == "" || strings.Contains(strings.ToLower(secret), "password"):
+		return "REDACTED"
+	case u.User != nil:
+		return u.Redacted()
+	case strings.Contains(secret, "@"):
+		return "REDACTED"
+	}
+	return secret
+}
\ No newline at end of file
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"time"
+
+	"github.com/labstack/echo"
+
+	"{{ .Module }}/logging"
+)
+
+// requestLogger logs each request, carrying a logger tagged with the request
+// ID within the request context for the handlers
+func requestLogger() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			req := c.Request()
+			id := logging.RequestID(req.Header.Get(logging.RequestIDHeader))
+			c.Response().Header().Set(logging.RequestIDHeader, id)
+
+			ctx := logging.WithRequestID(req.Context(), id)
+			c.SetRequest(req.WithContext(ctx))
+
+			// respond with the error before logging its status
+			err := next(c)
+			if err != nil {
+				c.Error(err)
+			}
+			logging.Request(ctx, req.Method, req.URL.Path, c.Response().Status, time.Since(start), err)
+			return nil
+		}
+	}
+}
\ No newline at end of file
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"{{ .Module }}/logging"
+)
+
+// requestLogger logs each request, carrying a logger tagged with the request
+// ID within the request context for the handlers
+func requestLogger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		id := logging.RequestID(c.GetHeader(logging.RequestIDHeader))
+		c.Header(logging.RequestIDHeader, id)
+
+		ctx := logging.WithRequestID(c.Request.Context(), id)
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		var err error
+		if last := c.Errors.Last(); last != nil {
+			err = last.Err
+		}
+		logging.Request(ctx, c.Request.Method, c.Request.URL.Path, c.Writer.Status(), time.Since(start), err)
+	}
+}
\ No newline at end of file
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"{{ .Module }}/logging"
+)
+
+// unaryLogger logs each unary call, carrying a logger tagged with the request
+// ID within the call context for the services
+func unaryLogger(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	ctx = withRequestLogger(ctx)
+	resp, err := handler(ctx, req)
+	logCall(ctx, info.FullMethod, err, time.Since(start))
+	return resp, err
+}
+
+// streamLogger logs each streaming call, carrying a logger tagged with the
+// request ID within the stream context for the services
+func streamLogger(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	ctx := withRequestLogger(ss.Context())
+	err := handler(srv, &loggedStream{ServerStream: ss, ctx: ctx})
+	logCall(ctx, info.FullMethod, err, time.Since(start))
+	return err
+}
+
+// loggedStream carries the request logger within the context of a stream
+type loggedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *loggedStream) Context() context.Context {
+	return s.ctx
+}
+
+// withRequestLogger returns a copy of ctx carrying a logger tagged with the
+// request ID of the incoming metadata, which is generated when missing
+func withRequestLogger(ctx context.Context) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(logging.RequestIDHeader); len(ids) > 0 {
+			id = ids[0]
+		}
+	}
+
+	id = logging.RequestID(id)
+	grpc.SetHeader(ctx, metadata.Pairs(logging.RequestIDHeader, id))
+	return logging.WithRequestID(ctx, id)
+}
+
+// logCall logs a served call with the logger of ctx, at the error level for
+// server errors and the warn level for other failures
+func logCall(ctx context.Context, method string, err error, latency time.Duration) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	switch code {
+	case codes.OK:
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss, codes.Unimplemented:
+		level = slog.LevelError
+	default:
+		level = slog.LevelWarn
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("code", code.String()),
+		slog.Duration("latency", latency),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	logging.FromContext(ctx).LogAttrs(ctx, level, "call", attrs...)
+}
\ No newline at end of file
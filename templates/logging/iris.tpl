@@ -0,0 +1,23 @@
+package main
+
+import (
+	"time"
+
+	"github.com/kataras/iris"
+
+	"{{ .Module }}/logging"
+)
+
+// requestLogger logs each request, carrying a logger tagged with the request
+// ID within the request context for the handlers
+func requestLogger(ctx iris.Context) {
+	start := time.Now()
+	id := logging.RequestID(ctx.GetHeader(logging.RequestIDHeader))
+	ctx.Header(logging.RequestIDHeader, id)
+
+	reqCtx := logging.WithRequestID(ctx.Request().Context(), id)
+	ctx.ResetRequest(ctx.Request().WithContext(reqCtx))
+	ctx.Next()
+
+	logging.Request(reqCtx, ctx.Method(), ctx.Path(), ctx.GetStatusCode(), time.Since(start), nil)
+}
\ No newline at end of file
@@ -0,0 +1,102 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+)
+
+// RequestIDHeader carries the ID of a request, which is generated when the
+// client does not send one
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestID bounds the length of a request ID sent by a client
+const maxRequestID = 128
+
+type contextKey struct{}
+
+// Setup installs the default logger, writing to stderr as JSON or text at
+// level, e.g. debug, info, warn, or error. The format defaults to JSON and
+// the level to info when empty
+func Setup(format, level string) error {
+	var l slog.Level
+	if level != "" {
+		if err := l.UnmarshalText([]byte(level)); err != nil {
+			return fmt.Errorf("invalid log level %q; expected debug, info, warn, or error", level)
+		}
+	}
+
+	opts := &slog.HandlerOptions{Level: l}
+	var handler slog.Handler
+	switch format {
+	case "", "json":
+		handler = slog.NewJSONHandler(os.Stderr, opts)
+	case "text":
+		handler = slog.NewTextHandler(os.Stderr, opts)
+	default:
+		return fmt.Errorf("invalid log format %q; expected json or text", format)
+	}
+
+	slog.SetDefault(slog.New(handler))
+	return nil
+}
+
+// NewContext returns a copy of ctx carrying logger
+func NewContext(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext returns the logger of ctx, falling back to the default logger
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(contextKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// WithRequestID returns a copy of ctx carrying the default logger tagged with
+// the request ID
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return NewContext(ctx, slog.Default().With("request_id", id))
+}
+
+// RequestID returns the ID sent by a client, or a new random ID when the
+// client did not send a valid one
+func RequestID(id string) string {
+	if id != "" && len(id) <= maxRequestID {
+		return id
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// Request logs a served request with the logger of ctx, at the error level
+// for server errors and the warn level for client errors
+func Request(ctx context.Context, method, path string, status int, latency time.Duration, err error) {
+	level := slog.LevelInfo
+	switch {
+	case status >= 500:
+		level = slog.LevelError
+	case status >= 400:
+		level = slog.LevelWarn
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("path", path),
+		slog.Int("status", status),
+		slog.Duration("latency", latency),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	FromContext(ctx).LogAttrs(ctx, level, "request", attrs...)
+}
\ No newline at end of file
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-ozzo/ozzo-routing"
+	"github.com/go-ozzo/ozzo-routing/access"
+
+	"{{ .Module }}/logging"
+)
+
+// requestLogger logs each request, carrying a logger tagged with the request
+// ID within the request context for the handlers
+func requestLogger(c *routing.Context) error {
+	start := time.Now()
+	id := logging.RequestID(c.Request.Header.Get(logging.RequestIDHeader))
+	c.Response.Header().Set(logging.RequestIDHeader, id)
+
+	ctx := logging.WithRequestID(c.Request.Context(), id)
+	c.Request = c.Request.WithContext(ctx)
+
+	rw := &access.LogResponseWriter{ResponseWriter: c.Response, Status: http.StatusOK}
+	c.Response = rw
+
+	// respond with the error before logging its status
+	err := c.Next()
+	if err != nil {
+		status := http.StatusInternalServerError
+		if httpErr, ok := err.(routing.HTTPError); ok {
+			status = httpErr.StatusCode()
+		}
+		http.Error(rw, err.Error(), status)
+	}
+	logging.Request(ctx, c.Request.Method, c.Request.URL.Path, rw.Status, time.Since(start), err)
+	return nil
+}
\ No newline at end of file
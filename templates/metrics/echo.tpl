@@ -0,0 +1,42 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo"
+
+	"{{ .Module }}/metrics"
+)
+
+// recordMetrics records the count, duration, and in-flight requests of each
+// route template
+func recordMetrics() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			done := metrics.StartRequest()
+			err := next(c)
+
+			// the error is responded to once returned from the middleware
+			status := c.Response().Status
+			if err != nil {
+				status = http.StatusInternalServerError
+				if httpErr, ok := err.(*echo.HTTPError); ok {
+					status = httpErr.Code
+				}
+			}
+			done(c.Request().Method, routeTemplate(c), status)
+			return err
+		}
+	}
+}
+
+// routeTemplate returns the path of the route matching a request, or an empty
+// string when the request path itself stands in for an unrouted request
+func routeTemplate(c echo.Context) string {
+	for _, route := range c.Echo().Routes() {
+		if route.Path == c.Path() {
+			return route.Path
+		}
+	}
+	return ""
+}
\ No newline at end of file
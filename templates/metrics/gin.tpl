@@ -0,0 +1,17 @@
+package main
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"{{ .Module }}/metrics"
+)
+
+// recordMetrics records the count, duration, and in-flight requests of each
+// route template
+func recordMetrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		done := metrics.StartRequest()
+		c.Next()
+		done(c.Request.Method, c.FullPath(), c.Writer.Status())
+	}
+}
\ No newline at end of file
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"{{ .Module }}/metrics"
+)
+
+// unaryMetrics records the count, duration, and in-flight calls of each unary
+// method
+func unaryMetrics(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	done := metrics.StartCall()
+	resp, err := handler(ctx, req)
+	done(info.FullMethod, status.Code(err).String())
+	return resp, err
+}
+
+// streamMetrics records the count, duration, and in-flight calls of each
+// streaming method
+func streamMetrics(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	done := metrics.StartCall()
+	err := handler(srv, ss)
+	done(info.FullMethod, status.Code(err).String())
+	return err
+}
\ No newline at end of file
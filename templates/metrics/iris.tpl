@@ -0,0 +1,20 @@
+package main
+
+import (
+	"github.com/kataras/iris"
+
+	"{{ .Module }}/metrics"
+)
+
+// recordMetrics records the count, duration, and in-flight requests of each
+// route template
+func recordMetrics(ctx iris.Context) {
+	done := metrics.StartRequest()
+	ctx.Next()
+
+	var route string
+	if r := ctx.GetCurrentRoute(); r != nil {
+		route = r.Path()
+	}
+	done(ctx.Method(), route, ctx.GetStatusCode())
+}
\ No newline at end of file
@@ -0,0 +1,149 @@
+package metrics
+
+import (
+{{- if .Migrations }}
+	"database/sql"
+{{- end }}
+	"net"
+	"net/http"
+{{- if ne .App "grpc" }}
+	"strconv"
+{{- end }}
+{{- if .Migrations }}
+	"sync"
+{{- end }}
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Registry holds the metrics served by Handler, along with the Go runtime and
+// process metrics
+var Registry = prometheus.NewRegistry()
+{{ if eq .App "grpc" }}
+var (
+	calls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "Count of the served calls by method and code",
+	}, []string{"method", "code"})
+	duration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Duration of the served calls by method and code",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "code"})
+	inFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "grpc_server_in_flight",
+		Help: "Count of the calls being served",
+	})
+)
+{{- else }}
+// unmatched labels the requests not matching a route, which bounds the
+// values of the route label
+const unmatched = "unmatched"
+
+var (
+	requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Count of the served requests by route template and status",
+	}, []string{"method", "route", "status"})
+	duration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Duration of the served requests by route template and status",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+	inFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "http_requests_in_flight",
+		Help: "Count of the requests being served",
+	})
+)
+{{- end }}
+{{- if .Migrations }}
+
+var (
+	mu sync.Mutex
+	// pool collects the connection pool statistics of the database
+	pool prometheus.Collector
+)
+{{- end }}
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+{{- if eq .App "grpc" }}
+		calls,
+{{- else }}
+		requests,
+{{- end }}
+		duration,
+		inFlight,
+	)
+}
+{{ if eq .App "grpc" }}
+// StartCall counts a call as in flight, returning a func recording the call
+// to method once served with code
+func StartCall() func(method, code string) {
+	start := time.Now()
+	inFlight.Inc()
+	return func(method, code string) {
+		inFlight.Dec()
+		calls.WithLabelValues(method, code).Inc()
+		duration.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+	}
+}
+{{- else }}
+// StartRequest counts a request as in flight, returning a func recording the
+// request once served by the route template, e.g. /users/:id, with status
+func StartRequest() func(method, route string, status int) {
+	start := time.Now()
+	inFlight.Inc()
+	return func(method, route string, status int) {
+		inFlight.Dec()
+		if route == "" {
+			route = unmatched
+		}
+
+		code := strconv.Itoa(status)
+		requests.WithLabelValues(method, route, code).Inc()
+		duration.WithLabelValues(method, route, code).Observe(time.Since(start).Seconds())
+	}
+}
+{{- end }}
+{{- if .Migrations }}
+
+// RegisterDB collects the connection pool statistics of db, replacing the
+// database registered before
+func RegisterDB(db *sql.DB, name string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if pool != nil {
+		Registry.Unregister(pool)
+	}
+	pool = collectors.NewDBStatsCollector(db, name)
+	return Registry.Register(pool)
+}
+{{- end }}
+
+// Handler serves the metrics of Registry in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// Serve serves Handler on the /metrics path of addr in the background,
+// returning the server to shut down
+func Serve(addr string) (*http.Server, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go srv.Serve(lis)
+	return srv, nil
+}
\ No newline at end of file
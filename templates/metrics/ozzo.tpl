@@ -0,0 +1,47 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/go-ozzo/ozzo-routing"
+	"github.com/go-ozzo/ozzo-routing/access"
+
+	"{{ .Module }}/metrics"
+)
+
+// recordMetrics records the count, duration, and in-flight requests of each
+// route template
+func recordMetrics(c *routing.Context) error {
+	done := metrics.StartRequest()
+	rw := &access.LogResponseWriter{ResponseWriter: c.Response, Status: http.StatusOK}
+	c.Response = rw
+	err := c.Next()
+
+	// the error is responded to once returned from the middleware
+	status := rw.Status
+	if err != nil {
+		status = http.StatusInternalServerError
+		if httpErr, ok := err.(routing.HTTPError); ok {
+			status = httpErr.StatusCode()
+		}
+	}
+	done(c.Request.Method, routeTemplate(c), status)
+	return err
+}
+
+// routeTemplate finds the template of the route matching the request, e.g.
+// /users/<id>, which is empty when no route matches
+func routeTemplate(c *routing.Context) string {
+	_, params := c.Router().Find(c.Request.Method, c.Request.URL.Path)
+	pairs := make([]interface{}, 0, 2*len(params))
+	for name, value := range params {
+		pairs = append(pairs, name, value)
+	}
+
+	for _, route := range c.Router().Routes() {
+		if route.Method() == c.Request.Method && route.URL(pairs...) == c.Request.URL.Path {
+			return route.Path()
+		}
+	}
+	return ""
+}
\ No newline at end of file
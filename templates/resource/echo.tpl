@@ -0,0 +1,127 @@
+{{- $model := .Model.Name }}{{ $name := .Resource.Name }}{{ $key := .Model.AutoKey.Name -}}
+package main
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo"
+
+	"{{ .Module }}/sql"
+)
+
+// {{ $name }}Store reads and writes the {{ .Model.Table }} served by the {{ $name }} handlers
+type {{ $name }}Store interface {
+	List(ctx context.Context) ([]sql.{{ $model }}, error)
+	Get(ctx context.Context, id int64) (*sql.{{ $model }}, error)
+	Create(ctx context.Context, m *sql.{{ $model }}) error
+	Update(ctx context.Context, m *sql.{{ $model }}) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// {{ $name }}Handlers serves the {{ .Model.Table }} endpoints
+type {{ $name }}Handlers struct {
+	store {{ $name }}Store
+}
+
+// register{{ $model }}Routes registers the {{ .Model.Table }} endpoints with r
+func register{{ $model }}Routes(r *echo.Echo, store {{ $name }}Store) {
+	h := &{{ $name }}Handlers{store}
+	r.GET("{{ .Resource.Path }}", h.list)
+	r.POST("{{ .Resource.Path }}", h.create)
+	r.GET("{{ .Resource.Path }}/:id", h.get)
+	r.PUT("{{ .Resource.Path }}/:id", h.update)
+	r.DELETE("{{ .Resource.Path }}/:id", h.delete)
+}
+
+func (h *{{ $name }}Handlers) list(c echo.Context) error {
+	list, err := h.store.List(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, list)
+}
+
+func (h *{{ $name }}Handlers) get(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	m, err := h.store.Get(c.Request().Context(), id)
+	if errors.Is(err, stdsql.ErrNoRows) {
+		return echo.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, m)
+}
+
+func (h *{{ $name }}Handlers) create(c echo.Context) error {
+	var m sql.{{ $model }}
+	if err := c.Bind(&m); err != nil {
+		return err
+	}
+{{- if .Model.Validations }}
+
+	if err := m.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+{{- end }}
+
+	if err := h.store.Create(c.Request().Context(), &m); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, m)
+}
+
+func (h *{{ $name }}Handlers) update(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var m sql.{{ $model }}
+	if err := c.Bind(&m); err != nil {
+		return err
+	}
+{{- if .Model.Validations }}
+
+	if err := m.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+{{- end }}
+	m.{{ $key }} = id
+
+	if _, err := h.store.Get(c.Request().Context(), id); errors.Is(err, stdsql.ErrNoRows) {
+		return echo.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if err := h.store.Update(c.Request().Context(), &m); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, m)
+}
+
+func (h *{{ $name }}Handlers) delete(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if _, err := h.store.Get(c.Request().Context(), id); errors.Is(err, stdsql.ErrNoRows) {
+		return echo.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if err := h.store.Delete(c.Request().Context(), id); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
\ No newline at end of file
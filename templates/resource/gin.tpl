@@ -0,0 +1,168 @@
+{{- $model := .Model.Name }}{{ $name := .Resource.Name }}{{ $key := .Model.AutoKey.Name -}}
+package main
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"{{ .Module }}/sql"
+{{- if .Logging }}
+	"{{ .Module }}/logging"
+{{- end }}
+)
+
+// {{ $name }}Store reads and writes the {{ .Model.Table }} served by the {{ $name }} handlers
+type {{ $name }}Store interface {
+	List(ctx context.Context) ([]sql.{{ $model }}, error)
+	Get(ctx context.Context, id int64) (*sql.{{ $model }}, error)
+	Create(ctx context.Context, m *sql.{{ $model }}) error
+	Update(ctx context.Context, m *sql.{{ $model }}) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// {{ $name }}Handlers serves the {{ .Model.Table }} endpoints
+type {{ $name }}Handlers struct {
+	store {{ $name }}Store
+}
+
+// register{{ $model }}Routes registers the {{ .Model.Table }} endpoints with r
+func register{{ $model }}Routes(r gin.IRouter, store {{ $name }}Store) {
+	h := &{{ $name }}Handlers{store}
+	r.GET("{{ .Resource.Path }}", h.list)
+	r.POST("{{ .Resource.Path }}", h.create)
+	r.GET("{{ .Resource.Path }}/:id", h.get)
+	r.PUT("{{ .Resource.Path }}/:id", h.update)
+	r.DELETE("{{ .Resource.Path }}/:id", h.delete)
+}
+
+func (h *{{ $name }}Handlers) list(c *gin.Context) {
+	list, err := h.store.List(c.Request.Context())
+	if err != nil {
+{{- if .Logging }}
+		logging.FromContext(c.Request.Context()).Error("failed to list {{ .Model.Table }}", "error", err)
+{{- end }}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, list)
+}
+
+func (h *{{ $name }}Handlers) get(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	m, err := h.store.Get(c.Request.Context(), id)
+	if errors.Is(err, stdsql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+		return
+	} else if err != nil {
+{{- if .Logging }}
+		logging.FromContext(c.Request.Context()).Error("failed to get {{ .Model.Table }}", "error", err)
+{{- end }}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, m)
+}
+
+func (h *{{ $name }}Handlers) create(c *gin.Context) {
+	var m sql.{{ $model }}
+	if err := c.ShouldBindJSON(&m); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+{{- if .Model.Validations }}
+
+	if err := m.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+{{- end }}
+
+	if err := h.store.Create(c.Request.Context(), &m); err != nil {
+{{- if .Logging }}
+		logging.FromContext(c.Request.Context()).Error("failed to create {{ .Model.Table }}", "error", err)
+{{- end }}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, m)
+}
+
+func (h *{{ $name }}Handlers) update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var m sql.{{ $model }}
+	if err := c.ShouldBindJSON(&m); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+{{- if .Model.Validations }}
+
+	if err := m.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+{{- end }}
+	m.{{ $key }} = id
+
+	if _, err := h.store.Get(c.Request.Context(), id); errors.Is(err, stdsql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+		return
+	} else if err != nil {
+{{- if .Logging }}
+		logging.FromContext(c.Request.Context()).Error("failed to update {{ .Model.Table }}", "error", err)
+{{- end }}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.store.Update(c.Request.Context(), &m); err != nil {
+{{- if .Logging }}
+		logging.FromContext(c.Request.Context()).Error("failed to update {{ .Model.Table }}", "error", err)
+{{- end }}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, m)
+}
+
+func (h *{{ $name }}Handlers) delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	if _, err := h.store.Get(c.Request.Context(), id); errors.Is(err, stdsql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+		return
+	} else if err != nil {
+{{- if .Logging }}
+		logging.FromContext(c.Request.Context()).Error("failed to delete {{ .Model.Table }}", "error", err)
+{{- end }}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.store.Delete(c.Request.Context(), id); err != nil {
+{{- if .Logging }}
+		logging.FromContext(c.Request.Context()).Error("failed to delete {{ .Model.Table }}", "error", err)
+{{- end }}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
\ No newline at end of file
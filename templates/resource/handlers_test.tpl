@@ -0,0 +1,81 @@
+{{- $model := .Model.Name -}}
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+{{- if eq .Resource.Framework "gin" }}
+
+	"github.com/gin-gonic/gin"
+{{- else if eq .Resource.Framework "echo" }}
+
+	"github.com/labstack/echo"
+{{- else if eq .Resource.Framework "iris" }}
+
+	"github.com/kataras/iris"
+{{- else if eq .Resource.Framework "ozzo" }}
+
+	"github.com/go-ozzo/ozzo-routing"
+	"github.com/go-ozzo/ozzo-routing/content"
+{{- end }}
+
+	"{{ .Module }}/sql"
+)
+
+func Test{{ $model }}Handlers(t *testing.T) {
+	store := sql.NewFakeStore().{{ .Model.Plural }}
+{{- if eq .Resource.Framework "gin" }}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	register{{ $model }}Routes(r, store)
+{{- else if eq .Resource.Framework "echo" }}
+	r := echo.New()
+	register{{ $model }}Routes(r, store)
+{{- else if eq .Resource.Framework "iris" }}
+	r := iris.New()
+	register{{ $model }}Routes(r, store)
+	if err := r.Build(); err != nil {
+		t.Fatalf("failed to build the router: %s", err)
+	}
+{{- else if eq .Resource.Framework "ozzo" }}
+	r := routing.New()
+	r.Use(content.TypeNegotiator(content.JSON))
+	register{{ $model }}Routes(r, store)
+{{- else }}
+	r := http.NewServeMux()
+	register{{ $model }}Routes(r, store)
+{{- end }}
+
+	body := {{ printf "%q" (or .Model.Sample "{}") }}
+	tests := []struct {
+		Method string
+		Path   string
+		Body   string
+		Status int
+	}{
+		{http.MethodPost, "{{ .Resource.Path }}", body, http.StatusCreated},
+{{- if .Model.ZeroInvalid }}
+		{http.MethodPost, "{{ .Resource.Path }}", "{}", http.StatusBadRequest},
+{{- end }}
+		{http.MethodGet, "{{ .Resource.Path }}", "", http.StatusOK},
+		{http.MethodGet, "{{ .Resource.Path }}/1", "", http.StatusOK},
+		{http.MethodPut, "{{ .Resource.Path }}/1", body, http.StatusOK},
+		{http.MethodDelete, "{{ .Resource.Path }}/1", "", http.StatusNoContent},
+		{http.MethodGet, "{{ .Resource.Path }}/1", "", http.StatusNotFound},
+		{http.MethodPut, "{{ .Resource.Path }}/1", body, http.StatusNotFound},
+		{http.MethodGet, "{{ .Resource.Path }}/x", "", http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(test.Method, test.Path, strings.NewReader(test.Body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != test.Status {
+			t.Errorf("%s %s: expected status %d; actual %d: %s", test.Method, test.Path, test.Status, w.Code, w.Body)
+		}
+	}
+}
\ No newline at end of file
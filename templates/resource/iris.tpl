@@ -0,0 +1,174 @@
+{{- $model := .Model.Name }}{{ $name := .Resource.Name }}{{ $key := .Model.AutoKey.Name -}}
+package main
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/kataras/iris"
+
+	"{{ .Module }}/sql"
+{{- if .Logging }}
+	"{{ .Module }}/logging"
+{{- end }}
+)
+
+// {{ $name }}Store reads and writes the {{ .Model.Table }} served by the {{ $name }} handlers
+type {{ $name }}Store interface {
+	List(ctx context.Context) ([]sql.{{ $model }}, error)
+	Get(ctx context.Context, id int64) (*sql.{{ $model }}, error)
+	Create(ctx context.Context, m *sql.{{ $model }}) error
+	Update(ctx context.Context, m *sql.{{ $model }}) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// {{ $name }}Handlers serves the {{ .Model.Table }} endpoints
+type {{ $name }}Handlers struct {
+	store {{ $name }}Store
+}
+
+// register{{ $model }}Routes registers the {{ .Model.Table }} endpoints with app
+func register{{ $model }}Routes(app iris.Party, store {{ $name }}Store) {
+	h := &{{ $name }}Handlers{store}
+	app.Get("{{ .Resource.Path }}", h.list)
+	app.Post("{{ .Resource.Path }}", h.create)
+	app.Get("{{ .Resource.Path }}/{id}", h.get)
+	app.Put("{{ .Resource.Path }}/{id}", h.update)
+	app.Delete("{{ .Resource.Path }}/{id}", h.delete)
+}
+
+// fail responds with status and the error message
+func (h *{{ $name }}Handlers) fail(ctx iris.Context, status int, message string) {
+	ctx.StatusCode(status)
+	ctx.JSON(iris.Map{"error": message})
+}
+
+func (h *{{ $name }}Handlers) list(ctx iris.Context) {
+	list, err := h.store.List(ctx.Request().Context())
+	if err != nil {
+{{- if .Logging }}
+		logging.FromContext(ctx.Request().Context()).Error("failed to list {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.JSON(list)
+}
+
+func (h *{{ $name }}Handlers) get(ctx iris.Context) {
+	id, err := ctx.Params().GetInt64("id")
+	if err != nil {
+		h.fail(ctx, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	m, err := h.store.Get(ctx.Request().Context(), id)
+	if errors.Is(err, stdsql.ErrNoRows) {
+		h.fail(ctx, http.StatusNotFound, "not found")
+		return
+	} else if err != nil {
+{{- if .Logging }}
+		logging.FromContext(ctx.Request().Context()).Error("failed to get {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.JSON(m)
+}
+
+func (h *{{ $name }}Handlers) create(ctx iris.Context) {
+	var m sql.{{ $model }}
+	if err := ctx.ReadJSON(&m); err != nil {
+		h.fail(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+{{- if .Model.Validations }}
+
+	if err := m.Validate(); err != nil {
+		h.fail(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+{{- end }}
+
+	if err := h.store.Create(ctx.Request().Context(), &m); err != nil {
+{{- if .Logging }}
+		logging.FromContext(ctx.Request().Context()).Error("failed to create {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.StatusCode(http.StatusCreated)
+	ctx.JSON(m)
+}
+
+func (h *{{ $name }}Handlers) update(ctx iris.Context) {
+	id, err := ctx.Params().GetInt64("id")
+	if err != nil {
+		h.fail(ctx, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var m sql.{{ $model }}
+	if err := ctx.ReadJSON(&m); err != nil {
+		h.fail(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+{{- if .Model.Validations }}
+
+	if err := m.Validate(); err != nil {
+		h.fail(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+{{- end }}
+	m.{{ $key }} = id
+
+	if _, err := h.store.Get(ctx.Request().Context(), id); errors.Is(err, stdsql.ErrNoRows) {
+		h.fail(ctx, http.StatusNotFound, "not found")
+		return
+	} else if err != nil {
+{{- if .Logging }}
+		logging.FromContext(ctx.Request().Context()).Error("failed to update {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if err := h.store.Update(ctx.Request().Context(), &m); err != nil {
+{{- if .Logging }}
+		logging.FromContext(ctx.Request().Context()).Error("failed to update {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.JSON(m)
+}
+
+func (h *{{ $name }}Handlers) delete(ctx iris.Context) {
+	id, err := ctx.Params().GetInt64("id")
+	if err != nil {
+		h.fail(ctx, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if _, err := h.store.Get(ctx.Request().Context(), id); errors.Is(err, stdsql.ErrNoRows) {
+		h.fail(ctx, http.StatusNotFound, "not found")
+		return
+	} else if err != nil {
+{{- if .Logging }}
+		logging.FromContext(ctx.Request().Context()).Error("failed to delete {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if err := h.store.Delete(ctx.Request().Context(), id); err != nil {
+{{- if .Logging }}
+		logging.FromContext(ctx.Request().Context()).Error("failed to delete {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.StatusCode(http.StatusNoContent)
+}
\ No newline at end of file
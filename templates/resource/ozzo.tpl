@@ -0,0 +1,130 @@
+{{- $model := .Model.Name }}{{ $name := .Resource.Name }}{{ $key := .Model.AutoKey.Name -}}
+package main
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-ozzo/ozzo-routing"
+
+	"{{ .Module }}/sql"
+)
+
+// {{ $name }}Store reads and writes the {{ .Model.Table }} served by the {{ $name }} handlers
+type {{ $name }}Store interface {
+	List(ctx context.Context) ([]sql.{{ $model }}, error)
+	Get(ctx context.Context, id int64) (*sql.{{ $model }}, error)
+	Create(ctx context.Context, m *sql.{{ $model }}) error
+	Update(ctx context.Context, m *sql.{{ $model }}) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// {{ $name }}Handlers serves the {{ .Model.Table }} endpoints; responses are
+// written using the content type negotiated by the router
+type {{ $name }}Handlers struct {
+	store {{ $name }}Store
+}
+
+// register{{ $model }}Routes registers the {{ .Model.Table }} endpoints with r
+func register{{ $model }}Routes(r *routing.Router, store {{ $name }}Store) {
+	h := &{{ $name }}Handlers{store}
+	r.Get("{{ .Resource.Path }}", h.list)
+	r.Post("{{ .Resource.Path }}", h.create)
+	r.Get("{{ .Resource.Path }}/<id>", h.get)
+	r.Put("{{ .Resource.Path }}/<id>", h.update)
+	r.Delete("{{ .Resource.Path }}/<id>", h.delete)
+}
+
+func (h *{{ $name }}Handlers) list(c *routing.Context) error {
+	list, err := h.store.List(c.Request.Context())
+	if err != nil {
+		return err
+	}
+	return c.Write(list)
+}
+
+func (h *{{ $name }}Handlers) get(c *routing.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return routing.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	m, err := h.store.Get(c.Request.Context(), id)
+	if errors.Is(err, stdsql.ErrNoRows) {
+		return routing.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return err
+	}
+	return c.Write(m)
+}
+
+func (h *{{ $name }}Handlers) create(c *routing.Context) error {
+	var m sql.{{ $model }}
+	if err := c.Read(&m); err != nil {
+		return routing.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+{{- if .Model.Validations }}
+
+	if err := m.Validate(); err != nil {
+		return routing.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+{{- end }}
+
+	if err := h.store.Create(c.Request.Context(), &m); err != nil {
+		return err
+	}
+	c.Response.WriteHeader(http.StatusCreated)
+	return c.Write(m)
+}
+
+func (h *{{ $name }}Handlers) update(c *routing.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return routing.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var m sql.{{ $model }}
+	if err := c.Read(&m); err != nil {
+		return routing.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+{{- if .Model.Validations }}
+
+	if err := m.Validate(); err != nil {
+		return routing.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+{{- end }}
+	m.{{ $key }} = id
+
+	if _, err := h.store.Get(c.Request.Context(), id); errors.Is(err, stdsql.ErrNoRows) {
+		return routing.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return err
+	}
+
+	if err := h.store.Update(c.Request.Context(), &m); err != nil {
+		return err
+	}
+	return c.Write(m)
+}
+
+func (h *{{ $name }}Handlers) delete(c *routing.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return routing.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if _, err := h.store.Get(c.Request.Context(), id); errors.Is(err, stdsql.ErrNoRows) {
+		return routing.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return err
+	}
+
+	if err := h.store.Delete(c.Request.Context(), id); err != nil {
+		return err
+	}
+	c.Response.WriteHeader(http.StatusNoContent)
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,179 @@
+{{- $model := .Model.Name }}{{ $name := .Resource.Name }}{{ $key := .Model.AutoKey.Name -}}
+package main
+
+import (
+	"context"
+	stdsql "database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"{{ .Module }}/sql"
+{{- if .Logging }}
+	"{{ .Module }}/logging"
+{{- end }}
+)
+
+// {{ $name }}Store reads and writes the {{ .Model.Table }} served by the {{ $name }} handlers
+type {{ $name }}Store interface {
+	List(ctx context.Context) ([]sql.{{ $model }}, error)
+	Get(ctx context.Context, id int64) (*sql.{{ $model }}, error)
+	Create(ctx context.Context, m *sql.{{ $model }}) error
+	Update(ctx context.Context, m *sql.{{ $model }}) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// {{ $name }}Handlers serves the {{ .Model.Table }} endpoints
+type {{ $name }}Handlers struct {
+	store {{ $name }}Store
+}
+
+// register{{ $model }}Routes registers the {{ .Model.Table }} endpoints with mux
+func register{{ $model }}Routes(mux *http.ServeMux, store {{ $name }}Store) {
+	h := &{{ $name }}Handlers{store}
+	mux.HandleFunc("GET {{ .Resource.Path }}", h.list)
+	mux.HandleFunc("POST {{ .Resource.Path }}", h.create)
+	mux.HandleFunc("GET {{ .Resource.Path }}/{id}", h.get)
+	mux.HandleFunc("PUT {{ .Resource.Path }}/{id}", h.update)
+	mux.HandleFunc("DELETE {{ .Resource.Path }}/{id}", h.delete)
+}
+
+// respond writes v as the JSON body of the response
+func (h *{{ $name }}Handlers) respond(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// fail responds with status and the error message
+func (h *{{ $name }}Handlers) fail(w http.ResponseWriter, status int, message string) {
+	h.respond(w, status, map[string]string{"error": message})
+}
+
+func (h *{{ $name }}Handlers) list(w http.ResponseWriter, r *http.Request) {
+	list, err := h.store.List(r.Context())
+	if err != nil {
+{{- if .Logging }}
+		logging.FromContext(r.Context()).Error("failed to list {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	h.respond(w, http.StatusOK, list)
+}
+
+func (h *{{ $name }}Handlers) get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.fail(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	m, err := h.store.Get(r.Context(), id)
+	if errors.Is(err, stdsql.ErrNoRows) {
+		h.fail(w, http.StatusNotFound, "not found")
+		return
+	} else if err != nil {
+{{- if .Logging }}
+		logging.FromContext(r.Context()).Error("failed to get {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	h.respond(w, http.StatusOK, m)
+}
+
+func (h *{{ $name }}Handlers) create(w http.ResponseWriter, r *http.Request) {
+	var m sql.{{ $model }}
+	if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+		h.fail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+{{- if .Model.Validations }}
+
+	if err := m.Validate(); err != nil {
+		h.fail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+{{- end }}
+
+	if err := h.store.Create(r.Context(), &m); err != nil {
+{{- if .Logging }}
+		logging.FromContext(r.Context()).Error("failed to create {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	h.respond(w, http.StatusCreated, m)
+}
+
+func (h *{{ $name }}Handlers) update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.fail(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var m sql.{{ $model }}
+	if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+		h.fail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+{{- if .Model.Validations }}
+
+	if err := m.Validate(); err != nil {
+		h.fail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+{{- end }}
+	m.{{ $key }} = id
+
+	if _, err := h.store.Get(r.Context(), id); errors.Is(err, stdsql.ErrNoRows) {
+		h.fail(w, http.StatusNotFound, "not found")
+		return
+	} else if err != nil {
+{{- if .Logging }}
+		logging.FromContext(r.Context()).Error("failed to update {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if err := h.store.Update(r.Context(), &m); err != nil {
+{{- if .Logging }}
+		logging.FromContext(r.Context()).Error("failed to update {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	h.respond(w, http.StatusOK, m)
+}
+
+func (h *{{ $name }}Handlers) delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		h.fail(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if _, err := h.store.Get(r.Context(), id); errors.Is(err, stdsql.ErrNoRows) {
+		h.fail(w, http.StatusNotFound, "not found")
+		return
+	} else if err != nil {
+{{- if .Logging }}
+		logging.FromContext(r.Context()).Error("failed to delete {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if err := h.store.Delete(r.Context(), id); err != nil {
+{{- if .Logging }}
+		logging.FromContext(r.Context()).Error("failed to delete {{ .Model.Table }}", "error", err)
+{{- end }}
+		h.fail(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
\ No newline at end of file
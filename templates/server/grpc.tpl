@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	// connectionTimeout bounds the handshake of a new connection
+	connectionTimeout = 5 * time.Second
+	// idleTimeout bounds keeping a connection without active calls open
+	idleTimeout = 120 * time.Second
+)
+
+// shutdownTimeout bounds draining the in-flight calls on shutdown
+var shutdownTimeout = 15 * time.Second
+
+// shutdownHooks run in reverse order of registration on shutdown
+var shutdownHooks []func(ctx context.Context) error
+
+// onShutdown registers hook to run once the server is drained, e.g. to
+// flush telemetry
+func onShutdown(hook func(ctx context.Context) error) {
+	shutdownHooks = append(shutdownHooks, hook)
+}
+
+// onClose registers close to run once the server is drained, e.g. to close
+// the database
+func onClose(close func() error) {
+	onShutdown(func(context.Context) error {
+		return close()
+	})
+}
+
+// serverOptions closes the idle and stalled connections of a server, and
+// installs the interceptors and stats handlers of its calls
+func serverOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ConnectionTimeout(connectionTimeout),
+		grpc.KeepaliveParams(keepalive.ServerParameters{MaxConnectionIdle: idleTimeout}),
+{{- if .Logging }}
+		grpc.ChainUnaryInterceptor(unaryLogger),
+		grpc.ChainStreamInterceptor(streamLogger),
+{{- end }}
+{{- if .Metrics }}
+		grpc.ChainUnaryInterceptor(unaryMetrics),
+		grpc.ChainStreamInterceptor(streamMetrics),
+{{- end }}
+{{- if .Tracing }}
+		traceCalls(),
+{{- end }}
+	}
+}
+
+// serve runs srv on addr until SIGINT or SIGTERM is received, then drains
+// the in-flight calls within shutdownTimeout and runs the shutdown hooks,
+// returning an error when srv fails to listen
+func serve(srv *grpc.Server, addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return errors.Join(err, shutdown(context.Background()))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- srv.Serve(lis)
+	}()
+
+	select {
+	case err = <-errs:
+	case <-ctx.Done():
+		// a second signal terminates immediately
+		stop()
+		log.Println("shutting down...")
+
+		drained := make(chan struct{})
+		go func() {
+			srv.GracefulStop()
+			close(drained)
+		}()
+
+		select {
+		case <-drained:
+		case <-time.After(shutdownTimeout):
+			srv.Stop()
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return errors.Join(err, shutdown(ctx))
+}
+
+// shutdown runs the shutdown hooks in reverse order of registration
+func shutdown(ctx context.Context) error {
+	var errs []error
+	for i := len(shutdownHooks) - 1; i >= 0; i-- {
+		errs = append(errs, shutdownHooks[i](ctx))
+	}
+	return errors.Join(errs...)
+}
\ No newline at end of file
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+const (
+	// readHeaderTimeout bounds reading the headers of a request
+	readHeaderTimeout = 5 * time.Second
+	// readTimeout bounds reading a whole request, including its body
+	readTimeout = 30 * time.Second
+	// writeTimeout bounds writing a response
+	writeTimeout = 30 * time.Second
+	// idleTimeout bounds waiting for the next request of a keep-alive
+	// connection
+	idleTimeout = 120 * time.Second
+)
+
+// shutdownTimeout bounds draining the in-flight requests on shutdown
+var shutdownTimeout = 15 * time.Second
+
+// shutdownHooks run in reverse order of registration on shutdown
+var shutdownHooks []func(ctx context.Context) error
+
+// onShutdown registers hook to run once the server is drained, e.g. to
+// flush telemetry
+func onShutdown(hook func(ctx context.Context) error) {
+	shutdownHooks = append(shutdownHooks, hook)
+}
+
+// onClose registers close to run once the server is drained, e.g. to close
+// the database
+func onClose(close func() error) {
+	onShutdown(func(context.Context) error {
+		return close()
+	})
+}
+
+// newServer creates a server of handler listening on addr
+func newServer(addr string, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       readTimeout,
+		WriteTimeout:      writeTimeout,
+		IdleTimeout:       idleTimeout,
+	}
+}
+
+// serve runs srv until SIGINT or SIGTERM is received, then drains the
+// in-flight requests within shutdownTimeout and runs the shutdown hooks,
+// returning an error when srv fails to listen or shut down
+func serve(srv *http.Server) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- srv.ListenAndServe()
+	}()
+
+	var err error
+	select {
+	case err = <-errs:
+	case <-ctx.Done():
+		// a second signal terminates immediately
+		stop()
+		log.Println("shutting down...")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err == nil {
+		err = srv.Shutdown(ctx)
+	}
+	return errors.Join(err, shutdown(ctx))
+}
+
+// shutdown runs the shutdown hooks in reverse order of registration
+func shutdown(ctx context.Context) error {
+	var errs []error
+	for i := len(shutdownHooks) - 1; i >= 0; i-- {
+		errs = append(errs, shutdownHooks[i](ctx))
+	}
+	return errors.Join(errs...)
+}
\ No newline at end of file
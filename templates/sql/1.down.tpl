@@ -1,4 +1,3 @@
 -- Place SQL statements that are used to revert the database schema migration
--- within this file. Subsequent schema migration rollbacks should be placed
--- within a n.down.sql file, where n increments a sequence number beginning
--- with 1.
\ No newline at end of file
+-- within this file. Subsequent schema migration rollbacks are placed within
+-- the down file created alongside each `conseil migration new <name>`.
\ No newline at end of file
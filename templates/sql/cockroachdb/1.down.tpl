@@ -0,0 +1,5 @@
+-- Place SQL statements that are used to revert the database schema migration
+-- within this file. Subsequent schema migration rollbacks are placed within
+-- the down file created alongside each `conseil migration new <name>`.
+--
+-- DROP TABLE IF EXISTS example;
\ No newline at end of file
@@ -0,0 +1,12 @@
+-- Place SQL statements that are used to initialize the database schema
+-- within this file. Subsequent schema migrations should be created using
+-- `conseil migration new <name>`.
+--
+-- CockroachDB applies schema changes as online background jobs and does
+-- not allow DDL and DML to be mixed within the same transaction. Prefer
+-- UUID primary keys over sequences to avoid write hot spots.
+--
+-- CREATE TABLE IF NOT EXISTS example (
+--     id UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+--     created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+-- );
\ No newline at end of file
@@ -0,0 +1,9 @@
+package sql
+
+import "database/sql"
+
+// DB returns the database opened by Open for use with the generated
+// repositories
+func DB() *sql.DB {
+	return db
+}
\ No newline at end of file
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// {{ .Model.Name }} holds the ent schema of the {{ .Model.Table }} table created by the
+// migrations
+type {{ .Model.Name }} struct {
+	ent.Schema
+}
+
+// Annotations maps {{ .Model.Name }} to the {{ .Model.Table }} table
+func ({{ .Model.Name }}) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: {{ printf "%q" .Model.Table }}},
+	}
+}
+
+// Fields of {{ .Model.Name }}
+func ({{ .Model.Name }}) Fields() []ent.Field {
+	return []ent.Field{
+{{- range .Model.Fields }}
+		{{ .EntField }},
+{{- end }}
+	}
+}
\ No newline at end of file
@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(up{{ .Version }}, down{{ .Version }})
+}
+
+// up{{ .Version }} applies the {{ .Name }} schema migration
+func up{{ .Version }}(ctx context.Context, tx *sql.Tx) error {
+	return nil
+}
+
+// down{{ .Version }} reverts the {{ .Name }} schema migration
+func down{{ .Version }}(ctx context.Context, tx *sql.Tx) error {
+	return nil
+}
\ No newline at end of file
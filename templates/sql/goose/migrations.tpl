@@ -0,0 +1,106 @@
+package sql
+
+import (
+	"context"
+{{- if .Embed }}
+	"embed"
+{{- end }}
+	"errors"
+	"fmt"
+	"io/fs"
+{{- if not .Embed }}
+	"os"
+{{- end }}
+	"strconv"
+
+	"github.com/pressly/goose/v3"
+
+	// Go migrations register themselves with goose when imported
+	_ "{{ .Module }}/sql/migrations"
+)
+{{ if .Embed }}
+// migrationFS holds the SQL migrations compiled into the binary
+//
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+{{- else }}
+var Migrations = "migrations"
+{{- end }}
+
+// RunMigrations performs any required database migrations
+func RunMigrations() error {
+	provider, err := newProvider()
+	if err != nil {
+		return err
+	}
+
+	_, err = provider.Up(context.Background())
+	return err
+}
+
+// Migrate runs a migration subcommand: up, down [n], or version
+func Migrate(args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: migrate up|down [n]|version")
+	}
+
+	if err := Open(); err != nil {
+		return err
+	}
+	defer Close()
+
+	provider, err := newProvider()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		_, err = provider.Up(ctx)
+	case "down":
+		n := 1
+		if len(args) > 1 {
+			if n, err = strconv.Atoi(args[1]); err != nil || n < 1 {
+				return fmt.Errorf("invalid number of migrations: %s", args[1])
+			}
+		}
+		for i := 0; i < n && err == nil; i++ {
+			_, err = provider.Down(ctx)
+		}
+	case "version":
+		version, err := provider.GetDBVersion(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("version %d\n", version)
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command: %s", args[0])
+	}
+
+	if errors.Is(err, goose.ErrNoNextVersion) {
+		return nil
+	}
+	return err
+}
+
+func newProvider() (*goose.Provider, error) {
+	if db == nil {
+		return nil, errors.New("database not initialized")
+	}
+
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+{{ if .Embed }}
+	migrations, err := fs.Sub(migrationFS, "migrations")
+	if err != nil {
+		return nil, err
+	}
+{{- else }}
+	var migrations fs.FS = os.DirFS(Migrations)
+{{- end }}
+
+	return goose.NewProvider(goose.Dialect("{{ .Dialect }}"), db, migrations)
+}
\ No newline at end of file
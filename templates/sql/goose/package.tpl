@@ -0,0 +1,4 @@
+// Package migrations holds the goose Go migrations, which register themselves
+// when the package is imported by the sql package. The SQL migrations within
+// this directory are loaded by the sql package directly.
+package migrations
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- Place SQL statements that are used to apply the {{ .Name }} schema
+-- migration within this file.
\ No newline at end of file
@@ -1,40 +1,186 @@
 package sql
 
 import (
+{{- if .Embed }}
+	"embed"
 	"errors"
 	"fmt"
+	"strconv"
 
 	migrate "github.com/golang-migrate/migrate/v4"
-	"github.com/golang-migrate/migrate/v4/database/{{ .Driver }}"
+	dbdriver "{{ .Migrate }}"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+{{- else }}
+	"errors"
+	"fmt"
+	"strconv"
+
+	migrate "github.com/golang-migrate/migrate/v4"
+	dbdriver "{{ .Migrate }}"
+	"github.com/golang-migrate/migrate/v4/source"
 
 	// file driver
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+{{- end }}
 )
-
+{{ if .Embed }}
+// migrationFS holds the migrations compiled into the binary
+//
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+{{- else }}
 var Migrations = "migrations"
+{{- end }}
+
+// baselineName identifies the migration squashing all prior migrations
+const baselineName = "baseline"
 
 // RunMigrations performs any required database migrations
 func RunMigrations() error {
-	if db == nil {
-		return errors.New("database not initialized")
+	m, err := newMigrate()
+	if err != nil {
+		return err
 	}
 
-	if err := db.Ping(); err != nil {
+	if err := checkBaseline(m); err != nil {
+		return err
+	}
+
+	if err := m.Up(); err != nil {
+		if err == migrate.ErrNoChange {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// Migrate runs a migration subcommand: up, down [n], version, or force <version>
+func Migrate(args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: migrate up|down [n]|version|force <version>")
+	}
+
+	if err := Open(); err != nil {
 		return err
 	}
+	defer Close()
 
-	migrationPath := fmt.Sprintf("file://%s", Migrations)
-	driver, err := {{ .Driver }}.WithInstance(db, &{{ .Driver }}.Config{})
-	m, err := migrate.NewWithDatabaseInstance(migrationPath, dbType, driver)
+	m, err := newMigrate()
 	if err != nil {
 		return err
 	}
 
-	if err := m.Up(); err != nil {
-		if err == migrate.ErrNoChange {
+	switch args[0] {
+	case "up":
+		if err := checkBaseline(m); err != nil {
+			return err
+		}
+		err = m.Up()
+	case "down":
+		n := 1
+		if len(args) > 1 {
+			if n, err = strconv.Atoi(args[1]); err != nil || n < 1 {
+				return fmt.Errorf("invalid number of migrations: %s", args[1])
+			}
+		}
+		err = m.Steps(-n)
+	case "version":
+		version, dirty, err := m.Version()
+		if err == migrate.ErrNilVersion {
+			fmt.Println("no migrations applied")
 			return nil
+		} else if err != nil {
+			return err
+		}
+		fmt.Printf("version %d (dirty: %t)\n", version, dirty)
+		return nil
+	case "force":
+		if len(args) < 2 {
+			return errors.New("usage: migrate force <version>")
 		}
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid migration version: %s", args[1])
+		}
+		return m.Force(version)
+	default:
+		return fmt.Errorf("unknown migrate command: %s", args[0])
+	}
+
+	if err == migrate.ErrNoChange {
+		return nil
+	}
+	return err
+}
+
+func newMigrate() (*migrate.Migrate, error) {
+	if db == nil {
+		return nil, errors.New("database not initialized")
+	}
+
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+
+	src, err := newSource()
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := dbdriver.WithInstance(db, &dbdriver.Config{})
+	if err != nil {
+		src.Close()
+		return nil, err
+	}
+
+	return migrate.NewWithInstance("{{ if .Embed }}iofs{{ else }}file{{ end }}", src, "{{ .Driver }}", driver)
+}
+
+// newSource opens the migration files{{ if .Embed }} compiled into the binary{{ end }}
+func newSource() (source.Driver, error) {
+{{- if .Embed }}
+	return iofs.New(migrationFS, "migrations")
+{{- else }}
+	return source.Open(fmt.Sprintf("file://%s", Migrations))
+{{- end }}
+}
+
+// checkBaseline refuses to migrate a database that was partially migrated
+// before the migrations were squashed into a baseline, which is always the
+// first migration; databases at or beyond the baseline skip it as usual
+func checkBaseline(m *migrate.Migrate) error {
+	src, err := newSource()
+	if err != nil {
 		return err
 	}
+	defer src.Close()
+
+	baseline, err := src.First()
+	if err != nil {
+		return err
+	}
+
+	r, identifier, err := src.ReadUp(baseline)
+	if err != nil {
+		return err
+	}
+	r.Close()
+
+	if identifier != baselineName {
+		return nil
+	}
+
+	version, _, err := m.Version()
+	if err == migrate.ErrNilVersion {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if version < baseline {
+		return fmt.Errorf("database version %d predates the baseline %d; apply the archived migrations first", version, baseline)
+	}
 	return nil
 }
\ No newline at end of file
@@ -0,0 +1,169 @@
+package sql
+
+import (
+{{- if .Seeds }}
+	"context"
+{{- end }}
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+{{- if .Throwaway }}
+	"path/filepath"
+{{- end }}
+	"strings"
+	"testing"
+
+	migrate "github.com/golang-migrate/migrate/v4"
+)
+
+// schemaQuery describes the tables, columns and indexes created by the
+// migrations
+const schemaQuery = `{{ .Schema }}`
+
+// TestMigrations applies each migration up, down, and up again, verifying
+// that every down migration exactly reverses its up migration. It runs against
+// the empty database named by TEST_DATABASE_URL{{ if .Throwaway }}, or a throwaway database
+// when unset{{ else }} and is skipped when unset{{ end }}.
+func TestMigrations(t *testing.T) {
+	openTestDB(t)
+	defer Close()
+
+	m, err := newMigrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := m.Version(); err != migrate.ErrNilVersion {
+		t.Fatalf("expected an empty database: %v", err)
+	}
+
+	initial := dumpSchema(t)
+	before := initial
+	for {
+		err := m.Steps(1)
+		if errors.Is(err, os.ErrNotExist) {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+
+		version, _, _ := m.Version()
+		after := dumpSchema(t)
+
+		if err := m.Steps(-1); err != nil {
+			t.Fatalf("rolling back migration %d: %v", version, err)
+		}
+		expectSchema(t, before, fmt.Sprintf("rolling back migration %d", version))
+
+		if err := m.Steps(1); err != nil {
+			t.Fatalf("reapplying migration %d: %v", version, err)
+		}
+		expectSchema(t, after, fmt.Sprintf("reapplying migration %d", version))
+		before = after
+	}
+
+	if err := m.Down(); err != nil && err != migrate.ErrNoChange {
+		t.Fatal(err)
+	}
+	expectSchema(t, initial, "rolling back all migrations")
+
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		t.Fatal(err)
+	}
+	expectSchema(t, before, "reapplying all migrations")
+}
+
+{{- if .Seeds }}
+
+// TestSeeds applies the migrations and the test seed files twice, verifying
+// that seeding is idempotent. Other tests can load the same fixtures using
+// SeedDB.
+func TestSeeds(t *testing.T) {
+	openTestDB(t)
+	defer Close()
+
+	m, err := newMigrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := SeedDB(context.Background(), db, "test"); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+{{- end }}
+
+// openTestDB connects to the database named by TEST_DATABASE_URL{{ if .Throwaway }}, falling
+// back to a throwaway database{{ else }}, skipping the
+// test when unset{{ end }}
+func openTestDB(t *testing.T) {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+{{- if .Throwaway }}
+		dsn = "file:" + filepath.Join(t.TempDir(), "test.sqlite")
+{{- else }}
+		t.Skip("TEST_DATABASE_URL is not set")
+{{- end }}
+	}
+
+	var err error
+	if db, err = sql.Open("{{ .Driver }}", dsn); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// expectSchema fails the test when the schema differs from the expected dump
+func expectSchema(t *testing.T, expected, step string) {
+	t.Helper()
+	if actual := dumpSchema(t); actual != expected {
+		t.Fatalf("schema mismatch after %s\nexpected:\n%s\nactual:\n%s", step, expected, actual)
+	}
+}
+
+// dumpSchema describes the database schema as one line per row of schemaQuery
+func dumpSchema(t *testing.T) string {
+	t.Helper()
+	rows, err := db.Query(schemaQuery)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := make([]sql.NullString, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	var dump strings.Builder
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			t.Fatal(err)
+		}
+
+		fields := make([]string, len(values))
+		for i, v := range values {
+			fields[i] = strings.Join(strings.Fields(v.String), " ")
+		}
+		dump.WriteString(strings.Join(fields, " | "))
+		dump.WriteString("\n")
+	}
+
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return dump.String()
+}
\ No newline at end of file
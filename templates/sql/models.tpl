@@ -0,0 +1,38 @@
+package sql
+{{- if .Imports }}
+
+import (
+{{- range .Imports }}
+	"{{ . }}"
+{{- end }}
+)
+{{- end }}
+{{ range .Models }}
+{{ template "model" . }}
+{{ end -}}
+{{- define "model" -}}
+// {{ .Name }} is a row of the {{ .Table }} table
+type {{ .Name }} struct {
+{{- range .Fields }}
+	{{ .Name }} {{ .Type }} `{{ .Tag }}`{{ if .Comment }} // {{ .Comment }}{{ end }}
+{{- end }}
+}
+{{- range .Validations }}
+{{- if .Pattern }}
+
+var {{ .PatternVar }} = regexp.MustCompile({{ printf "%q" .Pattern }})
+{{- end }}
+{{- end }}
+{{- if .Validations }}
+
+// Validate checks the fields of m before it is written
+func (m *{{ .Name }}) Validate() error {
+{{- range .Validations }}
+	if {{ .Check }} {
+		return errors.New({{ printf "%q" .Message }})
+	}
+{{- end }}
+	return nil
+}
+{{- end }}
+{{- end -}}
\ No newline at end of file
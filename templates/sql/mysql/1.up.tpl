@@ -0,0 +1,11 @@
+-- Place SQL statements that are used to initialize the database schema
+-- within this file. Subsequent schema migrations should be created using
+-- `conseil migration new <name>`.
+--
+-- MySQL does not support transactional DDL, so keep each migration small
+-- enough to be repaired by hand should a statement fail part way through.
+--
+-- CREATE TABLE IF NOT EXISTS example (
+--     id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+--     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+-- ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
\ No newline at end of file
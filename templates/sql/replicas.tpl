@@ -0,0 +1,238 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// primaryEnv overrides the connection string of the primary database
+	primaryEnv = "DATABASE_URL"
+	// replicasEnv lists the comma separated connection strings of the replicas
+	replicasEnv = "DATABASE_REPLICA_URLS"
+	// healthInterval is the period between the health checks of the replicas
+	healthInterval = 10 * time.Second
+)
+
+var (
+	// readStatement matches the statements routed to the replicas
+	readStatement = regexp.MustCompile(`(?is)^\s*SELECT\b`)
+	// lockingRead matches the reads that must run on the primary
+	lockingRead = regexp.MustCompile(`(?i)\bFOR\s+(UPDATE|SHARE|NO\s+KEY\s+UPDATE|KEY\s+SHARE)\b`)
+	unsafeEnv   = regexp.MustCompile(`[^A-Z0-9]+`)
+)
+
+// replica is a read-only connection along with the result of its last
+// health check
+type replica struct {
+	db      *sql.DB
+	healthy atomic.Bool
+}
+
+var (
+	replicas []*replica
+	next     atomic.Uint64
+	stop     chan struct{}
+
+	databases   = make(map[string]*sql.DB)
+	databasesMu sync.Mutex
+)
+
+// Router sends read-only queries and transactions to a healthy replica and
+// every other statement to the primary
+type Router struct{}
+
+// Routed returns the router of the databases opened by Open
+func Routed() Router {
+	return Router{}
+}
+
+type primaryKey struct{}
+
+// WithPrimary routes the queries of ctx to the primary, e.g. to read the
+// rows written by the same request
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// reader selects the database serving query
+func (Router) reader(ctx context.Context, query string) *sql.DB {
+	if ctx.Value(primaryKey{}) != nil || !readStatement.MatchString(query) || lockingRead.MatchString(query) {
+		return db
+	}
+	return Replica()
+}
+
+// QueryContext runs query on a replica when it is read-only
+func (r Router) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return r.reader(ctx, query).QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext runs query on a replica when it is read-only
+func (r Router) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return r.reader(ctx, query).QueryRowContext(ctx, query, args...)
+}
+
+// ExecContext runs query on the primary
+func (Router) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return db.ExecContext(ctx, query, args...)
+}
+
+// PrepareContext prepares query on the primary
+func (Router) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return db.PrepareContext(ctx, query)
+}
+
+// BeginTx starts a transaction on a replica when opts is read-only, or on
+// the primary otherwise
+func (Router) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	if opts != nil && opts.ReadOnly && ctx.Value(primaryKey{}) == nil {
+		return Replica().BeginTx(ctx, opts)
+	}
+	return db.BeginTx(ctx, opts)
+}
+
+// Primary returns the primary database opened by Open, which serves every
+// write
+func Primary() *sql.DB {
+	return db
+}
+
+// Replica returns the next healthy replica in round-robin order, falling
+// back to the primary when there are none
+func Replica() *sql.DB {
+	for range replicas {
+		r := replicas[next.Add(1)%uint64(len(replicas))]
+		if r.healthy.Load() {
+			return r.db
+		}
+	}
+	return db
+}
+
+// Database returns the named database connected using the connection
+// string of DATABASE_<NAME>_URL, opening it on first use
+func Database(name string) (*sql.DB, error) {
+	databasesMu.Lock()
+	defer databasesMu.Unlock()
+
+	if conn, ok := databases[name]; ok {
+		return conn, nil
+	}
+
+	key := "DATABASE_" + strings.Trim(unsafeEnv.ReplaceAllString(strings.ToUpper(name), "_"), "_") + "_URL"
+	dsn := os.Getenv(key)
+	if dsn == "" {
+		return nil, fmt.Errorf("the %s database is not configured; set %s", name, key)
+	}
+
+	conn, err := {{ if .Tracing }}open(dsn){{ else }}sql.Open("{{ .Driver }}", dsn){{ end }}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.Ping(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	databases[name] = conn
+	return conn, nil
+}
+
+// Health pings the primary, each replica, and the opened named databases,
+// reporting the error of each connection keyed by its name
+func Health(ctx context.Context) map[string]error {
+	health := map[string]error{"primary": db.PingContext(ctx)}
+	for i, r := range replicas {
+		err := r.db.PingContext(ctx)
+		r.healthy.Store(err == nil)
+		health["replica-"+strconv.Itoa(i+1)] = err
+	}
+
+	databasesMu.Lock()
+	defer databasesMu.Unlock()
+	for name, conn := range databases {
+		health[name] = conn.PingContext(ctx)
+	}
+	return health
+}
+
+// primaryURL reads the connection string of the primary, falling back to
+// the generated default
+func primaryURL() string {
+	if dsn := os.Getenv(primaryEnv); dsn != "" {
+		return dsn
+	}
+	return {{ if .Config }}URL{{ else }}"{{ .Conn }}"{{ end }}
+}
+
+// openReplicas connects to the replicas listed by DATABASE_REPLICA_URLS and
+// starts checking their health
+func openReplicas() error {
+	for _, dsn := range strings.Split(os.Getenv(replicasEnv), ",") {
+		if dsn = strings.TrimSpace(dsn); dsn == "" {
+			continue
+		}
+
+		conn, err := {{ if .Tracing }}open(dsn){{ else }}sql.Open("{{ .Driver }}", dsn){{ end }}
+		if err != nil {
+			return err
+		}
+		conn.SetMaxOpenConns(50)
+
+		r := &replica{db: conn}
+		r.healthy.Store(conn.Ping() == nil)
+		replicas = append(replicas, r)
+	}
+
+	if len(replicas) > 0 {
+		stop = make(chan struct{})
+		go checkReplicas(stop)
+	}
+	return nil
+}
+
+// checkReplicas pings the replicas every healthInterval until stop is closed
+func checkReplicas(stop <-chan struct{}) {
+	ticker := time.NewTicker(healthInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			for _, r := range replicas {
+				r.healthy.Store(r.db.Ping() == nil)
+			}
+		}
+	}
+}
+
+// closeReplicas closes the replicas and named databases
+func closeReplicas() {
+	if stop != nil {
+		close(stop)
+		stop = nil
+	}
+
+	for _, r := range replicas {
+		r.db.Close()
+	}
+	replicas = nil
+
+	databasesMu.Lock()
+	defer databasesMu.Unlock()
+	for name, conn := range databases {
+		conn.Close()
+		delete(databases, name)
+	}
+}
\ No newline at end of file
@@ -0,0 +1,311 @@
+{{- $gorm := and .ORM (eq .ORM.Name "gorm") -}}
+{{- $conn := "Querier" }}{{ if and .ORM .ORM.Querier }}{{ $conn = .ORM.Querier }}{{ end -}}
+package sql
+
+import (
+{{- range .Imports }}
+	{{ if . }}"{{ . }}"{{ end }}
+{{- end }}
+)
+{{- if .Resource }}
+
+{{ template "model" .Model }}
+{{- end }}
+
+// {{ .Model.Name }}Store reads and writes the {{ .Model.Table }} aggregate of a Store
+type {{ .Model.Name }}Store interface {
+	List(ctx context.Context) ([]{{ .Model.Name }}, error)
+{{- range .Model.Finders }}
+	{{ .Name }}(ctx context.Context, {{ .Param }} int64) ([]{{ $.Model.Name }}, error)
+{{- end }}
+{{- if .Model.GetQuery }}
+	Get(ctx context.Context, {{ .Model.KeyParams }}) (*{{ .Model.Name }}, error)
+{{- end }}
+	Create(ctx context.Context, m *{{ .Model.Name }}) error
+{{- if .Model.UpdateQuery }}
+	Update(ctx context.Context, m *{{ .Model.Name }}) error
+{{- end }}
+{{- if .Model.DeleteQuery }}
+	Delete(ctx context.Context, {{ .Model.KeyParams }}) error
+{{- end }}
+{{- range .Model.Links }}
+	Add{{ .Model }}(ctx context.Context, {{ .OwnerParam }}, {{ .OtherParam }} int64) error
+	Remove{{ .Model }}(ctx context.Context, {{ .OwnerParam }}, {{ .OtherParam }} int64) error
+	List{{ .Plural }}(ctx context.Context, {{ .OwnerParam }} int64) ([]{{ .Model }}, error)
+{{- end }}
+}
+
+// {{ .Model.Name }}Repository reads and writes rows of the {{ .Model.Table }} table
+type {{ .Model.Name }}Repository struct {
+	conn {{ $conn }}
+}
+
+// New{{ .Model.Name }}Repository creates a {{ .Model.Table }} repository using conn
+func New{{ .Model.Name }}Repository(conn {{ $conn }}) *{{ .Model.Name }}Repository {
+	return &{{ .Model.Name }}Repository{conn}
+}
+
+// List reads every row of the {{ .Model.Table }} table
+func (r *{{ .Model.Name }}Repository) List(ctx context.Context) ([]{{ .Model.Name }}, error) {
+	return r.query(ctx, {{ printf "%q" .Model.ListQuery }})
+}
+{{- range .Model.Finders }}
+
+// {{ .Name }} reads the {{ $.Model.Table }} rows referencing {{ .Param }} using {{ .Column }}
+func (r *{{ $.Model.Name }}Repository) {{ .Name }}(ctx context.Context, {{ .Param }} int64) ([]{{ $.Model.Name }}, error) {
+	return r.query(ctx, {{ printf "%q" .Query }}, {{ .Param }})
+}
+{{- end }}
+
+// query reads the {{ .Model.Table }} rows returned by query
+func (r *{{ .Model.Name }}Repository) query(ctx context.Context, query string, args ...interface{}) ([]{{ .Model.Name }}, error) {
+{{- if not .ORM }}
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]{{ .Model.Name }}, 0)
+	for rows.Next() {
+		var m {{ .Model.Name }}
+		if err := rows.Scan({{ .Model.ScanArgs }}); err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return list, rows.Err()
+{{- else if eq .ORM.Name "sqlx" }}
+	list := make([]{{ .Model.Name }}, 0)
+	return list, r.conn.SelectContext(ctx, &list, query, args...)
+{{- else if eq .ORM.Name "gorm" }}
+	list := make([]{{ .Model.Name }}, 0)
+	return list, r.conn.WithContext(ctx).Raw(query, args...).Scan(&list).Error
+{{- else if eq .ORM.Name "bun" }}
+	list := make([]{{ .Model.Name }}, 0)
+	return list, r.conn.NewRaw(query, args...).Scan(ctx, &list)
+{{- end }}
+}
+{{- if .Model.GetQuery }}
+
+// Get reads the {{ .Model.Table }} row with the primary key, returning
+// sql.ErrNoRows when it does not exist
+func (r *{{ .Model.Name }}Repository) Get(ctx context.Context, {{ .Model.KeyParams }}) (*{{ .Model.Name }}, error) {
+	var m {{ .Model.Name }}
+{{- if not .ORM }}
+	row := r.conn.QueryRowContext(ctx, {{ printf "%q" .Model.GetQuery }}, {{ .Model.KeyArgs }})
+	if err := row.Scan({{ .Model.ScanArgs }}); err != nil {
+		return nil, err
+	}
+{{- else if eq .ORM.Name "sqlx" }}
+	if err := r.conn.GetContext(ctx, &m, {{ printf "%q" .Model.GetQuery }}, {{ .Model.KeyArgs }}); err != nil {
+		return nil, err
+	}
+{{- else if eq .ORM.Name "gorm" }}
+	result := r.conn.WithContext(ctx).Raw({{ printf "%q" .Model.GetQuery }}, {{ .Model.KeyArgs }}).Scan(&m)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+{{- else if eq .ORM.Name "bun" }}
+	if err := r.conn.NewRaw({{ printf "%q" .Model.GetQuery }}, {{ .Model.KeyArgs }}).Scan(ctx, &m); err != nil {
+		return nil, err
+	}
+{{- end }}
+	return &m, nil
+}
+{{- end }}
+
+// Create adds m to the {{ .Model.Table }} table{{ if .Model.AutoKey }}, setting the generated {{ .Model.AutoKey.Name }}{{ end }}
+func (r *{{ .Model.Name }}Repository) Create(ctx context.Context, m *{{ .Model.Name }}) error {
+{{- if and $gorm .Model.AutoKey }}
+	return r.conn.WithContext(ctx).Table({{ printf "%q" .Model.Table }}).Create(m).Error
+{{- else if and .Model.AutoKey .Model.Returning }}
+	row := r.conn.QueryRowContext(ctx, {{ printf "%q" .Model.InsertQuery }}{{ if .Model.InsertArgs }}, {{ .Model.InsertArgs }}{{ end }})
+	return row.Scan(&m.{{ .Model.AutoKey.Name }})
+{{- else if .Model.AutoKey }}
+	result, err := r.conn.ExecContext(ctx, {{ printf "%q" .Model.InsertQuery }}{{ if .Model.InsertArgs }}, {{ .Model.InsertArgs }}{{ end }})
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	m.{{ .Model.AutoKey.Name }} = id
+	return nil
+{{- else }}
+{{- if $gorm }}
+	return r.conn.WithContext(ctx).Exec({{ printf "%q" .Model.InsertQuery }}{{ if .Model.InsertArgs }}, {{ .Model.InsertArgs }}{{ end }}).Error
+{{- else }}
+	_, err := r.conn.ExecContext(ctx, {{ printf "%q" .Model.InsertQuery }}{{ if .Model.InsertArgs }}, {{ .Model.InsertArgs }}{{ end }})
+	return err
+{{- end }}
+{{- end }}
+}
+{{- if .Model.UpdateQuery }}
+
+// Update writes the fields of m to its {{ .Model.Table }} row
+func (r *{{ .Model.Name }}Repository) Update(ctx context.Context, m *{{ .Model.Name }}) error {
+{{- if $gorm }}
+	return r.conn.WithContext(ctx).Exec({{ printf "%q" .Model.UpdateQuery }}, {{ .Model.UpdateArgs }}).Error
+{{- else }}
+	_, err := r.conn.ExecContext(ctx, {{ printf "%q" .Model.UpdateQuery }}, {{ .Model.UpdateArgs }})
+	return err
+{{- end }}
+}
+{{- end }}
+{{- if .Model.DeleteQuery }}
+
+// Delete removes the {{ .Model.Table }} row with the primary key
+func (r *{{ .Model.Name }}Repository) Delete(ctx context.Context, {{ .Model.KeyParams }}) error {
+{{- if $gorm }}
+	return r.conn.WithContext(ctx).Exec({{ printf "%q" .Model.DeleteQuery }}, {{ .Model.KeyArgs }}).Error
+{{- else }}
+	_, err := r.conn.ExecContext(ctx, {{ printf "%q" .Model.DeleteQuery }}, {{ .Model.KeyArgs }})
+	return err
+{{- end }}
+}
+{{- end }}
+{{- range .Model.Links }}
+
+// Add{{ .Model }} links the {{ .Table }} row {{ .OtherParam }} to the {{ $.Model.Table }} row {{ .OwnerParam }}
+func (r *{{ $.Model.Name }}Repository) Add{{ .Model }}(ctx context.Context, {{ .OwnerParam }}, {{ .OtherParam }} int64) error {
+{{- if $gorm }}
+	return r.conn.WithContext(ctx).Exec({{ printf "%q" .InsertQuery }}, {{ .OwnerParam }}, {{ .OtherParam }}).Error
+{{- else }}
+	_, err := r.conn.ExecContext(ctx, {{ printf "%q" .InsertQuery }}, {{ .OwnerParam }}, {{ .OtherParam }})
+	return err
+{{- end }}
+}
+
+// Remove{{ .Model }} unlinks the {{ .Table }} row {{ .OtherParam }} from the {{ $.Model.Table }} row {{ .OwnerParam }}
+func (r *{{ $.Model.Name }}Repository) Remove{{ .Model }}(ctx context.Context, {{ .OwnerParam }}, {{ .OtherParam }} int64) error {
+{{- if $gorm }}
+	return r.conn.WithContext(ctx).Exec({{ printf "%q" .DeleteQuery }}, {{ .OwnerParam }}, {{ .OtherParam }}).Error
+{{- else }}
+	_, err := r.conn.ExecContext(ctx, {{ printf "%q" .DeleteQuery }}, {{ .OwnerParam }}, {{ .OtherParam }})
+	return err
+{{- end }}
+}
+
+// List{{ .Plural }} reads the {{ .Table }} rows linked to the {{ $.Model.Table }} row {{ .OwnerParam }}
+func (r *{{ $.Model.Name }}Repository) List{{ .Plural }}(ctx context.Context, {{ .OwnerParam }} int64) ([]{{ .Model }}, error) {
+	return (&{{ .Model }}Repository{r.conn}).query(ctx, {{ printf "%q" .ListQuery }}, {{ .OwnerParam }})
+}
+{{- end }}
+
+// fake{{ .Model.Name }}Repository keeps the {{ .Model.Table }} rows of a fake Store in memory
+type fake{{ .Model.Name }}Repository struct {
+	data *fakeData
+}
+
+func (r *fake{{ .Model.Name }}Repository) List(ctx context.Context) ([]{{ .Model.Name }}, error) {
+	r.data.mu.Lock()
+	defer r.data.mu.Unlock()
+	return append(make([]{{ .Model.Name }}, 0, len(r.data.{{ .Model.Plural }})), r.data.{{ .Model.Plural }}...), nil
+}
+{{- range .Model.Finders }}
+
+func (r *fake{{ $.Model.Name }}Repository) {{ .Name }}(ctx context.Context, {{ .Param }} int64) ([]{{ $.Model.Name }}, error) {
+	r.data.mu.Lock()
+	defer r.data.mu.Unlock()
+
+	list := make([]{{ $.Model.Name }}, 0)
+	for _, m := range r.data.{{ $.Model.Plural }} {
+{{- if .Null }}
+		if m.{{ .Field }}.Valid && m.{{ .Field }}.Int64 == {{ .Param }} {
+{{- else }}
+		if m.{{ .Field }} == {{ .Param }} {
+{{- end }}
+			list = append(list, m)
+		}
+	}
+	return list, nil
+}
+{{- end }}
+{{- if .Model.GetQuery }}
+
+func (r *fake{{ .Model.Name }}Repository) Get(ctx context.Context, {{ .Model.KeyParams }}) (*{{ .Model.Name }}, error) {
+	r.data.mu.Lock()
+	defer r.data.mu.Unlock()
+
+	for _, m := range r.data.{{ .Model.Plural }} {
+		if {{ .Model.KeyMatch "m" "" }} {
+			return &m, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+{{- end }}
+
+func (r *fake{{ .Model.Name }}Repository) Create(ctx context.Context, m *{{ .Model.Name }}) error {
+	r.data.mu.Lock()
+	defer r.data.mu.Unlock()
+{{- if .Model.AutoKey }}
+
+	r.data.{{ .Model.Plural }}Seq++
+	m.{{ .Model.AutoKey.Name }} = r.data.{{ .Model.Plural }}Seq
+{{- end }}
+	r.data.{{ .Model.Plural }} = append(r.data.{{ .Model.Plural }}, *m)
+	return nil
+}
+{{- if .Model.UpdateQuery }}
+
+func (r *fake{{ .Model.Name }}Repository) Update(ctx context.Context, m *{{ .Model.Name }}) error {
+	r.data.mu.Lock()
+	defer r.data.mu.Unlock()
+
+	for i, row := range r.data.{{ .Model.Plural }} {
+		if {{ .Model.KeyMatch "row" "m" }} {
+			r.data.{{ .Model.Plural }}[i] = *m
+		}
+	}
+	return nil
+}
+{{- end }}
+{{- if .Model.DeleteQuery }}
+
+func (r *fake{{ .Model.Name }}Repository) Delete(ctx context.Context, {{ .Model.KeyParams }}) error {
+	r.data.mu.Lock()
+	defer r.data.mu.Unlock()
+
+	list := make([]{{ .Model.Name }}, 0, len(r.data.{{ .Model.Plural }}))
+	for _, m := range r.data.{{ .Model.Plural }} {
+		if !({{ .Model.KeyMatch "m" "" }}) {
+			list = append(list, m)
+		}
+	}
+	r.data.{{ .Model.Plural }} = list
+	return nil
+}
+{{- end }}
+{{- range .Model.Links }}
+
+func (r *fake{{ $.Model.Name }}Repository) Add{{ .Model }}(ctx context.Context, {{ .OwnerParam }}, {{ .OtherParam }} int64) error {
+	r.data.link({{ printf "%q" .Join }}, [2]int64{ {{- if .Reversed }}{{ .OtherParam }}, {{ .OwnerParam }}{{ else }}{{ .OwnerParam }}, {{ .OtherParam }}{{ end -}} }, true)
+	return nil
+}
+
+func (r *fake{{ $.Model.Name }}Repository) Remove{{ .Model }}(ctx context.Context, {{ .OwnerParam }}, {{ .OtherParam }} int64) error {
+	r.data.link({{ printf "%q" .Join }}, [2]int64{ {{- if .Reversed }}{{ .OtherParam }}, {{ .OwnerParam }}{{ else }}{{ .OwnerParam }}, {{ .OtherParam }}{{ end -}} }, false)
+	return nil
+}
+
+func (r *fake{{ $.Model.Name }}Repository) List{{ .Plural }}(ctx context.Context, {{ .OwnerParam }} int64) ([]{{ .Model }}, error) {
+	r.data.mu.Lock()
+	defer r.data.mu.Unlock()
+
+	list := make([]{{ .Model }}, 0)
+	for _, m := range r.data.{{ .Plural }} {
+		if r.data.links[{{ printf "%q" .Join }}][[2]int64{ {{- if .Reversed }}m.ID, {{ .OwnerParam }}{{ else }}{{ .OwnerParam }}, m.ID{{ end -}} }] {
+			list = append(list, m)
+		}
+	}
+	return list, nil
+}
+{{- end }}
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- Declare the desired database schema within the .sql files of this directory,
+-- which are applied in file name order. Run `conseil migration diff <name>` to
+-- generate a migration from the differences between the existing migrations
+-- and this schema.
\ No newline at end of file
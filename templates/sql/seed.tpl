@@ -0,0 +1,3 @@
+-- Place the {{ .Name }} seed data within this file. Seed files are applied in
+-- file name order using `conseil db seed --env {{ .Name }}` or sql.Seed, and
+-- each file is only applied once to a database.
\ No newline at end of file
@@ -0,0 +1,116 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+{{- if .Embed }}
+	"embed"
+{{- end }}
+	"errors"
+	"fmt"
+	"io/fs"
+{{- if not .Embed }}
+	"os"
+{{- end }}
+	"path"
+	"strings"
+)
+{{ if .Embed }}
+// seedFiles holds the seed files compiled into the binary
+//
+//go:embed seeds
+var seedFiles embed.FS
+{{- else }}
+var Seeds = "seeds"
+{{- end }}
+
+// Seed applies the seed files of env that have not yet been applied to the
+// database
+func Seed(ctx context.Context, env string) error {
+	if db == nil {
+		return errors.New("database not initialized")
+	}
+	return SeedDB(ctx, db, env)
+}
+
+// SeedDB applies the seed files of env to conn in file name order, so tests
+// can load fixtures into their own database. Each file is recorded within the
+// {{ .Seed.Table }} table and only applied once.
+func SeedDB(ctx context.Context, conn *sql.DB, env string) error {
+{{- if .Embed }}
+	fsys, err := fs.Sub(seedFiles, "seeds")
+	if err != nil {
+		return err
+	}
+{{- else }}
+	fsys := os.DirFS(Seeds)
+{{- end }}
+
+	files, err := fs.Glob(fsys, path.Join(env, "*.sql"))
+	if err != nil {
+		return err
+	}
+
+	if len(files) == 0 {
+		return fmt.Errorf("no seed files found for the %s environment", env)
+	}
+
+	if _, err := conn.ExecContext(ctx, `{{ .Seed.Create }}`); err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		name := path.Base(file)
+
+		var count int
+		if err := conn.QueryRowContext(ctx, `{{ .Seed.Applied }}`, env, name).Scan(&count); err != nil {
+			return err
+		}
+
+		if count > 0 {
+			continue
+		}
+
+		src, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+
+		if err := seedFile(ctx, conn, env, name, string(src)); err != nil {
+			return fmt.Errorf("seeding %s/%s: %w", env, name, err)
+		}
+	}
+	return nil
+}
+
+// seedFile applies a seed file and records it within a single transaction
+func seedFile(ctx context.Context, conn *sql.DB, env, name, src string) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if hasStatements(src) {
+		if _, err := tx.ExecContext(ctx, src); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `{{ .Seed.Insert }}`, env, name); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// hasStatements reports whether src contains anything besides blank lines
+// and comments, which some drivers reject as an empty query
+func hasStatements(src string) bool {
+	for _, line := range strings.Split(src, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return true
+		}
+	}
+	return false
+}
\ No newline at end of file
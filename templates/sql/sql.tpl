@@ -2,30 +2,117 @@ package sql
 
 import (
     "database/sql"
+{{- if .Tracing }}
+
+    "github.com/XSAM/otelsql"
+    semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+{{- end }}
+{{- if .ORM }}
+{{ range .ORM.Imports }}
+    {{ . }}
+{{- end }}
+{{- if eq .ORM.Name "ent" }}
+    "{{ .Module }}/sql/ent"
+{{- end }}
+{{- end }}
+{{- if or .Queries .Metrics }}
+{{ end }}
+{{- if .Metrics }}
+    "{{ .Module }}/metrics"
+{{- end }}
+{{- if .Queries }}
+    "{{ .Module }}/sql/queries"
+{{- end }}
 
     _ "{{ .Import }}"
 )
 
 var db *sql.DB
+{{- if .Config }}
+
+// URL is the connection string of the database opened by Open
+var URL = "{{ .Conn }}"
+{{- end }}
+{{- if .ORM }}
+
+// conn wraps db using {{ .ORM.Name }}
+var conn {{ .ORM.Conn }}
+{{- end }}
 
 func Open() error {
     var err error
-    db, err = sql.Open("{{ .Driver }}", "{{ .Conn }}")
+{{- if .Replicas }}
+    db, err = {{ if .Tracing }}open({{ else }}sql.Open("{{ .Driver }}", {{ end }}primaryURL())
+{{- else }}
+    db, err = {{ if .Tracing }}open({{ else }}sql.Open("{{ .Driver }}", {{ end }}{{ if .Config }}URL{{ else }}"{{ .Conn }}"{{ end }})
+{{- end }}
     if err != nil {
-        return nil, err
+        return err
     }
 
     if err := db.Ping(); err != nil {
-        return nil, err
+        db.Close()
+        db = nil
+        return err
     }
 
     db.SetMaxOpenConns(50)
-    return db, nil
+{{- if .Metrics }}
+
+    if err := metrics.RegisterDB(db, "{{ .Driver }}"); err != nil {
+        return err
+    }
+{{- end }}
+{{- if .ORM }}
+{{- if .ORM.Fallible }}
+
+    if conn, err = {{ .ORM.Open }}; err != nil {
+        return err
+    }
+{{- else }}
+    conn = {{ .ORM.Open }}
+{{- end }}
+{{- end }}
+{{- if .Replicas }}
+
+    if err := openReplicas(); err != nil {
+        return err
+    }
+{{- end }}
+    return nil
 }
 
 func Close() error {
+{{- if .Replicas }}
+    closeReplicas()
+{{- end }}
     if db != nil {
         return db.Close()
     }
     return nil
-}
\ No newline at end of file
+}
+{{- if .Tracing }}
+
+// open connects to the database at dsn, tracing its queries
+func open(dsn string) (*sql.DB, error) {
+    return otelsql.Open("{{ .Driver }}", dsn, otelsql.WithAttributes(semconv.DBSystemNameKey.String("{{ .System }}")))
+}
+{{- end }}
+{{- if .ORM }}
+
+// {{ .ORM.Accessor }} returns the {{ .ORM.Name }} handle of the database opened by Open
+func {{ .ORM.Accessor }}() {{ .ORM.Conn }} {
+    return conn
+}
+{{- end }}
+{{- if .Queries }}
+
+// Queries returns the sqlc queries of the database opened by Open
+func Queries() *queries.Queries {
+{{- if .Replicas }}
+    return queries.New(Routed())
+{{- else }}
+    return queries.New(db)
+{{- end }}
+}
+{{- end }}
\ No newline at end of file
@@ -0,0 +1,7 @@
+-- Place the queries of the application within this directory. sqlc compiles
+-- them against the schema of the migrations into the queries package, which
+-- is returned by sql.Queries(). Run `go generate ./sql/queries` after
+-- changing a query or applying a new migration.
+
+-- name: Ping :one
+SELECT 1;
@@ -0,0 +1,3 @@
+package queries
+
+//go:generate sqlc generate -f ../../sqlc.yaml
\ No newline at end of file
@@ -0,0 +1,22 @@
+-- Queries of the {{ .Model.Table }} table compiled into the queries package by sqlc
+
+-- name: List{{ .Name }} :many
+{{ .Model.ListQuery }};
+{{- if .Model.GetQuery }}
+
+-- name: Get{{ .Model.Name }} :one
+{{ .Model.GetQuery }};
+{{- end }}
+
+-- name: Create{{ .Model.Name }} {{ if .Model.Returning }}:one{{ else if .Model.AutoKey }}:execlastid{{ else }}:exec{{ end }}
+{{ .Model.InsertQuery }};
+{{- if .Model.UpdateQuery }}
+
+-- name: Update{{ .Model.Name }} :exec
+{{ .Model.UpdateQuery }};
+{{- end }}
+{{- if .Model.DeleteQuery }}
+
+-- name: Delete{{ .Model.Name }} :exec
+{{ .Model.DeleteQuery }};
+{{- end }}
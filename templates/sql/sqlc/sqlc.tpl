@@ -0,0 +1,9 @@
+version: "2"
+sql:
+  - engine: "{{ .Dialect }}"
+    schema: "{{ .Schema }}"
+    queries: "{{ .Name }}"
+    gen:
+      go:
+        package: "queries"
+        out: "{{ .Name }}"
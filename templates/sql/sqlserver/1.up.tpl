@@ -0,0 +1,11 @@
+-- Place SQL statements that are used to initialize the database schema
+-- within this file. Subsequent schema migrations should be created using
+-- `conseil migration new <name>`.
+--
+-- Migrations are executed as a single batch, so omit the GO batch
+-- separators used by sqlcmd and SQL Server Management Studio.
+--
+-- CREATE TABLE example (
+--     id BIGINT IDENTITY(1,1) NOT NULL PRIMARY KEY,
+--     created_at DATETIME2 NOT NULL DEFAULT SYSUTCDATETIME()
+-- );
\ No newline at end of file
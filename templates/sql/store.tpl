@@ -0,0 +1,170 @@
+{{- $root := "*sql.DB" }}{{ if .ORM }}{{ $root = .ORM.Conn }}{{ else if .Replicas }}{{ $root = "Router" }}{{ end -}}
+{{- $conn := "Querier" }}{{ if and .ORM .ORM.Querier }}{{ $conn = .ORM.Querier }}{{ end -}}
+{{- $layer := "" }}{{ if .ORM }}{{ $layer = .ORM.Name }}{{ end -}}
+package sql
+
+import (
+{{- range .Imports }}
+	{{ if . }}"{{ . }}"{{ end }}
+{{- end }}
+)
+{{- if eq $conn "Querier" }}
+{{- if eq $layer "sqlx" }}
+
+// Querier is implemented by *sqlx.DB and *sqlx.Tx, and is used by the
+// generated repositories
+type Querier interface {
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+{{- else }}
+
+// Querier is implemented by *sql.DB{{ if .Replicas }}, the Router,{{ end }} and *sql.Tx, and is used by the
+// generated repositories
+type Querier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+{{- end }}
+{{- end }}
+
+// Store groups the repositories of every aggregate, which share a
+// transaction within WithTx
+type Store struct {
+{{- range .Models }}
+	{{ .Plural }} {{ .Name }}Store
+{{- end }}
+
+	withTx func(ctx context.Context, fn func(tx Store) error) error
+}
+
+// NewStore creates a Store of the repositories querying conn
+func NewStore(conn {{ $root }}) Store {
+	s := newStore(conn)
+	s.withTx = func(ctx context.Context, fn func(tx Store) error) error {
+{{- if eq $layer "gorm" }}
+		return conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return fn(newStore(tx))
+		})
+{{- else if eq $layer "bun" }}
+		return conn.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return fn(newStore(&tx))
+		})
+{{- else }}
+		tx, err := conn.{{ if eq $layer "sqlx" }}BeginTxx{{ else }}BeginTx{{ end }}(ctx, nil)
+		if err != nil {
+			return err
+		}
+		// rolls back when fn fails or panics; a no-op once committed
+		defer tx.Rollback()
+
+		if err := fn(newStore(tx)); err != nil {
+			return err
+		}
+		return tx.Commit()
+{{- end }}
+	}
+	return s
+}
+
+// newStore creates a Store of the repositories querying conn, whose WithTx
+// reuses conn
+func newStore(conn {{ $conn }}) Store {
+	s := Store{
+{{- range .Models }}
+		{{ .Plural }}: New{{ .Name }}Repository(conn),
+{{- end }}
+	}
+	s.withTx = func(ctx context.Context, fn func(tx Store) error) error {
+		return fn(s)
+	}
+	return s
+}
+
+// WithTx runs fn with a Store whose repositories share a transaction, which
+// is committed when fn succeeds and rolled back otherwise
+func (s Store) WithTx(ctx context.Context, fn func(tx Store) error) error {
+	return s.withTx(ctx, fn)
+}
+
+// fakeData holds the rows of a fake Store
+type fakeData struct {
+	mu sync.Mutex
+{{- range .Models }}
+	{{ .Plural }}    []{{ .Name }}
+	{{ .Plural }}Seq int64
+{{- end }}
+	// links holds the linked keys of every join table
+	links map[string]map[[2]int64]bool
+}
+
+// NewFakeStore creates a Store keeping its rows in memory, e.g. to test
+// handlers without a database; its transactions are applied when they
+// succeed, but are not isolated from concurrent writes
+func NewFakeStore() Store {
+	return newFakeStore(&fakeData{links: make(map[string]map[[2]int64]bool)})
+}
+
+func newFakeStore(data *fakeData) Store {
+	s := Store{
+{{- range .Models }}
+		{{ .Plural }}: &fake{{ .Name }}Repository{data},
+{{- end }}
+	}
+	s.withTx = func(ctx context.Context, fn func(tx Store) error) error {
+		tx := data.clone()
+		if err := fn(newFakeStore(tx)); err != nil {
+			return err
+		}
+		data.restore(tx)
+		return nil
+	}
+	return s
+}
+
+// link adds or removes key from the links of the join table
+func (d *fakeData) link(join string, key [2]int64, linked bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.links[join] == nil {
+		d.links[join] = make(map[[2]int64]bool)
+	}
+
+	if linked {
+		d.links[join][key] = true
+	} else {
+		delete(d.links[join], key)
+	}
+}
+
+// clone copies the rows of d
+func (d *fakeData) clone() *fakeData {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c := &fakeData{links: make(map[string]map[[2]int64]bool, len(d.links))}
+{{- range .Models }}
+	c.{{ .Plural }}, c.{{ .Plural }}Seq = append([]{{ .Name }}(nil), d.{{ .Plural }}...), d.{{ .Plural }}Seq
+{{- end }}
+	for join, links := range d.links {
+		c.links[join] = make(map[[2]int64]bool, len(links))
+		for key := range links {
+			c.links[join][key] = true
+		}
+	}
+	return c
+}
+
+// restore replaces the rows of d with those of c
+func (d *fakeData) restore(c *fakeData) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+{{- range .Models }}
+	d.{{ .Plural }}, d.{{ .Plural }}Seq = c.{{ .Plural }}, c.{{ .Plural }}Seq
+{{- end }}
+	d.links = c.links
+}
\ No newline at end of file
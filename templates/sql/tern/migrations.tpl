@@ -0,0 +1,124 @@
+package sql
+
+import (
+	"context"
+{{- if .Embed }}
+	"embed"
+{{- end }}
+	"errors"
+	"fmt"
+	"io/fs"
+{{- if not .Embed }}
+	"os"
+{{- end }}
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/tern/v2/migrate"
+)
+
+const versionTable = "schema_version"
+{{ if .Embed }}
+// migrationFS holds the migrations compiled into the binary
+//
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+{{- else }}
+var Migrations = "migrations"
+{{- end }}
+
+// RunMigrations performs any required database migrations
+func RunMigrations() error {
+	ctx := context.Background()
+	m, conn, err := newMigrator(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+
+	return m.Migrate(ctx)
+}
+
+// Migrate runs a migration subcommand: up, down [n], version, or force <version>
+func Migrate(args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: migrate up|down [n]|version|force <version>")
+	}
+
+	ctx := context.Background()
+	m, conn, err := newMigrator(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+
+	switch args[0] {
+	case "up":
+		return m.Migrate(ctx)
+	case "down":
+		n := 1
+		if len(args) > 1 {
+			if n, err = strconv.Atoi(args[1]); err != nil || n < 1 {
+				return fmt.Errorf("invalid number of migrations: %s", args[1])
+			}
+		}
+
+		version, err := m.GetCurrentVersion(ctx)
+		if err != nil {
+			return err
+		}
+
+		target := version - int32(n)
+		if target < 0 {
+			target = 0
+		}
+		return m.MigrateTo(ctx, target)
+	case "version":
+		version, err := m.GetCurrentVersion(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("version %d\n", version)
+		return nil
+	case "force":
+		if len(args) < 2 {
+			return errors.New("usage: migrate force <version>")
+		}
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid migration version: %s", args[1])
+		}
+		_, err = conn.Exec(ctx, "UPDATE "+versionTable+" SET version = $1", version)
+		return err
+	default:
+		return fmt.Errorf("unknown migrate command: %s", args[0])
+	}
+}
+
+func newMigrator(ctx context.Context) (*migrate.Migrator, *pgx.Conn, error) {
+	conn, err := pgx.Connect(ctx, {{ if .Config }}URL{{ else }}"{{ .Conn }}"{{ end }})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m, err := migrate.NewMigrator(ctx, conn, versionTable)
+	if err != nil {
+		conn.Close(ctx)
+		return nil, nil, err
+	}
+{{ if .Embed }}
+	migrations, err := fs.Sub(migrationFS, "migrations")
+	if err != nil {
+		conn.Close(ctx)
+		return nil, nil, err
+	}
+{{- else }}
+	var migrations fs.FS = os.DirFS(Migrations)
+{{- end }}
+
+	if err := m.LoadMigrations(migrations); err != nil {
+		conn.Close(ctx)
+		return nil, nil, err
+	}
+	return m, conn, nil
+}
\ No newline at end of file
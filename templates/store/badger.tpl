@@ -0,0 +1,106 @@
+package store
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// Path is the database directory opened by Open
+var Path = "data"
+
+var db *badger.DB
+
+// Open opens the badger database at Path and applies the pending data
+// migrations
+func Open() error {
+	var err error
+	db, err = badger.Open(badger.DefaultOptions(Path).WithLogger(nil))
+	if err != nil {
+		return err
+	}
+	return Migrate()
+}
+
+// Close closes the database opened by Open
+func Close() error {
+	if db != nil {
+		return db.Close()
+	}
+	return nil
+}
+
+// Backup writes a full backup of the database to w, which is restored
+// using badger's Load
+func Backup(w io.Writer) error {
+	_, err := db.Backup(w, 0)
+	return err
+}
+
+// bucketKey prefixes key with its bucket, as badger has a single key space
+func bucketKey(bucket, key string) []byte {
+	return []byte(bucket + "/" + key)
+}
+
+// get reads the value of key within bucket, returning ErrNotFound when it
+// does not exist
+func get(bucket, key string) ([]byte, error) {
+	var value []byte
+	err := db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(bucketKey(bucket, key))
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return ErrNotFound
+		} else if err != nil {
+			return err
+		}
+
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	return value, err
+}
+
+// put writes the value of key within bucket
+func put(bucket, key string, value []byte) error {
+	return db.Update(func(txn *badger.Txn) error {
+		return txn.Set(bucketKey(bucket, key), value)
+	})
+}
+
+// remove deletes key from bucket
+func remove(bucket, key string) error {
+	return db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(bucketKey(bucket, key))
+	})
+}
+
+// each calls fn with the keys and values of bucket in key order, or of
+// every bucket when bucket is empty; value is only valid during the call
+func each(bucket string, fn func(bucket, key string, value []byte) error) error {
+	return db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		if bucket != "" {
+			opts.Prefix = []byte(bucket + "/")
+		}
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			parts := strings.SplitN(string(item.Key()), "/", 2)
+			if len(parts) != 2 {
+				continue
+			}
+
+			if err := item.Value(func(v []byte) error {
+				return fn(parts[0], parts[1], v)
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
\ No newline at end of file
@@ -0,0 +1,106 @@
+package store
+
+import (
+	"io"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Path is the database file opened by Open
+var Path = "data.db"
+
+var db *bolt.DB
+
+// Open opens the bbolt database at Path and applies the pending data
+// migrations
+func Open() error {
+	var err error
+	db, err = bolt.Open(Path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return err
+	}
+	return Migrate()
+}
+
+// Close closes the database opened by Open
+func Close() error {
+	if db != nil {
+		return db.Close()
+	}
+	return nil
+}
+
+// Backup writes a consistent snapshot of the database file to w
+func Backup(w io.Writer) error {
+	return db.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(w)
+		return err
+	})
+}
+
+// get reads the value of key within bucket, returning ErrNotFound when
+// either does not exist
+func get(bucket, key string) ([]byte, error) {
+	var value []byte
+	err := db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return ErrNotFound
+		}
+
+		v := b.Get([]byte(key))
+		if v == nil {
+			return ErrNotFound
+		}
+		value = append([]byte(nil), v...)
+		return nil
+	})
+	return value, err
+}
+
+// put writes the value of key within bucket, creating the bucket when it
+// does not exist
+func put(bucket, key string, value []byte) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(key), value)
+	})
+}
+
+// remove deletes key from bucket
+func remove(bucket, key string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		if b := tx.Bucket([]byte(bucket)); b != nil {
+			return b.Delete([]byte(key))
+		}
+		return nil
+	})
+}
+
+// each calls fn with the keys and values of bucket in key order, or of
+// every bucket when bucket is empty; value is only valid during the call
+func each(bucket string, fn func(bucket, key string, value []byte) error) error {
+	return db.View(func(tx *bolt.Tx) error {
+		visit := func(name []byte, b *bolt.Bucket) error {
+			return b.ForEach(func(k, v []byte) error {
+				if v == nil {
+					return nil
+				}
+				return fn(string(name), string(k), v)
+			})
+		}
+
+		if bucket == "" {
+			return tx.ForEach(visit)
+		}
+
+		if b := tx.Bucket([]byte(bucket)); b != nil {
+			return visit([]byte(bucket), b)
+		}
+		return nil
+	})
+}
\ No newline at end of file
@@ -0,0 +1,58 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrNotFound is returned when a key does not exist
+var ErrNotFound = errors.New("store: key not found")
+
+// Bucket stores the JSON encoding of values of type T under the keys of a
+// named bucket; bucket names must not contain a slash
+type Bucket[T any] struct {
+	name string
+}
+
+// NewBucket returns the bucket named name
+func NewBucket[T any](name string) Bucket[T] {
+	return Bucket[T]{name}
+}
+
+// Get reads the value of key, returning ErrNotFound when it does not exist
+func (b Bucket[T]) Get(key string) (T, error) {
+	var v T
+	data, err := get(b.name, key)
+	if err != nil {
+		return v, err
+	}
+	return v, json.Unmarshal(data, &v)
+}
+
+// Put writes v as the value of key
+func (b Bucket[T]) Put(key string, v T) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return put(b.name, key, data)
+}
+
+// Delete removes key from the bucket
+func (b Bucket[T]) Delete(key string) error {
+	return remove(b.name, key)
+}
+
+// List reads every value of the bucket in key order
+func (b Bucket[T]) List() ([]T, error) {
+	list := make([]T, 0)
+	err := each(b.name, func(_, _ string, value []byte) error {
+		var v T
+		if err := json.Unmarshal(value, &v); err != nil {
+			return err
+		}
+		list = append(list, v)
+		return nil
+	})
+	return list, err
+}
\ No newline at end of file
@@ -0,0 +1,76 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Command runs a store subcommand: backup <file>, export [file], or version
+func Command(args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: store backup <file>|export [file]|version")
+	}
+
+	if err := Open(); err != nil {
+		return err
+	}
+	defer Close()
+
+	switch args[0] {
+	case "backup":
+		if len(args) != 2 {
+			return errors.New("usage: store backup <file>")
+		}
+		return writeFile(args[1], Backup)
+	case "export":
+		if len(args) > 1 {
+			return writeFile(args[1], Export)
+		}
+		return Export(os.Stdout)
+	case "version":
+		version, err := Version()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("version %d\n", version)
+		return nil
+	}
+	return fmt.Errorf("unknown store command: %s", args[0])
+}
+
+// Export writes the values of every bucket to w as a JSON object keyed by
+// bucket and key
+func Export(w io.Writer) error {
+	buckets := make(map[string]map[string]json.RawMessage)
+	err := each("", func(bucket, key string, value []byte) error {
+		if buckets[bucket] == nil {
+			buckets[bucket] = make(map[string]json.RawMessage)
+		}
+		buckets[bucket][key] = append(json.RawMessage(nil), value...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(buckets)
+}
+
+// writeFile creates file and writes to it using write
+func writeFile(file string, write func(io.Writer) error) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
\ No newline at end of file
@@ -0,0 +1,14 @@
+package store
+
+func init() {
+	register(Migration{
+		Version: {{ .Version }},
+		Name:    "{{ .Name }}",
+		Up: func() error {
+			// Place the statements initializing or transforming the stored
+			// data within this function. Subsequent data migrations should
+			// be added to new files registering the next version.
+			return nil
+		},
+	})
+}
\ No newline at end of file
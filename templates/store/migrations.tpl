@@ -0,0 +1,67 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+)
+
+// metaBucket records the version of the last applied migration
+const metaBucket = "_meta"
+
+// Migration transforms the stored data of the previous version
+type Migration struct {
+	Version int
+	Name    string
+	Up      func() error
+}
+
+var migrations []Migration
+
+// register adds a migration applied by Migrate, typically from the init
+// function of its file
+func register(m Migration) {
+	migrations = append(migrations, m)
+}
+
+// Version reads the version of the last applied migration
+func Version() (int, error) {
+	data, err := get(metaBucket, "version")
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(data))
+}
+
+// Migrate applies the migrations newer than the stored version in version
+// order, recording the version after each one
+func Migrate() error {
+	current, err := Version()
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	for _, m := range migrations {
+		if m.Version <= current {
+			continue
+		}
+
+		log.Printf("applying store migration %d_%s...", m.Version, m.Name)
+		if err := m.Up(); err != nil {
+			return fmt.Errorf("store migration %d_%s failed: %w", m.Version, m.Name, err)
+		}
+
+		if err := put(metaBucket, "version", []byte(strconv.Itoa(m.Version))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
\ No newline at end of file
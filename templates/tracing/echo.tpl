@@ -0,0 +1,39 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"{{ .Module }}/tracing"
+)
+
+// traceRequests traces each request served by h, continuing the trace
+// propagated by the client
+func traceRequests(h http.Handler) http.Handler {
+	return otelhttp.NewHandler(h, "http.server", otelhttp.WithSpanNameFormatter(tracing.SpanName))
+}
+
+// routeSpans names the span of each request after its route template
+func routeSpans() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			tracing.Route(c.Request().Context(), c.Request().Method, routeTemplate(c))
+			return next(c)
+		}
+	}
+}
+{{- if not .Metrics }}
+
+// routeTemplate returns the path of the route matching a request, or an empty
+// string when the request path itself stands in for an unrouted request
+func routeTemplate(c echo.Context) string {
+	for _, route := range c.Echo().Routes() {
+		if route.Path == c.Path() {
+			return route.Path
+		}
+	}
+	return ""
+}
+{{- end }}
\ No newline at end of file
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+
+	"{{ .Module }}/tracing"
+)
+
+// traceRequests traces each request in a span named after its route template,
+// continuing the trace propagated by the client
+func traceRequests() gin.HandlerFunc {
+	return otelgin.Middleware(tracing.Service)
+}
\ No newline at end of file
@@ -0,0 +1,12 @@
+package main
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+)
+
+// traceCalls traces each call in a span named after its method, continuing the
+// trace propagated by the client
+func traceCalls() grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler())
+}
\ No newline at end of file
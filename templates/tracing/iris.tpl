@@ -0,0 +1,24 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/kataras/iris"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"{{ .Module }}/tracing"
+)
+
+// traceRequests traces each request served by h, continuing the trace
+// propagated by the client
+func traceRequests(h http.Handler) http.Handler {
+	return otelhttp.NewHandler(h, "http.server", otelhttp.WithSpanNameFormatter(tracing.SpanName))
+}
+
+// routeSpans names the span of each request after its route template
+func routeSpans(ctx iris.Context) {
+	if r := ctx.GetCurrentRoute(); r != nil {
+		tracing.Route(ctx.Request().Context(), ctx.Method(), r.Path())
+	}
+	ctx.Next()
+}
\ No newline at end of file
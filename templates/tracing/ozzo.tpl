@@ -0,0 +1,41 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/go-ozzo/ozzo-routing"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"{{ .Module }}/tracing"
+)
+
+// traceRequests traces each request served by h, continuing the trace
+// propagated by the client
+func traceRequests(h http.Handler) http.Handler {
+	return otelhttp.NewHandler(h, "http.server", otelhttp.WithSpanNameFormatter(tracing.SpanName))
+}
+
+// routeSpans names the span of each request after its route template
+func routeSpans(c *routing.Context) error {
+	tracing.Route(c.Request.Context(), c.Request.Method, routeTemplate(c))
+	return c.Next()
+}
+{{- if not .Metrics }}
+
+// routeTemplate finds the template of the route matching the request, e.g.
+// /users/<id>, which is empty when no route matches
+func routeTemplate(c *routing.Context) string {
+	_, params := c.Router().Find(c.Request.Method, c.Request.URL.Path)
+	pairs := make([]interface{}, 0, 2*len(params))
+	for name, value := range params {
+		pairs = append(pairs, name, value)
+	}
+
+	for _, route := range c.Router().Routes() {
+		if route.Method() == c.Request.Method && route.URL(pairs...) == c.Request.URL.Path {
+			return route.Path()
+		}
+	}
+	return ""
+}
+{{- end }}
\ No newline at end of file
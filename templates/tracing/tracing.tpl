@@ -0,0 +1,107 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+{{- if ne .App "grpc" }}
+	"net/http"
+{{- end }}
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+{{- if ne .App "grpc" }}
+	"go.opentelemetry.io/otel/trace"
+{{- end }}
+)
+
+// Service names the service of the spans unless overridden by
+// OTEL_SERVICE_NAME
+const Service = "{{ .Name }}"
+
+// Setup installs the global tracer provider and propagator, exporting the spans
+// to the exporter named by OTEL_TRACES_EXPORTER: console, the default, writing
+// them to stdout, otlp, or none. The OTLP exporter is configured by the
+// OTEL_EXPORTER_OTLP_* variables, and the sampler by OTEL_TRACES_SAMPLER. The
+// returned function flushes the pending spans on shutdown
+func Setup() (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	exporter, err := newExporter(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	// tracing is disabled
+	if exporter == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res, err := resource.New(context.Background(),
+		resource.WithAttributes(semconv.ServiceName(Service)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// newExporter creates the exporter named by OTEL_TRACES_EXPORTER, which is nil
+// when tracing is disabled
+func newExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	switch name := os.Getenv("OTEL_TRACES_EXPORTER"); name {
+	case "", "console":
+		return stdouttrace.New()
+	case "otlp":
+		protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+		if protocol == "" {
+			protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+		}
+
+		switch protocol {
+		case "", "http/protobuf":
+			return otlptracehttp.New(ctx)
+		case "grpc":
+			return otlptracegrpc.New(ctx)
+		default:
+			return nil, fmt.Errorf("invalid OTLP protocol %q; expected grpc or http/protobuf", protocol)
+		}
+	case "none":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("invalid traces exporter %q; expected console, otlp, or none", name)
+	}
+}
+{{- if ne .App "grpc" }}
+
+// SpanName names the span of a request by its method until the route is known
+func SpanName(_ string, r *http.Request) string {
+	return r.Method
+}
+
+// Route names the span of a request after the template of the route it
+// matched, e.g. GET /users/:id, rather than its raw path
+func Route(ctx context.Context, method, route string) {
+	if route == "" {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.SetName(method + " " + route)
+	span.SetAttributes(semconv.HTTPRoute(route))
+}
+{{- end }}
\ No newline at end of file